@@ -0,0 +1,1216 @@
+//go:build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfig) DeepCopyInto(out *ClientConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfig.
+func (in *ClientConfig) DeepCopy() *ClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfigInitParameters) DeepCopyInto(out *ClientConfigInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfigInitParameters.
+func (in *ClientConfigInitParameters) DeepCopy() *ClientConfigInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfigInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfigList) DeepCopyInto(out *ClientConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClientConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfigList.
+func (in *ClientConfigList) DeepCopy() *ClientConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfigObservation) DeepCopyInto(out *ClientConfigObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ObjectID != nil {
+		in, out := &in.ObjectID, &out.ObjectID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubscriptionResourceID != nil {
+		in, out := &in.SubscriptionResourceID, &out.SubscriptionResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantID != nil {
+		in, out := &in.TenantID, &out.TenantID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfigObservation.
+func (in *ClientConfigObservation) DeepCopy() *ClientConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfigParameters) DeepCopyInto(out *ClientConfigParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfigParameters.
+func (in *ClientConfigParameters) DeepCopy() *ClientConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfigSpec) DeepCopyInto(out *ClientConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+	out.InitProvider = in.InitProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfigSpec.
+func (in *ClientConfigSpec) DeepCopy() *ClientConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientConfigStatus) DeepCopyInto(out *ClientConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientConfigStatus.
+func (in *ClientConfigStatus) DeepCopy() *ClientConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityInitParameters) DeepCopyInto(out *IdentityInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityInitParameters.
+func (in *IdentityInitParameters) DeepCopy() *IdentityInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityObservation) DeepCopyInto(out *IdentityObservation) {
+	*out = *in
+	if in.IdentityIds != nil {
+		in, out := &in.IdentityIds, &out.IdentityIds
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.PrincipalID != nil {
+		in, out := &in.PrincipalID, &out.PrincipalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantID != nil {
+		in, out := &in.TenantID, &out.TenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityObservation.
+func (in *IdentityObservation) DeepCopy() *IdentityObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityParameters) DeepCopyInto(out *IdentityParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityParameters.
+func (in *IdentityParameters) DeepCopy() *IdentityParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputConfigMapInitParameters) DeepCopyInto(out *OutputConfigMapInitParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputConfigMapInitParameters.
+func (in *OutputConfigMapInitParameters) DeepCopy() *OutputConfigMapInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputConfigMapInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputConfigMapObservation) DeepCopyInto(out *OutputConfigMapObservation) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputConfigMapObservation.
+func (in *OutputConfigMapObservation) DeepCopy() *OutputConfigMapObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputConfigMapObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputConfigMapParameters) DeepCopyInto(out *OutputConfigMapParameters) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputConfigMapParameters.
+func (in *OutputConfigMapParameters) DeepCopy() *OutputConfigMapParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputConfigMapParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceData) DeepCopyInto(out *ResourceData) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceData.
+func (in *ResourceData) DeepCopy() *ResourceData {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceData) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDataInitParameters) DeepCopyInto(out *ResourceDataInitParameters) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.IgnoreNotFound != nil {
+		in, out := &in.IgnoreNotFound, &out.IgnoreNotFound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResponseExportValues != nil {
+		in, out := &in.ResponseExportValues, &out.ResponseExportValues
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDataInitParameters.
+func (in *ResourceDataInitParameters) DeepCopy() *ResourceDataInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDataInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDataList) DeepCopyInto(out *ResourceDataList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceData, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDataList.
+func (in *ResourceDataList) DeepCopy() *ResourceDataList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDataList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceDataList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDataObservation) DeepCopyInto(out *ResourceDataObservation) {
+	*out = *in
+	if in.Exists != nil {
+		in, out := &in.Exists, &out.Exists
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = make([]IdentityObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnoreNotFound != nil {
+		in, out := &in.IgnoreNotFound, &out.IgnoreNotFound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResponseExportValues != nil {
+		in, out := &in.ResponseExportValues, &out.ResponseExportValues
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDataObservation.
+func (in *ResourceDataObservation) DeepCopy() *ResourceDataObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDataObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDataParameters) DeepCopyInto(out *ResourceDataParameters) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.IgnoreNotFound != nil {
+		in, out := &in.IgnoreNotFound, &out.IgnoreNotFound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResponseExportValues != nil {
+		in, out := &in.ResponseExportValues, &out.ResponseExportValues
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDataParameters.
+func (in *ResourceDataParameters) DeepCopy() *ResourceDataParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDataParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDataSpec) DeepCopyInto(out *ResourceDataSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDataSpec.
+func (in *ResourceDataSpec) DeepCopy() *ResourceDataSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDataSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDataStatus) DeepCopyInto(out *ResourceDataStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDataStatus.
+func (in *ResourceDataStatus) DeepCopy() *ResourceDataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceList) DeepCopyInto(out *ResourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceList.
+func (in *ResourceList) DeepCopy() *ResourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceListInitParameters) DeepCopyInto(out *ResourceListInitParameters) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OutputConfigMap != nil {
+		in, out := &in.OutputConfigMap, &out.OutputConfigMap
+		*out = new(OutputConfigMapInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResponseExportValues != nil {
+		in, out := &in.ResponseExportValues, &out.ResponseExportValues
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceListInitParameters.
+func (in *ResourceListInitParameters) DeepCopy() *ResourceListInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceListInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceListList) DeepCopyInto(out *ResourceListList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceListList.
+func (in *ResourceListList) DeepCopy() *ResourceListList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceListList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceListList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceListObservation) DeepCopyInto(out *ResourceListObservation) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ItemCount != nil {
+		in, out := &in.ItemCount, &out.ItemCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutputConfigMap != nil {
+		in, out := &in.OutputConfigMap, &out.OutputConfigMap
+		*out = new(OutputConfigMapObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutputRef != nil {
+		in, out := &in.OutputRef, &out.OutputRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResponseExportValues != nil {
+		in, out := &in.ResponseExportValues, &out.ResponseExportValues
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Truncated != nil {
+		in, out := &in.Truncated, &out.Truncated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceListObservation.
+func (in *ResourceListObservation) DeepCopy() *ResourceListObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceListObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceListParameters) DeepCopyInto(out *ResourceListParameters) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OutputConfigMap != nil {
+		in, out := &in.OutputConfigMap, &out.OutputConfigMap
+		*out = new(OutputConfigMapParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueryParameters != nil {
+		in, out := &in.QueryParameters, &out.QueryParameters
+		*out = make(map[string][]*string, len(*in))
+		for key, val := range *in {
+			var outVal []*string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]*string, len(*in))
+				for i := range *in {
+					if (*in)[i] != nil {
+						in, out := &(*in)[i], &(*out)[i]
+						*out = new(string)
+						**out = **in
+					}
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ResponseExportValues != nil {
+		in, out := &in.ResponseExportValues, &out.ResponseExportValues
+		*out = new(v1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceListParameters.
+func (in *ResourceListParameters) DeepCopy() *ResourceListParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceListParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceListSpec) DeepCopyInto(out *ResourceListSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.InitProvider.DeepCopyInto(&out.InitProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceListSpec.
+func (in *ResourceListSpec) DeepCopy() *ResourceListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceListStatus) DeepCopyInto(out *ResourceListStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceListStatus.
+func (in *ResourceListStatus) DeepCopy() *ResourceListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryInitParameters) DeepCopyInto(out *RetryInitParameters) {
+	*out = *in
+	if in.ErrorMessageRegex != nil {
+		in, out := &in.ErrorMessageRegex, &out.ErrorMessageRegex
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxIntervalSeconds != nil {
+		in, out := &in.MaxIntervalSeconds, &out.MaxIntervalSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.RandomizationFactor != nil {
+		in, out := &in.RandomizationFactor, &out.RandomizationFactor
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryInitParameters.
+func (in *RetryInitParameters) DeepCopy() *RetryInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryObservation) DeepCopyInto(out *RetryObservation) {
+	*out = *in
+	if in.ErrorMessageRegex != nil {
+		in, out := &in.ErrorMessageRegex, &out.ErrorMessageRegex
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxIntervalSeconds != nil {
+		in, out := &in.MaxIntervalSeconds, &out.MaxIntervalSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.RandomizationFactor != nil {
+		in, out := &in.RandomizationFactor, &out.RandomizationFactor
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryObservation.
+func (in *RetryObservation) DeepCopy() *RetryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryParameters) DeepCopyInto(out *RetryParameters) {
+	*out = *in
+	if in.ErrorMessageRegex != nil {
+		in, out := &in.ErrorMessageRegex, &out.ErrorMessageRegex
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MaxIntervalSeconds != nil {
+		in, out := &in.MaxIntervalSeconds, &out.MaxIntervalSeconds
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.RandomizationFactor != nil {
+		in, out := &in.RandomizationFactor, &out.RandomizationFactor
+		*out = new(float64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryParameters.
+func (in *RetryParameters) DeepCopy() *RetryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
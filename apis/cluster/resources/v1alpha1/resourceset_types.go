@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+)
+
+// A ResourceSetTemplate is the template of a Resource of a ResourceSet.
+type ResourceSetTemplate struct {
+	// Name of the template, unique within the ResourceSet. The other
+	// templates reference the Resource by this name, which is also the
+	// suffix of the name of the Resource.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// DependsOn lists the names of the templates whose Resources must be
+	// ready before this one is applied, in addition to the ones it
+	// references.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// ForProvider is the forProvider of the Resource. Its string values may
+	// reference the observed state of the Resources of the other templates,
+	// e.g. ${resources.vnet.id} or ${resources.vnet.output.properties.guid}.
+	// A string consisting of a single reference is replaced with the
+	// referenced value, whatever its type.
+	ForProvider v1beta2.ResourceParameters `json:"forProvider"`
+}
+
+// A ResourceSetSpec defines the desired state of a ResourceSet.
+type ResourceSetSpec struct {
+	// ProviderConfigReference specifies the ProviderConfig of the
+	// Resources of the set.
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// ManagementPolicies of the Resources of the set.
+	// +optional
+	// +kubebuilder:default={"*"}
+	ManagementPolicies xpv1.ManagementPolicies `json:"managementPolicies,omitempty"`
+
+	// DeletionPolicy of the Resources of the set.
+	// +optional
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +kubebuilder:default=Delete
+	DeletionPolicy xpv1.DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Resources are the templates of the Resources of the set. A Resource
+	// is applied once the Resources it depends on are ready, in the order
+	// of the list otherwise, and deleted once the Resources depending on it
+	// are gone.
+	// +kubebuilder:validation:MinItems=1
+	Resources []ResourceSetTemplate `json:"resources"`
+}
+
+// A ResourceSetResourceStatus is the observed state of a Resource of a
+// ResourceSet.
+type ResourceSetResourceStatus struct {
+	// Name of the template of the Resource.
+	Name string `json:"name"`
+
+	// ResourceName is the name of the Resource.
+	ResourceName string `json:"resourceName"`
+
+	// ID is the Azure resource ID of the Resource, once it's known.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Ready indicates whether the Resource is ready.
+	Ready bool `json:"ready"`
+
+	// Message explains why the Resource is not ready, e.g. the templates
+	// it's waiting for.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// A ResourceSetStatus reflects the observed state of a ResourceSet.
+type ResourceSetStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// ObservedGeneration is the latest generation of the ResourceSet
+	// reconciled.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Resources are the observed states of the Resources of the set, in
+	// the order of the templates.
+	// +optional
+	Resources []ResourceSetResourceStatus `json:"resources,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// A ResourceSet deploys a set of Resources from ordered templates, which
+// reference each other's observed state. It's ready once all its Resources
+// are ready.
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,azapi}
+type ResourceSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ResourceSetSpec   `json:"spec"`
+	Status            ResourceSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceSetList contains a list of ResourceSets
+type ResourceSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceSet `json:"items"`
+}
+
+// ResourceSet type metadata.
+var (
+	ResourceSet_Kind             = "ResourceSet"
+	ResourceSet_GroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ResourceSet_Kind}.String()
+	ResourceSet_KindAPIVersion   = ResourceSet_Kind + "." + CRDGroupVersion.String()
+	ResourceSet_GroupVersionKind = CRDGroupVersion.WithKind(ResourceSet_Kind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ResourceSet{}, &ResourceSetList{})
+}
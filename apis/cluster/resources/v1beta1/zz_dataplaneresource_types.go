@@ -19,6 +19,9 @@ type DataPlaneResourceInitParameters struct {
 	// A JSON object that contains the request body used to create and update data plane resource.
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the create request.
 	// A mapping of headers to be sent with the create request.
 	// +mapType=granular
@@ -37,6 +40,12 @@ type DataPlaneResourceInitParameters struct {
 	// A mapping of query parameters to be sent with the delete request.
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
 	// Whether ignore incorrect casing returned in body to suppress plan-diff. Defaults to false.
 	IgnoreCasing *bool `json:"ignoreCasing,omitempty" tf:"ignore_casing,omitempty"`
 
@@ -87,6 +96,9 @@ type DataPlaneResourceObservation struct {
 	// A JSON object that contains the request body used to create and update data plane resource.
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the create request.
 	// A mapping of headers to be sent with the create request.
 	// +mapType=granular
@@ -105,6 +117,18 @@ type DataPlaneResourceObservation struct {
 	// A mapping of query parameters to be sent with the delete request.
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
+	// The time the resource is deleted at with the `DeleteWithRetention` deletion mode, in RFC 3339 format.
+	DeletionScheduledTime *string `json:"deletionScheduledTime,omitempty" tf:"deletion_scheduled_time,omitempty"`
+
+	// The deletion state of the resource. It's `PendingDelete` while the deletion is held back by the `DeleteWithRetention` deletion mode.
+	DeletionState *string `json:"deletionState,omitempty" tf:"deletion_state,omitempty"`
+
 	// The ID of the azure resource.
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
@@ -135,6 +159,9 @@ type DataPlaneResourceObservation struct {
 	// A mapping of query parameters to be sent with the read request.
 	ReadQueryParameters map[string][]*string `json:"readQueryParameters,omitempty" tf:"read_query_parameters,omitempty"`
 
+	// The `body` rendered with `body_templating`, in JSON.
+	RenderedBody *string `json:"renderedBody,omitempty" tf:"rendered_body,omitempty"`
+
 	// A list of path that needs to be exported from response body.
 	// Setting it to ["*"] will export the full response body.
 	// Here's an example. If it sets to ["properties.loginServer", "properties.policies.quarantinePolicy.status"], it will set the following json to computed property output.
@@ -170,6 +197,10 @@ type DataPlaneResourceParameters struct {
 	// +kubebuilder:validation:Optional
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	// +kubebuilder:validation:Optional
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the create request.
 	// A mapping of headers to be sent with the create request.
 	// +kubebuilder:validation:Optional
@@ -192,6 +223,14 @@ type DataPlaneResourceParameters struct {
 	// +kubebuilder:validation:Optional
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	// +kubebuilder:validation:Optional
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	// +kubebuilder:validation:Optional
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
 	// (Boolean) A dynamic attribute that contains the request body.
 	// A dynamic attribute that contains the request body.
 	// +kubebuilder:validation:Optional
@@ -364,7 +403,6 @@ type DataPlaneResourceStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // DataPlaneResource is the Schema for the DataPlaneResources API. Manages a Azure data plane resource
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
@@ -14,6 +14,35 @@ import (
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
+type AzureLockInitParameters struct {
+
+	// The level of the lock, value must be one of: `CanNotDelete`, `ReadOnly`.
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// The notes of the lock, at most 512 characters.
+	Notes *string `json:"notes,omitempty" tf:"notes,omitempty"`
+}
+
+type AzureLockObservation struct {
+
+	// The level of the lock, value must be one of: `CanNotDelete`, `ReadOnly`.
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// The notes of the lock, at most 512 characters.
+	Notes *string `json:"notes,omitempty" tf:"notes,omitempty"`
+}
+
+type AzureLockParameters struct {
+
+	// The level of the lock, value must be one of: `CanNotDelete`, `ReadOnly`.
+	// +kubebuilder:validation:Optional
+	Level *string `json:"level" tf:"level,omitempty"`
+
+	// The notes of the lock, at most 512 characters.
+	// +kubebuilder:validation:Optional
+	Notes *string `json:"notes,omitempty" tf:"notes,omitempty"`
+}
+
 type IdentityInitParameters struct {
 
 	// A list of User Managed Identity ID's which should be assigned to the azure resource.
@@ -51,9 +80,15 @@ type IdentityParameters struct {
 
 type ResourceInitParameters struct {
 
+	// The Azure management lock of the resource. The lock is lifted while the resource is updated or deleted by the provider and removed when this block is removed.
+	AzureLock *AzureLockInitParameters `json:"azureLock,omitempty" tf:"azure_lock,omitempty"`
+
 	// A JSON object that contains the request body used to create and update azure resource.
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the create request.
 	// A mapping of headers to be sent with the create request.
 	// +mapType=granular
@@ -72,6 +107,12 @@ type ResourceInitParameters struct {
 	// A mapping of query parameters to be sent with the delete request.
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
 	// A identity block as defined below.
 	Identity []IdentityInitParameters `json:"identity,omitempty" tf:"identity,omitempty"`
 
@@ -155,9 +196,21 @@ type ResourceInitParameters struct {
 
 type ResourceObservation struct {
 
+	// The Azure management lock of the resource. The lock is lifted while the resource is updated or deleted by the provider and removed when this block is removed.
+	AzureLock *AzureLockObservation `json:"azureLock,omitempty" tf:"azure_lock,omitempty"`
+
+	// The ID of the Azure management lock of the resource.
+	AzureLockID *string `json:"azureLockId,omitempty" tf:"azure_lock_id,omitempty"`
+
+	// The state of the Azure management lock of the resource. It's `Locked` while the lock is in place and `Lifted` while it's lifted for an update or the deletion of the resource.
+	AzureLockState *string `json:"azureLockState,omitempty" tf:"azure_lock_state,omitempty"`
+
 	// A JSON object that contains the request body used to create and update azure resource.
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the create request.
 	// A mapping of headers to be sent with the create request.
 	// +mapType=granular
@@ -176,6 +229,18 @@ type ResourceObservation struct {
 	// A mapping of query parameters to be sent with the delete request.
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
+	// The time the resource is deleted at with the `DeleteWithRetention` deletion mode, in RFC 3339 format.
+	DeletionScheduledTime *string `json:"deletionScheduledTime,omitempty" tf:"deletion_scheduled_time,omitempty"`
+
+	// The deletion state of the resource. It's `PendingDelete` while the deletion is held back by the `DeleteWithRetention` deletion mode.
+	DeletionState *string `json:"deletionState,omitempty" tf:"deletion_state,omitempty"`
+
 	// The ID of the azure resource.
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
@@ -216,6 +281,9 @@ type ResourceObservation struct {
 	// The ID of the azure resource in which this resource is created. Changing this forces a new resource to be created. It supports different kinds of deployment scope for top level resources:
 	ParentID *string `json:"parentId,omitempty" tf:"parent_id,omitempty"`
 
+	// The tags propagated from the metadata of the managed resource by the tag propagation of its provider config.
+	PropagatedTags map[string]*string `json:"propagatedTags,omitempty" tf:"propagated_tags,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the read request.
 	// A mapping of headers to be sent with the read request.
 	// +mapType=granular
@@ -225,6 +293,9 @@ type ResourceObservation struct {
 	// A mapping of query parameters to be sent with the read request.
 	ReadQueryParameters map[string][]*string `json:"readQueryParameters,omitempty" tf:"read_query_parameters,omitempty"`
 
+	// The `body` rendered with `body_templating`, in JSON.
+	RenderedBody *string `json:"renderedBody,omitempty" tf:"rendered_body,omitempty"`
+
 	// A list of path that needs to be exported from response body.
 	// Setting it to ["*"] will export the full response body.
 	// Here's an example. If it sets to ["properties.loginServer", "properties.policies.quarantinePolicy.status"], it will set the following json to computed property output.
@@ -265,10 +336,18 @@ type ResourceObservation struct {
 
 type ResourceParameters struct {
 
+	// The Azure management lock of the resource. The lock is lifted while the resource is updated or deleted by the provider and removed when this block is removed.
+	// +kubebuilder:validation:Optional
+	AzureLock *AzureLockParameters `json:"azureLock,omitempty" tf:"azure_lock,omitempty"`
+
 	// A JSON object that contains the request body used to create and update azure resource.
 	// +kubebuilder:validation:Optional
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	// +kubebuilder:validation:Optional
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the create request.
 	// A mapping of headers to be sent with the create request.
 	// +kubebuilder:validation:Optional
@@ -291,6 +370,14 @@ type ResourceParameters struct {
 	// +kubebuilder:validation:Optional
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	// +kubebuilder:validation:Optional
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	// +kubebuilder:validation:Optional
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
 	// A identity block as defined below.
 	// +kubebuilder:validation:Optional
 	Identity []IdentityParameters `json:"identity,omitempty" tf:"identity,omitempty"`
@@ -492,7 +579,6 @@ type ResourceStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // Resource is the Schema for the Resources API. Manages a Azure resource
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
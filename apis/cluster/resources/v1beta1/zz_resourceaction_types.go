@@ -14,6 +14,78 @@ import (
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
+type HistoryInitParameters struct {
+}
+
+type HistoryObservation struct {
+
+	// The error of a failed run, truncated.
+	Error *string `json:"error,omitempty" tf:"error,omitempty"`
+
+	// The HTTP method of the run.
+	Method *string `json:"method,omitempty" tf:"method,omitempty"`
+
+	// The JSON encoded `output` of the run, truncated. The values of the keys that look like credentials, e.g. `primaryKey`, and the strings that look like connection strings, SAS tokens or JWTs are redacted, other credentials are not detected.
+	Output *string `json:"output,omitempty" tf:"output,omitempty"`
+
+	// The key of the full output of the run in the `output_store`.
+	OutputRef *string `json:"outputRef,omitempty" tf:"output_ref,omitempty"`
+
+	// The SHA-256 digest of the full JSON encoded `output` of the run.
+	OutputSha256 *string `json:"outputSha256,omitempty" tf:"output_sha256,omitempty"`
+
+	// Indicates whether `output` is truncated.
+	OutputTruncated *bool `json:"outputTruncated,omitempty" tf:"output_truncated,omitempty"`
+
+	// The HTTP status code of the last response of the run. It's `0` if no response was received.
+	StatusCode *int64 `json:"statusCode,omitempty" tf:"status_code,omitempty"`
+
+	// The time the run completed, in RFC 3339 format.
+	Time *string `json:"time,omitempty" tf:"time,omitempty"`
+}
+
+type HistoryParameters struct {
+}
+
+type OutputStoreInitParameters struct {
+
+	// The kind of the object the full outputs are stored in, value must be one of: `ConfigMap`, `Secret`. The `sensitive_output` is only stored in Secrets. The values of the keys that look like credentials, e.g. `primaryKey`, and the strings that look like connection strings, SAS tokens or JWTs are redacted from ConfigMaps, other credentials are not detected and require a Secret.
+	Kind *string `json:"kind,omitempty" tf:"kind,omitempty"`
+
+	// The name of the object the full outputs are stored in. It's created if it does not exist.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// The namespace of the object the full outputs are stored in.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type OutputStoreObservation struct {
+
+	// The kind of the object the full outputs are stored in, value must be one of: `ConfigMap`, `Secret`. The `sensitive_output` is only stored in Secrets. The values of the keys that look like credentials, e.g. `primaryKey`, and the strings that look like connection strings, SAS tokens or JWTs are redacted from ConfigMaps, other credentials are not detected and require a Secret.
+	Kind *string `json:"kind,omitempty" tf:"kind,omitempty"`
+
+	// The name of the object the full outputs are stored in. It's created if it does not exist.
+	Name *string `json:"name,omitempty" tf:"name,omitempty"`
+
+	// The namespace of the object the full outputs are stored in.
+	Namespace *string `json:"namespace,omitempty" tf:"namespace,omitempty"`
+}
+
+type OutputStoreParameters struct {
+
+	// The kind of the object the full outputs are stored in, value must be one of: `ConfigMap`, `Secret`. The `sensitive_output` is only stored in Secrets. The values of the keys that look like credentials, e.g. `primaryKey`, and the strings that look like connection strings, SAS tokens or JWTs are redacted from ConfigMaps, other credentials are not detected and require a Secret.
+	// +kubebuilder:validation:Optional
+	Kind *string `json:"kind" tf:"kind,omitempty"`
+
+	// The name of the object the full outputs are stored in. It's created if it does not exist.
+	// +kubebuilder:validation:Optional
+	Name *string `json:"name" tf:"name,omitempty"`
+
+	// The namespace of the object the full outputs are stored in.
+	// +kubebuilder:validation:Optional
+	Namespace *string `json:"namespace" tf:"namespace,omitempty"`
+}
+
 type ResourceActionInitParameters struct {
 
 	// The name of the resource action. It's also possible to make Http requests towards the resource ID if leave this field empty.
@@ -27,6 +99,9 @@ type ResourceActionInitParameters struct {
 	// +mapType=granular
 	Headers map[string]*string `json:"headers,omitempty" tf:"headers,omitempty"`
 
+	// The maximum number of action runs kept in `history`, between `0` and `50`. Default is `10`.
+	HistoryLimit *int64 `json:"historyLimit,omitempty" tf:"history_limit,omitempty"`
+
 	// If set to `true`, the resource action will ignore `Not Found` errors returned from the Azure API. Default is `false`.
 	IgnoreNotFound *bool `json:"ignoreNotFound,omitempty" tf:"ignore_not_found,omitempty"`
 
@@ -36,6 +111,9 @@ type ResourceActionInitParameters struct {
 	// Specifies the Http method of the azure resource action. Allowed values are POST, PATCH, PUT and DELETE. Defaults to POST.
 	Method *string `json:"method,omitempty" tf:"method,omitempty"`
 
+	// A ConfigMap or Secret the full output of each run in `history` is stored in, under the `outputRef` key of the run.
+	OutputStore *OutputStoreInitParameters `json:"outputStore,omitempty" tf:"output_store,omitempty"`
+
 	// (Map of List of String) A map of query parameters to include in the request
 	// A map of query parameters to include in the request
 	QueryParameters map[string][]*string `json:"queryParameters,omitempty" tf:"query_parameters,omitempty"`
@@ -51,6 +129,12 @@ type ResourceActionInitParameters struct {
 	// (Attributes) The retry object supports the following attributes: (see below for nested schema)
 	Retry *ResourceActionRetryInitParameters `json:"retry,omitempty" tf:"retry,omitempty"`
 
+	// When to run the action, value must be one of: `Once`, `OnChange`, `Scheduled`. `Once` runs the action only when the resource is created, `OnChange` also runs it whenever the arguments change and `Scheduled` also runs it at the times given by `schedule`. Default is `Scheduled` if `schedule` is set and `OnChange` otherwise.
+	RunPolicy *string `json:"runPolicy,omitempty" tf:"run_policy,omitempty"`
+
+	// A cron expression in UTC specifying when to run the action again, e.g. `0 3 * * *`, `@daily` or `@every 12h`. Only used with the `Scheduled` run policy. A scheduled run happens at the first reconciliation after the scheduled time.
+	Schedule *string `json:"schedule,omitempty" tf:"schedule,omitempty"`
+
 	// (Dynamic) The attribute can accept either a list or a map.
 	// The attribute can accept either a list or a map.
 	//
@@ -106,21 +190,36 @@ type ResourceActionObservation struct {
 	// +mapType=granular
 	Headers map[string]*string `json:"headers,omitempty" tf:"headers,omitempty"`
 
+	// The latest runs of the action, the most recent first.
+	History []HistoryObservation `json:"history,omitempty" tf:"history,omitempty"`
+
+	// The maximum number of action runs kept in `history`, between `0` and `50`. Default is `10`.
+	HistoryLimit *int64 `json:"historyLimit,omitempty" tf:"history_limit,omitempty"`
+
 	// The ID of the azure resource action.
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
 	// If set to `true`, the resource action will ignore `Not Found` errors returned from the Azure API. Default is `false`.
 	IgnoreNotFound *bool `json:"ignoreNotFound,omitempty" tf:"ignore_not_found,omitempty"`
 
+	// The time the action was last run, in RFC 3339 format. The `output` holds the output of that run.
+	LastRunTime *string `json:"lastRunTime,omitempty" tf:"last_run_time,omitempty"`
+
 	// A list of ARM resource IDs which are used to avoid modify azapi resources at the same time.
 	Locks []*string `json:"locks,omitempty" tf:"locks,omitempty"`
 
 	// Specifies the Http method of the azure resource action. Allowed values are POST, PATCH, PUT and DELETE. Defaults to POST.
 	Method *string `json:"method,omitempty" tf:"method,omitempty"`
 
+	// The time the action is next run according to the `schedule`, in RFC 3339 format.
+	NextRunTime *string `json:"nextRunTime,omitempty" tf:"next_run_time,omitempty"`
+
 	// The output json containing the properties specified in response_export_values. Here are some examples to decode json and extract the value.
 	Output *string `json:"output,omitempty" tf:"output,omitempty"`
 
+	// A ConfigMap or Secret the full output of each run in `history` is stored in, under the `outputRef` key of the run.
+	OutputStore *OutputStoreObservation `json:"outputStore,omitempty" tf:"output_store,omitempty"`
+
 	// (Map of List of String) A map of query parameters to include in the request
 	// A map of query parameters to include in the request
 	QueryParameters map[string][]*string `json:"queryParameters,omitempty" tf:"query_parameters,omitempty"`
@@ -136,6 +235,12 @@ type ResourceActionObservation struct {
 	// (Attributes) The retry object supports the following attributes: (see below for nested schema)
 	Retry *ResourceActionRetryObservation `json:"retry,omitempty" tf:"retry,omitempty"`
 
+	// When to run the action, value must be one of: `Once`, `OnChange`, `Scheduled`. `Once` runs the action only when the resource is created, `OnChange` also runs it whenever the arguments change and `Scheduled` also runs it at the times given by `schedule`. Default is `Scheduled` if `schedule` is set and `OnChange` otherwise.
+	RunPolicy *string `json:"runPolicy,omitempty" tf:"run_policy,omitempty"`
+
+	// A cron expression in UTC specifying when to run the action again, e.g. `0 3 * * *`, `@daily` or `@every 12h`. Only used with the `Scheduled` run policy. A scheduled run happens at the first reconciliation after the scheduled time.
+	Schedule *string `json:"schedule,omitempty" tf:"schedule,omitempty"`
+
 	// (Dynamic) The attribute can accept either a list or a map.
 	// The attribute can accept either a list or a map.
 	//
@@ -191,6 +296,10 @@ type ResourceActionParameters struct {
 	// +mapType=granular
 	Headers map[string]*string `json:"headers,omitempty" tf:"headers,omitempty"`
 
+	// The maximum number of action runs kept in `history`, between `0` and `50`. Default is `10`.
+	// +kubebuilder:validation:Optional
+	HistoryLimit *int64 `json:"historyLimit,omitempty" tf:"history_limit,omitempty"`
+
 	// If set to `true`, the resource action will ignore `Not Found` errors returned from the Azure API. Default is `false`.
 	// +kubebuilder:validation:Optional
 	IgnoreNotFound *bool `json:"ignoreNotFound,omitempty" tf:"ignore_not_found,omitempty"`
@@ -203,6 +312,10 @@ type ResourceActionParameters struct {
 	// +kubebuilder:validation:Optional
 	Method *string `json:"method,omitempty" tf:"method,omitempty"`
 
+	// A ConfigMap or Secret the full output of each run in `history` is stored in, under the `outputRef` key of the run.
+	// +kubebuilder:validation:Optional
+	OutputStore *OutputStoreParameters `json:"outputStore,omitempty" tf:"output_store,omitempty"`
+
 	// (Map of List of String) A map of query parameters to include in the request
 	// A map of query parameters to include in the request
 	// +kubebuilder:validation:Optional
@@ -222,6 +335,14 @@ type ResourceActionParameters struct {
 	// +kubebuilder:validation:Optional
 	Retry *ResourceActionRetryParameters `json:"retry,omitempty" tf:"retry,omitempty"`
 
+	// When to run the action, value must be one of: `Once`, `OnChange`, `Scheduled`. `Once` runs the action only when the resource is created, `OnChange` also runs it whenever the arguments change and `Scheduled` also runs it at the times given by `schedule`. Default is `Scheduled` if `schedule` is set and `OnChange` otherwise.
+	// +kubebuilder:validation:Optional
+	RunPolicy *string `json:"runPolicy,omitempty" tf:"run_policy,omitempty"`
+
+	// A cron expression in UTC specifying when to run the action again, e.g. `0 3 * * *`, `@daily` or `@every 12h`. Only used with the `Scheduled` run policy. A scheduled run happens at the first reconciliation after the scheduled time.
+	// +kubebuilder:validation:Optional
+	Schedule *string `json:"schedule,omitempty" tf:"schedule,omitempty"`
+
 	// (Dynamic) The attribute can accept either a list or a map.
 	// The attribute can accept either a list or a map.
 	//
@@ -363,7 +484,6 @@ type ResourceActionStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // ResourceAction is the Schema for the ResourceActions API. Perform resource action which changes an existing resource's state
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
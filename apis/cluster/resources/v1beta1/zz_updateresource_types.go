@@ -19,6 +19,9 @@ type UpdateResourceInitParameters struct {
 	// A JSON object that contains the request body used to add on an existing azure resource.
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// Whether ignore incorrect casing returned in body to suppress plan-diff. Defaults to false.
 	IgnoreCasing *bool `json:"ignoreCasing,omitempty" tf:"ignore_casing,omitempty"`
 
@@ -89,6 +92,9 @@ type UpdateResourceObservation struct {
 	// A JSON object that contains the request body used to add on an existing azure resource.
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// The ID of the azure resource.
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
@@ -117,6 +123,9 @@ type UpdateResourceObservation struct {
 	// The ID of the azure resource in which this resource is created. Changing this forces a new resource to be created. It supports different kinds of deployment scope for top level resources:
 	ParentID *string `json:"parentId,omitempty" tf:"parent_id,omitempty"`
 
+	// The tags propagated from the metadata of the managed resource by the tag propagation of its provider config.
+	PropagatedTags map[string]*string `json:"propagatedTags,omitempty" tf:"propagated_tags,omitempty"`
+
 	// (Map of String) A mapping of headers to be sent with the read request.
 	// A mapping of headers to be sent with the read request.
 	// +mapType=granular
@@ -126,6 +135,9 @@ type UpdateResourceObservation struct {
 	// A mapping of query parameters to be sent with the read request.
 	ReadQueryParameters map[string][]*string `json:"readQueryParameters,omitempty" tf:"read_query_parameters,omitempty"`
 
+	// The `body` rendered with `body_templating`, in JSON.
+	RenderedBody *string `json:"renderedBody,omitempty" tf:"rendered_body,omitempty"`
+
 	// The ID of an existing azure source. Changing this forces a new azure resource to be created.
 	ResourceID *string `json:"resourceId,omitempty" tf:"resource_id,omitempty"`
 
@@ -166,6 +178,10 @@ type UpdateResourceParameters struct {
 	// +kubebuilder:validation:Optional
 	Body *string `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	// +kubebuilder:validation:Optional
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// Whether ignore incorrect casing returned in body to suppress plan-diff. Defaults to false.
 	// +kubebuilder:validation:Optional
 	IgnoreCasing *bool `json:"ignoreCasing,omitempty" tf:"ignore_casing,omitempty"`
@@ -347,7 +363,6 @@ type UpdateResourceStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // UpdateResource is the Schema for the UpdateResources API. Manages a subset of an existing azure resource's properties
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
@@ -10,18 +10,22 @@ package cluster
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	v1alpha1 "github.com/upbound/provider-azapi/v2/apis/cluster/data/v1alpha1"
+	v1alpha1resources "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1alpha1"
 	v1beta1 "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta1"
 	v1beta2 "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
-	v1alpha1 "github.com/upbound/provider-azapi/v2/apis/cluster/v1alpha1"
+	v1alpha1cluster "github.com/upbound/provider-azapi/v2/apis/cluster/v1alpha1"
 	v1beta1cluster "github.com/upbound/provider-azapi/v2/apis/cluster/v1beta1"
 )
 
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
+		v1alpha1.SchemeBuilder.AddToScheme,
+		v1alpha1resources.SchemeBuilder.AddToScheme,
 		v1beta1.SchemeBuilder.AddToScheme,
 		v1beta2.SchemeBuilder.AddToScheme,
-		v1alpha1.SchemeBuilder.AddToScheme,
+		v1alpha1cluster.SchemeBuilder.AddToScheme,
 		v1beta1cluster.SchemeBuilder.AddToScheme,
 	)
 }
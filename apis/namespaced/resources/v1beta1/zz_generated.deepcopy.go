@@ -13,6 +13,81 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLockInitParameters) DeepCopyInto(out *AzureLockInitParameters) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Notes != nil {
+		in, out := &in.Notes, &out.Notes
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLockInitParameters.
+func (in *AzureLockInitParameters) DeepCopy() *AzureLockInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLockInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLockObservation) DeepCopyInto(out *AzureLockObservation) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Notes != nil {
+		in, out := &in.Notes, &out.Notes
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLockObservation.
+func (in *AzureLockObservation) DeepCopy() *AzureLockObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLockObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLockParameters) DeepCopyInto(out *AzureLockParameters) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.Notes != nil {
+		in, out := &in.Notes, &out.Notes
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLockParameters.
+func (in *AzureLockParameters) DeepCopy() *AzureLockParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLockParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlaneResource) DeepCopyInto(out *DataPlaneResource) {
 	*out = *in
@@ -48,6 +123,11 @@ func (in *DataPlaneResourceInitParameters) DeepCopyInto(out *DataPlaneResourceIn
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CreateHeaders != nil {
 		in, out := &in.CreateHeaders, &out.CreateHeaders
 		*out = make(map[string]*string, len(*in))
@@ -124,6 +204,16 @@ func (in *DataPlaneResourceInitParameters) DeepCopyInto(out *DataPlaneResourceIn
 			(*out)[key] = outVal
 		}
 	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionRetentionDays != nil {
+		in, out := &in.DeletionRetentionDays, &out.DeletionRetentionDays
+		*out = new(int64)
+		**out = **in
+	}
 	if in.IgnoreCasing != nil {
 		in, out := &in.IgnoreCasing, &out.IgnoreCasing
 		*out = new(bool)
@@ -319,6 +409,11 @@ func (in *DataPlaneResourceObservation) DeepCopyInto(out *DataPlaneResourceObser
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CreateHeaders != nil {
 		in, out := &in.CreateHeaders, &out.CreateHeaders
 		*out = make(map[string]*string, len(*in))
@@ -395,6 +490,26 @@ func (in *DataPlaneResourceObservation) DeepCopyInto(out *DataPlaneResourceObser
 			(*out)[key] = outVal
 		}
 	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionRetentionDays != nil {
+		in, out := &in.DeletionRetentionDays, &out.DeletionRetentionDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeletionScheduledTime != nil {
+		in, out := &in.DeletionScheduledTime, &out.DeletionScheduledTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionState != nil {
+		in, out := &in.DeletionState, &out.DeletionState
+		*out = new(string)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -474,6 +589,11 @@ func (in *DataPlaneResourceObservation) DeepCopyInto(out *DataPlaneResourceObser
 			(*out)[key] = outVal
 		}
 	}
+	if in.RenderedBody != nil {
+		in, out := &in.RenderedBody, &out.RenderedBody
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResponseExportValues != nil {
 		in, out := &in.ResponseExportValues, &out.ResponseExportValues
 		*out = new(v1.JSON)
@@ -568,6 +688,11 @@ func (in *DataPlaneResourceParameters) DeepCopyInto(out *DataPlaneResourceParame
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CreateHeaders != nil {
 		in, out := &in.CreateHeaders, &out.CreateHeaders
 		*out = make(map[string]*string, len(*in))
@@ -644,6 +769,16 @@ func (in *DataPlaneResourceParameters) DeepCopyInto(out *DataPlaneResourceParame
 			(*out)[key] = outVal
 		}
 	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionRetentionDays != nil {
+		in, out := &in.DeletionRetentionDays, &out.DeletionRetentionDays
+		*out = new(int64)
+		**out = **in
+	}
 	if in.IgnoreCasing != nil {
 		in, out := &in.IgnoreCasing, &out.IgnoreCasing
 		*out = new(bool)
@@ -834,6 +969,91 @@ func (in *DataPlaneResourceStatus) DeepCopy() *DataPlaneResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistoryInitParameters) DeepCopyInto(out *HistoryInitParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistoryInitParameters.
+func (in *HistoryInitParameters) DeepCopy() *HistoryInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HistoryInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistoryObservation) DeepCopyInto(out *HistoryObservation) {
+	*out = *in
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputRef != nil {
+		in, out := &in.OutputRef, &out.OutputRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputSha256 != nil {
+		in, out := &in.OutputSha256, &out.OutputSha256
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputTruncated != nil {
+		in, out := &in.OutputTruncated, &out.OutputTruncated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistoryObservation.
+func (in *HistoryObservation) DeepCopy() *HistoryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HistoryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HistoryParameters) DeepCopyInto(out *HistoryParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistoryParameters.
+func (in *HistoryParameters) DeepCopy() *HistoryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HistoryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityInitParameters) DeepCopyInto(out *IdentityInitParameters) {
 	*out = *in
@@ -937,6 +1157,81 @@ func (in *IdentityParameters) DeepCopy() *IdentityParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputStoreInitParameters) DeepCopyInto(out *OutputStoreInitParameters) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStoreInitParameters.
+func (in *OutputStoreInitParameters) DeepCopy() *OutputStoreInitParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputStoreInitParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputStoreObservation) DeepCopyInto(out *OutputStoreObservation) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStoreObservation.
+func (in *OutputStoreObservation) DeepCopy() *OutputStoreObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputStoreObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputStoreParameters) DeepCopyInto(out *OutputStoreParameters) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStoreParameters.
+func (in *OutputStoreParameters) DeepCopy() *OutputStoreParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputStoreParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resource) DeepCopyInto(out *Resource) {
 	*out = *in
@@ -1020,6 +1315,11 @@ func (in *ResourceActionInitParameters) DeepCopyInto(out *ResourceActionInitPara
 			(*out)[key] = outVal
 		}
 	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
 	if in.IgnoreNotFound != nil {
 		in, out := &in.IgnoreNotFound, &out.IgnoreNotFound
 		*out = new(bool)
@@ -1041,6 +1341,11 @@ func (in *ResourceActionInitParameters) DeepCopyInto(out *ResourceActionInitPara
 		*out = new(string)
 		**out = **in
 	}
+	if in.OutputStore != nil {
+		in, out := &in.OutputStore, &out.OutputStore
+		*out = new(OutputStoreInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.QueryParameters != nil {
 		in, out := &in.QueryParameters, &out.QueryParameters
 		*out = make(map[string][]*string, len(*in))
@@ -1078,6 +1383,16 @@ func (in *ResourceActionInitParameters) DeepCopyInto(out *ResourceActionInitPara
 		*out = new(ResourceActionRetryInitParameters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunPolicy != nil {
+		in, out := &in.RunPolicy, &out.RunPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
 	if in.SensitiveResponseExportValues != nil {
 		in, out := &in.SensitiveResponseExportValues, &out.SensitiveResponseExportValues
 		*out = new(v1.JSON)
@@ -1171,6 +1486,18 @@ func (in *ResourceActionObservation) DeepCopyInto(out *ResourceActionObservation
 			(*out)[key] = outVal
 		}
 	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]HistoryObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -1181,6 +1508,11 @@ func (in *ResourceActionObservation) DeepCopyInto(out *ResourceActionObservation
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = new(string)
+		**out = **in
+	}
 	if in.Locks != nil {
 		in, out := &in.Locks, &out.Locks
 		*out = make([]*string, len(*in))
@@ -1197,11 +1529,21 @@ func (in *ResourceActionObservation) DeepCopyInto(out *ResourceActionObservation
 		*out = new(string)
 		**out = **in
 	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = new(string)
+		**out = **in
+	}
 	if in.Output != nil {
 		in, out := &in.Output, &out.Output
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OutputStore != nil {
+		in, out := &in.OutputStore, &out.OutputStore
+		*out = new(OutputStoreObservation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.QueryParameters != nil {
 		in, out := &in.QueryParameters, &out.QueryParameters
 		*out = make(map[string][]*string, len(*in))
@@ -1239,6 +1581,16 @@ func (in *ResourceActionObservation) DeepCopyInto(out *ResourceActionObservation
 		*out = new(ResourceActionRetryObservation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunPolicy != nil {
+		in, out := &in.RunPolicy, &out.RunPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
 	if in.SensitiveResponseExportValues != nil {
 		in, out := &in.SensitiveResponseExportValues, &out.SensitiveResponseExportValues
 		*out = new(v1.JSON)
@@ -1295,6 +1647,11 @@ func (in *ResourceActionParameters) DeepCopyInto(out *ResourceActionParameters)
 			(*out)[key] = outVal
 		}
 	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
 	if in.IgnoreNotFound != nil {
 		in, out := &in.IgnoreNotFound, &out.IgnoreNotFound
 		*out = new(bool)
@@ -1316,6 +1673,11 @@ func (in *ResourceActionParameters) DeepCopyInto(out *ResourceActionParameters)
 		*out = new(string)
 		**out = **in
 	}
+	if in.OutputStore != nil {
+		in, out := &in.OutputStore, &out.OutputStore
+		*out = new(OutputStoreParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.QueryParameters != nil {
 		in, out := &in.QueryParameters, &out.QueryParameters
 		*out = make(map[string][]*string, len(*in))
@@ -1353,6 +1715,16 @@ func (in *ResourceActionParameters) DeepCopyInto(out *ResourceActionParameters)
 		*out = new(ResourceActionRetryParameters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RunPolicy != nil {
+		in, out := &in.RunPolicy, &out.RunPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
 	if in.SensitiveResponseExportValues != nil {
 		in, out := &in.SensitiveResponseExportValues, &out.SensitiveResponseExportValues
 		*out = new(v1.JSON)
@@ -1556,11 +1928,21 @@ func (in *ResourceActionStatus) DeepCopy() *ResourceActionStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceInitParameters) DeepCopyInto(out *ResourceInitParameters) {
 	*out = *in
+	if in.AzureLock != nil {
+		in, out := &in.AzureLock, &out.AzureLock
+		*out = new(AzureLockInitParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Body != nil {
 		in, out := &in.Body, &out.Body
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CreateHeaders != nil {
 		in, out := &in.CreateHeaders, &out.CreateHeaders
 		*out = make(map[string]*string, len(*in))
@@ -1637,6 +2019,16 @@ func (in *ResourceInitParameters) DeepCopyInto(out *ResourceInitParameters) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionRetentionDays != nil {
+		in, out := &in.DeletionRetentionDays, &out.DeletionRetentionDays
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Identity != nil {
 		in, out := &in.Identity, &out.Identity
 		*out = make([]IdentityInitParameters, len(*in))
@@ -1892,11 +2284,31 @@ func (in *ResourceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceObservation) DeepCopyInto(out *ResourceObservation) {
 	*out = *in
+	if in.AzureLock != nil {
+		in, out := &in.AzureLock, &out.AzureLock
+		*out = new(AzureLockObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureLockID != nil {
+		in, out := &in.AzureLockID, &out.AzureLockID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AzureLockState != nil {
+		in, out := &in.AzureLockState, &out.AzureLockState
+		*out = new(string)
+		**out = **in
+	}
 	if in.Body != nil {
 		in, out := &in.Body, &out.Body
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CreateHeaders != nil {
 		in, out := &in.CreateHeaders, &out.CreateHeaders
 		*out = make(map[string]*string, len(*in))
@@ -1973,6 +2385,26 @@ func (in *ResourceObservation) DeepCopyInto(out *ResourceObservation) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionRetentionDays != nil {
+		in, out := &in.DeletionRetentionDays, &out.DeletionRetentionDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeletionScheduledTime != nil {
+		in, out := &in.DeletionScheduledTime, &out.DeletionScheduledTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionState != nil {
+		in, out := &in.DeletionState, &out.DeletionState
+		*out = new(string)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -2058,6 +2490,22 @@ func (in *ResourceObservation) DeepCopyInto(out *ResourceObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PropagatedTags != nil {
+		in, out := &in.PropagatedTags, &out.PropagatedTags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.ReadHeaders != nil {
 		in, out := &in.ReadHeaders, &out.ReadHeaders
 		*out = make(map[string]*string, len(*in))
@@ -2096,6 +2544,11 @@ func (in *ResourceObservation) DeepCopyInto(out *ResourceObservation) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.RenderedBody != nil {
+		in, out := &in.RenderedBody, &out.RenderedBody
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResponseExportValues != nil {
 		in, out := &in.ResponseExportValues, &out.ResponseExportValues
 		*out = new(v1.JSON)
@@ -2206,11 +2659,21 @@ func (in *ResourceObservation) DeepCopy() *ResourceObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceParameters) DeepCopyInto(out *ResourceParameters) {
 	*out = *in
+	if in.AzureLock != nil {
+		in, out := &in.AzureLock, &out.AzureLock
+		*out = new(AzureLockParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Body != nil {
 		in, out := &in.Body, &out.Body
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CreateHeaders != nil {
 		in, out := &in.CreateHeaders, &out.CreateHeaders
 		*out = make(map[string]*string, len(*in))
@@ -2287,6 +2750,16 @@ func (in *ResourceParameters) DeepCopyInto(out *ResourceParameters) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.DeletionMode != nil {
+		in, out := &in.DeletionMode, &out.DeletionMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionRetentionDays != nil {
+		in, out := &in.DeletionRetentionDays, &out.DeletionRetentionDays
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Identity != nil {
 		in, out := &in.Identity, &out.Identity
 		*out = make([]IdentityParameters, len(*in))
@@ -2853,6 +3326,11 @@ func (in *UpdateResourceInitParameters) DeepCopyInto(out *UpdateResourceInitPara
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.IgnoreCasing != nil {
 		in, out := &in.IgnoreCasing, &out.IgnoreCasing
 		*out = new(bool)
@@ -3080,6 +3558,11 @@ func (in *UpdateResourceObservation) DeepCopyInto(out *UpdateResourceObservation
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
 		*out = new(string)
@@ -3148,6 +3631,22 @@ func (in *UpdateResourceObservation) DeepCopyInto(out *UpdateResourceObservation
 		*out = new(string)
 		**out = **in
 	}
+	if in.PropagatedTags != nil {
+		in, out := &in.PropagatedTags, &out.PropagatedTags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.ReadHeaders != nil {
 		in, out := &in.ReadHeaders, &out.ReadHeaders
 		*out = make(map[string]*string, len(*in))
@@ -3186,6 +3685,11 @@ func (in *UpdateResourceObservation) DeepCopyInto(out *UpdateResourceObservation
 			(*out)[key] = outVal
 		}
 	}
+	if in.RenderedBody != nil {
+		in, out := &in.RenderedBody, &out.RenderedBody
+		*out = new(string)
+		**out = **in
+	}
 	if in.ResourceID != nil {
 		in, out := &in.ResourceID, &out.ResourceID
 		*out = new(string)
@@ -3285,6 +3789,11 @@ func (in *UpdateResourceParameters) DeepCopyInto(out *UpdateResourceParameters)
 		*out = new(v1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BodyTemplating != nil {
+		in, out := &in.BodyTemplating, &out.BodyTemplating
+		*out = new(bool)
+		**out = **in
+	}
 	if in.IgnoreCasing != nil {
 		in, out := &in.IgnoreCasing, &out.IgnoreCasing
 		*out = new(bool)
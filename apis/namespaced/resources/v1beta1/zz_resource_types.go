@@ -15,6 +15,35 @@ import (
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
+type AzureLockInitParameters struct {
+
+	// The level of the lock, value must be one of: `CanNotDelete`, `ReadOnly`.
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// The notes of the lock, at most 512 characters.
+	Notes *string `json:"notes,omitempty" tf:"notes,omitempty"`
+}
+
+type AzureLockObservation struct {
+
+	// The level of the lock, value must be one of: `CanNotDelete`, `ReadOnly`.
+	Level *string `json:"level,omitempty" tf:"level,omitempty"`
+
+	// The notes of the lock, at most 512 characters.
+	Notes *string `json:"notes,omitempty" tf:"notes,omitempty"`
+}
+
+type AzureLockParameters struct {
+
+	// The level of the lock, value must be one of: `CanNotDelete`, `ReadOnly`.
+	// +kubebuilder:validation:Optional
+	Level *string `json:"level" tf:"level,omitempty"`
+
+	// The notes of the lock, at most 512 characters.
+	// +kubebuilder:validation:Optional
+	Notes *string `json:"notes,omitempty" tf:"notes,omitempty"`
+}
+
 type IdentityInitParameters struct {
 
 	// A list of User Managed Identity ID's which should be assigned to the azure resource.
@@ -52,9 +81,15 @@ type IdentityParameters struct {
 
 type ResourceInitParameters struct {
 
+	// The Azure management lock of the resource. The lock is lifted while the resource is updated or deleted by the provider and removed when this block is removed.
+	AzureLock *AzureLockInitParameters `json:"azureLock,omitempty" tf:"azure_lock,omitempty"`
+
 	// A dynamic attribute that contains the request body.
 	Body *v1.JSON `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// A mapping of headers to be sent with the create request.
 	// +mapType=granular
 	CreateHeaders map[string]*string `json:"createHeaders,omitempty" tf:"create_headers,omitempty"`
@@ -69,6 +104,12 @@ type ResourceInitParameters struct {
 	// A mapping of query parameters to be sent with the delete request.
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
 	Identity []IdentityInitParameters `json:"identity,omitempty" tf:"identity,omitempty"`
 
 	// Whether ignore the casing of the property names in the response body. Defaults to `false`.
@@ -175,9 +216,21 @@ type ResourceInitParameters struct {
 
 type ResourceObservation struct {
 
+	// The Azure management lock of the resource. The lock is lifted while the resource is updated or deleted by the provider and removed when this block is removed.
+	AzureLock *AzureLockObservation `json:"azureLock,omitempty" tf:"azure_lock,omitempty"`
+
+	// The ID of the Azure management lock of the resource.
+	AzureLockID *string `json:"azureLockId,omitempty" tf:"azure_lock_id,omitempty"`
+
+	// The state of the Azure management lock of the resource. It's `Locked` while the lock is in place and `Lifted` while it's lifted for an update or the deletion of the resource.
+	AzureLockState *string `json:"azureLockState,omitempty" tf:"azure_lock_state,omitempty"`
+
 	// A dynamic attribute that contains the request body.
 	Body *v1.JSON `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// A mapping of headers to be sent with the create request.
 	// +mapType=granular
 	CreateHeaders map[string]*string `json:"createHeaders,omitempty" tf:"create_headers,omitempty"`
@@ -192,6 +245,18 @@ type ResourceObservation struct {
 	// A mapping of query parameters to be sent with the delete request.
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
+	// The time the resource is deleted at with the `DeleteWithRetention` deletion mode, in RFC 3339 format.
+	DeletionScheduledTime *string `json:"deletionScheduledTime,omitempty" tf:"deletion_scheduled_time,omitempty"`
+
+	// The deletion state of the resource. It's `PendingDelete` while the deletion is held back by the `DeleteWithRetention` deletion mode.
+	DeletionState *string `json:"deletionState,omitempty" tf:"deletion_state,omitempty"`
+
 	ID *string `json:"id,omitempty" tf:"id,omitempty"`
 
 	Identity []IdentityObservation `json:"identity,omitempty" tf:"identity,omitempty"`
@@ -247,6 +312,9 @@ type ResourceObservation struct {
 	// For type `Microsoft.Resources/resourceGroups`, the `parent_id` could be omitted, it defaults to subscription ID specified in provider or the default subscription (You could check the default subscription by azure cli command: `az account show`).
 	ParentID *string `json:"parentId,omitempty" tf:"parent_id,omitempty"`
 
+	// The tags propagated from the metadata of the managed resource by the tag propagation of its provider config.
+	PropagatedTags map[string]*string `json:"propagatedTags,omitempty" tf:"propagated_tags,omitempty"`
+
 	// A mapping of headers to be sent with the read request.
 	// +mapType=granular
 	ReadHeaders map[string]*string `json:"readHeaders,omitempty" tf:"read_headers,omitempty"`
@@ -254,6 +322,9 @@ type ResourceObservation struct {
 	// A mapping of query parameters to be sent with the read request.
 	ReadQueryParameters map[string][]*string `json:"readQueryParameters,omitempty" tf:"read_query_parameters,omitempty"`
 
+	// The `body` rendered with `body_templating`, in JSON.
+	RenderedBody *string `json:"renderedBody,omitempty" tf:"rendered_body,omitempty"`
+
 	// The attribute can accept either a list or a map.
 	//
 	// - **List**: A list of paths that need to be exported from the response body. Setting it to `["*"]` will export the full response body. Here's an example. If it sets to `["properties.loginServer", "properties.policies.quarantinePolicy.status"]`, it will set the following HCL object to the computed property output.
@@ -312,10 +383,18 @@ type ResourceObservation struct {
 
 type ResourceParameters struct {
 
+	// The Azure management lock of the resource. The lock is lifted while the resource is updated or deleted by the provider and removed when this block is removed.
+	// +kubebuilder:validation:Optional
+	AzureLock *AzureLockParameters `json:"azureLock,omitempty" tf:"azure_lock,omitempty"`
+
 	// A dynamic attribute that contains the request body.
 	// +kubebuilder:validation:Optional
 	Body *v1.JSON `json:"body,omitempty" tf:"body,omitempty"`
 
+	// Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.
+	// +kubebuilder:validation:Optional
+	BodyTemplating *bool `json:"bodyTemplating,omitempty" tf:"body_templating,omitempty"`
+
 	// A mapping of headers to be sent with the create request.
 	// +kubebuilder:validation:Optional
 	// +mapType=granular
@@ -334,6 +413,14 @@ type ResourceParameters struct {
 	// +kubebuilder:validation:Optional
 	DeleteQueryParameters map[string][]*string `json:"deleteQueryParameters,omitempty" tf:"delete_query_parameters,omitempty"`
 
+	// How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.
+	// +kubebuilder:validation:Optional
+	DeletionMode *string `json:"deletionMode,omitempty" tf:"deletion_mode,omitempty"`
+
+	// The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.
+	// +kubebuilder:validation:Optional
+	DeletionRetentionDays *int64 `json:"deletionRetentionDays,omitempty" tf:"deletion_retention_days,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	Identity []IdentityParameters `json:"identity,omitempty" tf:"identity,omitempty"`
 
@@ -543,7 +630,6 @@ type ResourceStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:storageversion
 
 // Resource is the Schema for the Resources API. This resource can manage any Azure Resource Manager resource.
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
@@ -4,7 +4,7 @@
 
 // Code generated by upjet. DO NOT EDIT.
 
-package v1beta1
+package v1beta2
 
 // Hub marks this type as a conversion hub.
 func (tr *DataPlaneResource) Hub() {}
@@ -15,6 +15,79 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// Defaults of the managed resources using this provider config, which
+	// the bodies of the managed resources with body templating can refer
+	// to, e.g. {{ .Defaults.Location }}.
+	// +optional
+	Defaults *ProviderDefaults `json:"defaults,omitempty"`
+
+	// TagPropagation propagates the metadata of the managed resources using
+	// this provider config into the tags of their Azure resources. The tags
+	// set in the managed resources take precedence over the propagated
+	// ones. Tags are not propagated if unset.
+	// +optional
+	TagPropagation *TagPropagation `json:"tagPropagation,omitempty"`
+}
+
+// ProviderDefaults are the defaults of the managed resources using a
+// provider config.
+type ProviderDefaults struct {
+	// Location of the resources, e.g. westeurope.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Tags of the resources.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// TagPropagation configures the propagation of the metadata of the managed
+// resources into the tags of their Azure resources.
+type TagPropagation struct {
+	// CrossplaneMetadata propagates the kind, name and namespace of the
+	// managed resources, and the name of their composite resource, into the
+	// crossplane-kind, crossplane-name, crossplane-namespace and
+	// crossplane-composite tags.
+	// +kubebuilder:default=true
+	// +optional
+	CrossplaneMetadata *bool `json:"crossplaneMetadata,omitempty"`
+
+	// Labels selects the labels of the managed resources propagated into
+	// tags. No label is propagated if unset.
+	// +optional
+	Labels *TagSelector `json:"labels,omitempty"`
+
+	// Annotations selects the annotations of the managed resources
+	// propagated into tags. No annotation is propagated if unset.
+	// +optional
+	Annotations *TagSelector `json:"annotations,omitempty"`
+}
+
+// A TagSelector selects the labels or annotations propagated into tags and
+// maps their keys to tag names.
+type TagSelector struct {
+	// Include lists the patterns of the propagated keys, e.g. team or
+	// app.kubernetes.io/*, in the syntax of Go's path.Match. All keys are
+	// propagated if empty.
+	// +optional
+	Include []string `json:"include,omitempty"`
+
+	// Exclude lists the patterns of the keys that are not propagated. They
+	// take precedence over include.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+
+	// Mappings maps keys to the names of their tags, e.g. app.kubernetes.io/name
+	// to application.
+	// +optional
+	Mappings map[string]string `json:"mappings,omitempty"`
+
+	// Prefix is prepended to the keys without mapping to form the names of
+	// their tags. The characters that are not allowed in tag names, e.g. /,
+	// are replaced with -.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
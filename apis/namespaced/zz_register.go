@@ -10,16 +10,22 @@ package namespaced
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	v1alpha1 "github.com/upbound/provider-azapi/v2/apis/namespaced/data/v1alpha1"
+	v1alpha1resources "github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1alpha1"
 	v1beta1 "github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1beta1"
-	v1alpha1 "github.com/upbound/provider-azapi/v2/apis/namespaced/v1alpha1"
+	v1beta2 "github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1beta2"
+	v1alpha1namespaced "github.com/upbound/provider-azapi/v2/apis/namespaced/v1alpha1"
 	v1beta1namespaced "github.com/upbound/provider-azapi/v2/apis/namespaced/v1beta1"
 )
 
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
-		v1beta1.SchemeBuilder.AddToScheme,
 		v1alpha1.SchemeBuilder.AddToScheme,
+		v1alpha1resources.SchemeBuilder.AddToScheme,
+		v1beta1.SchemeBuilder.AddToScheme,
+		v1beta2.SchemeBuilder.AddToScheme,
+		v1alpha1namespaced.SchemeBuilder.AddToScheme,
 		v1beta1namespaced.SchemeBuilder.AddToScheme,
 	)
 }
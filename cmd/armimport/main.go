@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/upbound/provider-azapi/v2/internal/armtemplate"
+	"github.com/upbound/provider-azapi/v2/internal/manifest"
+)
+
+func main() {
+	var (
+		app                = kingpin.New(filepath.Base(os.Args[0]), "Translates an ARM deployment template, e.g. compiled from Bicep, into AzAPI Resource manifests.").DefaultEnvars()
+		templateFile       = app.Arg("template", "Path of the ARM deployment template JSON.").Required().ExistingFile()
+		parametersFile     = app.Flag("parameters", "Path of the ARM deployment parameters JSON.").Short('p').ExistingFile()
+		subscriptionID     = app.Flag("subscription-id", "ID of the subscription the template is deployed to.").String()
+		resourceGroup      = app.Flag("resource-group", "Name of the resource group the template is deployed to, for the resource group deployments.").Short('g').String()
+		managementGroupID  = app.Flag("management-group-id", "ID of the management group the template is deployed to, for the management group deployments.").String()
+		tenantID           = app.Flag("tenant-id", "ID of the tenant returned by subscription().tenantId.").String()
+		location           = app.Flag("location", "Location of the resource group returned by resourceGroup().location.").String()
+		namespace          = app.Flag("namespace", "Namespace of the namespaced Resources. Cluster-scoped Resources are emitted if unset.").Short('n').String()
+		providerConfigKind = app.Flag("provider-config-kind", "Kind of the provider config referenced by the namespaced Resources.").Default("ClusterProviderConfig").Enum("ClusterProviderConfig", "ProviderConfig")
+		providerConfigName = app.Flag("provider-config-name", "Name of the provider config referenced by the Resources. The default provider config is used if unset.").String()
+		output             = app.Flag("output", "Path of the file the Resource manifests are written to. Defaults to the standard output.").Short('o').String()
+		strict             = app.Flag("strict", "Fail if any construct of the template cannot be translated.").Default("false").Bool()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	t, err := os.ReadFile(filepath.Clean(*templateFile))
+	kingpin.FatalIfError(err, "Cannot read the template")
+	var p []byte
+	if *parametersFile != "" {
+		p, err = os.ReadFile(filepath.Clean(*parametersFile))
+		kingpin.FatalIfError(err, "Cannot read the parameters")
+	}
+	res, err := armtemplate.Convert(t, p, armtemplate.Options{
+		Manifest: manifest.Options{
+			Namespaced:         *namespace != "",
+			Namespace:          *namespace,
+			ProviderConfigKind: *providerConfigKind,
+			ProviderConfigName: *providerConfigName,
+		},
+		SubscriptionID:    *subscriptionID,
+		ResourceGroup:     *resourceGroup,
+		ManagementGroupID: *managementGroupID,
+		TenantID:          *tenantID,
+		Location:          *location,
+	})
+	kingpin.FatalIfError(err, "Cannot translate the template")
+	for _, w := range res.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
+	if *strict && len(res.Warnings) > 0 {
+		kingpin.Fatalf("%d constructs of the template cannot be translated", len(res.Warnings))
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		out, err = os.Create(filepath.Clean(*output))
+		kingpin.FatalIfError(err, "Cannot create the output file")
+		defer out.Close() //nolint:errcheck // closed on exit
+	}
+	for _, r := range res.Resources {
+		b, err := yaml.Marshal(r.Object)
+		kingpin.FatalIfError(err, "Cannot marshal the Resource %s", r.GetName())
+		_, err = fmt.Fprintf(out, "---\n%s", b)
+		kingpin.FatalIfError(err, "Cannot write the Resource %s", r.GetName())
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/upbound/provider-azapi/v2/config/typed"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+	"github.com/upbound/provider-azapi/v2/internal/export"
+	"github.com/upbound/provider-azapi/v2/internal/manifest"
+)
+
+const (
+	modeObserve = "observe"
+	modeFull    = "full"
+)
+
+func main() {
+	var (
+		app                = kingpin.New(filepath.Base(os.Args[0]), "Exports the existing Azure resources of a resource group or subscription as AzAPI Resource manifests.").DefaultEnvars()
+		credentials        = app.Flag("credentials", "Path of the credentials, in the format of the ProviderConfig secrets, i.e. a JSON object with the subscriptionId, tenantId, clientId, clientSecret and optionally the environment, i.e. public, usgovernment or china. The default Azure credential chain is used if unset.").ExistingFile()
+		subscriptionID     = app.Flag("subscription-id", "ID of the subscription to export. Defaults to the subscription of the credentials.").String()
+		resourceGroup      = app.Flag("resource-group", "Name of the resource group to export. The whole subscription, including its resource groups, is exported if unset.").Short('g').String()
+		mode               = app.Flag("mode", "Management mode of the exported Resources: observe exports them with the Observe management policy, full with the full management.").Default(modeObserve).Enum(modeObserve, modeFull)
+		namespace          = app.Flag("namespace", "Namespace of the namespaced Resources. Cluster-scoped Resources are emitted if unset.").Short('n').String()
+		providerConfigKind = app.Flag("provider-config-kind", "Kind of the provider config referenced by the namespaced Resources.").Default("ClusterProviderConfig").Enum("ClusterProviderConfig", "ProviderConfig")
+		providerConfigName = app.Flag("provider-config-name", "Name of the provider config referenced by the Resources. The default provider config is used if unset.").String()
+		azureSchemaDir     = app.Flag("azure-schema-dir", "Directory of the Azure schema the read-only properties are determined with. Defaults to the "+typed.SchemaPath+" directory of the azapi Terraform provider module, if it's in the module cache.").String()
+		endpoint           = app.Flag("endpoint", "Resource Manager endpoint. Defaults to the endpoint of the environment of the credentials.").String()
+		output             = app.Flag("output", "Path of the file the Resource manifests are written to. Defaults to the standard output.").Short('o').String()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	creds := arm.Credentials{}
+	if *credentials != "" {
+		b, err := os.ReadFile(filepath.Clean(*credentials))
+		kingpin.FatalIfError(err, "Cannot read the credentials")
+		creds, err = arm.ParseCredentials(b)
+		kingpin.FatalIfError(err, "Cannot parse the credentials")
+	}
+	if *subscriptionID == "" {
+		*subscriptionID = creds.SubscriptionID
+	}
+	if *subscriptionID == "" {
+		kingpin.Fatalf("the subscription ID must be set, either with --subscription-id or in the credentials")
+	}
+	cred, err := arm.NewTokenCredential(creds)
+	kingpin.FatalIfError(err, "Cannot initialize the Azure credential")
+	if *endpoint == "" {
+		*endpoint, err = creds.Endpoint()
+		kingpin.FatalIfError(err, "Cannot determine the Resource Manager endpoint")
+	}
+
+	dir := *azureSchemaDir
+	if dir == "" {
+		if dir, err = typed.ModuleSchemaDir(); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s, only the read-only properties of the typed kinds are known\n", err)
+		}
+	}
+	var schema *typed.Schema
+	if dir != "" {
+		schema, err = typed.LoadSchema(dir)
+		kingpin.FatalIfError(err, "Cannot load the Azure schema")
+	}
+
+	e, err := export.NewExporter(arm.NewClient(cred, arm.WithEndpoint(*endpoint)), export.Options{
+		Manifest: manifest.Options{
+			Namespaced:         *namespace != "",
+			Namespace:          *namespace,
+			ProviderConfigKind: *providerConfigKind,
+			ProviderConfigName: *providerConfigName,
+		},
+		ObserveOnly: *mode == modeObserve,
+		Schema:      schema,
+		Log: func(format string, args ...any) {
+			fmt.Fprintf(os.Stderr, format+"\n", args...)
+		},
+	})
+	kingpin.FatalIfError(err, "Cannot create the exporter")
+	scope := "/subscriptions/" + *subscriptionID
+	if *resourceGroup != "" {
+		scope += "/resourceGroups/" + *resourceGroup
+	}
+	res, err := e.Export(context.Background(), scope)
+	kingpin.FatalIfError(err, "Cannot export %s", scope)
+	for _, w := range res.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		out, err = os.Create(filepath.Clean(*output))
+		kingpin.FatalIfError(err, "Cannot create the output file")
+		defer out.Close() //nolint:errcheck // closed on exit
+	}
+	for _, r := range res.Resources {
+		b, err := yaml.Marshal(r.Object)
+		kingpin.FatalIfError(err, "Cannot marshal the Resource %s", r.GetName())
+		_, err = fmt.Fprintf(out, "---\n%s", b)
+		kingpin.FatalIfError(err, "Cannot write the Resource %s", r.GetName())
+	}
+}
@@ -5,25 +5,51 @@ Copyright 2021 Upbound Inc.
 package main
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/alecthomas/kingpin/v2"
 	"github.com/crossplane/upjet/v2/pkg/pipeline"
 
 	"github.com/upbound/provider-azapi/v2/config"
+	"github.com/upbound/provider-azapi/v2/config/typed"
 )
 
 func main() {
-	if len(os.Args) < 2 || os.Args[1] == "" {
-		panic("root directory is required to be given as argument")
-	}
-	rootDir := os.Args[1]
-	absRootDir, err := filepath.Abs(rootDir)
+	var (
+		app            = kingpin.New(filepath.Base(os.Args[0]), "Generates the AzAPI provider.")
+		rootDir        = app.Arg("root-dir", "Root directory of the provider repository.").Required().String()
+		typedKinds     = app.Flag("typed-kinds", "File listing the Azure resource types to generate typed kinds for, one <type>@<apiVersion> entry per line, optionally followed by =<Kind>, e.g. Microsoft.Storage/storageAccounts@2023-01-01. The typed kinds and the untaggable resource types are not regenerated if unset.").String()
+		azureSchemaDir = app.Flag("azure-schema-dir", "Directory of the Azure schema the typed kinds and the untaggable resource types are derived from. Defaults to the "+typed.SchemaPath+" directory of the azapi Terraform provider module.").String()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	absRootDir, err := filepath.Abs(*rootDir)
 	if err != nil {
-		panic(fmt.Sprintf("cannot calculate the absolute path with %s", rootDir))
+		panic(fmt.Sprintf("cannot calculate the absolute path with %s", *rootDir))
+	}
+	if *typedKinds != "" {
+		entries, err := readEntries(*typedKinds)
+		kingpin.FatalIfError(err, "Cannot read the typed kinds")
+		dir := *azureSchemaDir
+		if dir == "" {
+			dir, err = typed.ModuleSchemaDir()
+			kingpin.FatalIfError(err, "Cannot find the azapi Terraform provider module")
+		}
+		kinds, err := typed.Load(dir, entries)
+		kingpin.FatalIfError(err, "Cannot load the typed kinds from the Azure schema")
+		writeJSON(filepath.Join(absRootDir, "config", "typed", "kinds.json"), kinds, "typed kinds")
+		typed.SetKinds(kinds)
+		s, err := typed.LoadSchema(dir)
+		kingpin.FatalIfError(err, "Cannot load the Azure schema")
+		untaggable, err := s.UntaggableTypes()
+		kingpin.FatalIfError(err, "Cannot list the untaggable resource types of the Azure schema")
+		writeJSON(filepath.Join(absRootDir, "config", "typed", "untaggable.json"), untaggable, "untaggable resource types")
 	}
 	pc, err := config.GetProvider(context.Background(), true)
 	kingpin.FatalIfError(err, "Cannot initialize the cluster-scoped provider configuration")
@@ -31,3 +57,30 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot initialize the namespaced provider configuration")
 	pipeline.Run(pc, pns, absRootDir)
 }
+
+// writeJSON writes the supplied value as indented JSON to the supplied file.
+func writeJSON(path string, v any, what string) {
+	b, err := json.MarshalIndent(v, "", "  ")
+	kingpin.FatalIfError(err, "Cannot marshal the %s", what)
+	kingpin.FatalIfError(os.WriteFile(path, append(b, '\n'), 0o644), "Cannot write the %s", what) //nolint:gosec // a source file of the repository
+}
+
+// readEntries returns the non-empty lines of the supplied file, except for
+// the comments starting with #.
+func readEntries(path string) ([]string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck // read only
+	var entries []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		entries = append(entries, l)
+	}
+	return entries, s.Err()
+}
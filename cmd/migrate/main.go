@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/kingpin/v2"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/provider-azapi/v2/internal/migration"
+)
+
+func main() {
+	var (
+		app                = kingpin.New(filepath.Base(os.Args[0]), "Migrates cluster-scoped AzAPI managed resources to namespaced ones without deleting the Azure resources.").DefaultEnvars()
+		kind               = app.Arg("kind", "Kind of the managed resources to migrate: "+strings.Join(migration.Kinds, ", ")+".").Required().Enum(migration.Kinds...)
+		names              = app.Arg("names", "Names of the cluster-scoped managed resources to migrate. All the managed resources matching the selector are migrated if no name is given.").Strings()
+		selector           = app.Flag("selector", "Label selector of the cluster-scoped managed resources to migrate, if no name is given.").Short('l').Default("").String()
+		namespace          = app.Flag("namespace", "Namespace of the namespaced managed resources.").Short('n').Required().String()
+		providerConfigKind = app.Flag("provider-config-kind", "Kind of the provider config referenced by the namespaced managed resources.").Default("ClusterProviderConfig").Enum("ClusterProviderConfig", "ProviderConfig")
+		providerConfigName = app.Flag("provider-config-name", "Name of the provider config referenced by the namespaced managed resources. Defaults to the name of the provider config of each cluster-scoped managed resource.").Default("").String()
+		keepLegacy         = app.Flag("keep-legacy", "Keep the orphaned cluster-scoped managed resources instead of deleting them.").Default("false").Bool()
+		readyTimeout       = app.Flag("ready-timeout", "How long to wait for each namespaced managed resource to become ready before handing it over the management.").Default("10m").Duration()
+		dryRun             = app.Flag("dry-run", "Print the namespaced managed resources and the migration steps without changing anything.").Default("false").Bool()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	sel, err := labels.Parse(*selector)
+	kingpin.FatalIfError(err, "Cannot parse the label selector")
+	cfg, err := ctrl.GetConfig()
+	kingpin.FatalIfError(err, "Cannot get API server rest config")
+	c, err := client.New(cfg, client.Options{})
+	kingpin.FatalIfError(err, "Cannot create the Kubernetes client")
+
+	m := migration.NewMigrator(c, migration.Options{
+		Namespace:          *namespace,
+		ProviderConfigKind: *providerConfigKind,
+		ProviderConfigName: *providerConfigName,
+		KeepLegacy:         *keepLegacy,
+		ReadyTimeout:       *readyTimeout,
+		Log: func(format string, args ...any) {
+			fmt.Fprintf(os.Stderr, format+"\n", args...)
+		},
+	})
+	ctx := context.Background()
+	plans, err := m.Plans(ctx, *kind, *names, sel)
+	kingpin.FatalIfError(err, "Cannot plan the migration")
+	if len(plans) == 0 {
+		fmt.Fprintln(os.Stderr, "No managed resource to migrate")
+		return
+	}
+	for _, p := range plans {
+		if *dryRun {
+			b, err := migration.DryRun(p)
+			kingpin.FatalIfError(err, "Cannot print the migration of %s", p.Legacy.GetName())
+			fmt.Print(string(b))
+			continue
+		}
+		for _, w := range p.Warnings {
+			fmt.Fprintf(os.Stderr, "warning: %s %s: %s\n", p.Legacy.GetKind(), p.Legacy.GetName(), w)
+		}
+		kingpin.FatalIfError(m.Migrate(ctx, p), "Cannot migrate %s %s", p.Legacy.GetKind(), p.Legacy.GetName())
+	}
+}
@@ -29,11 +29,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -45,9 +48,14 @@ import (
 	"github.com/upbound/provider-azapi/v2/config"
 	"github.com/upbound/provider-azapi/v2/internal/bootcheck"
 	"github.com/upbound/provider-azapi/v2/internal/clients"
+	"github.com/upbound/provider-azapi/v2/internal/clients/resourcegraph"
 	controllercluster "github.com/upbound/provider-azapi/v2/internal/controller/cluster"
+	"github.com/upbound/provider-azapi/v2/internal/controller/cluster/providerconfig"
 	controllernamespaced "github.com/upbound/provider-azapi/v2/internal/controller/namespaced"
+	"github.com/upbound/provider-azapi/v2/internal/controller/resourceset"
+	"github.com/upbound/provider-azapi/v2/internal/drift"
 	"github.com/upbound/provider-azapi/v2/internal/features"
+	"github.com/upbound/provider-azapi/v2/internal/storageversion"
 	"github.com/upbound/provider-azapi/v2/internal/version"
 )
 
@@ -82,6 +90,25 @@ func main() {
 		enableManagementPolicies = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
 		enableChangeLogs         = app.Flag("enable-changelogs", "Enable support for capturing change logs during reconciliation.").Default("false").Envar("ENABLE_CHANGE_LOGS").Bool()
 
+		enableResourceGraphObserve = app.Flag("enable-resource-graph-observe", "Skip the individual reads of the azapi_resource instances that Azure Resource Graph reports as unchanged.").Default("false").Envar("ENABLE_RESOURCE_GRAPH_OBSERVE").Bool()
+		resourceGraphInterval      = app.Flag("resource-graph-interval", "Interval at which Azure Resource Graph is queried for each subscription in use.").Default("5m").Envar("RESOURCE_GRAPH_INTERVAL").Duration()
+		resourceGraphEndpoint      = app.Flag("resource-graph-endpoint", "Resource Manager endpoint used for the Azure Resource Graph queries. Defaults to the endpoint of the environment of the credentials of each ProviderConfig, e.g. of a sovereign cloud.").Default("").Envar("RESOURCE_GRAPH_ENDPOINT").String()
+
+		driftWebhookAddress        = app.Flag("drift-webhook-address", "The address the Event Grid drift webhook listens on. The webhook is disabled if empty.").Default("").Envar("DRIFT_WEBHOOK_ADDRESS").String()
+		driftWebhookToken          = app.Flag("drift-webhook-token", "Token the Event Grid subscription must pass in the token query parameter of the drift webhook URL. Required unless --drift-webhook-allow-unauthenticated is set.").Default("").Envar("DRIFT_WEBHOOK_TOKEN").String()
+		driftWebhookAllowUnauth    = app.Flag("drift-webhook-allow-unauthenticated", "Accept the Event Grid drift webhook deliveries without a token, e.g. behind an authenticating proxy.").Default("false").Envar("DRIFT_WEBHOOK_ALLOW_UNAUTHENTICATED").Bool()
+		driftEventHubName          = app.Flag("drift-eventhub-name", "Name of the Event Hub to receive drift events from. The Event Hub source is disabled if empty.").Default("").Envar("DRIFT_EVENTHUB_NAME").String()
+		driftEventHubConnection    = app.Flag("drift-eventhub-connection-string", "Connection string of the Event Hub to receive drift events from.").Default("").Envar("DRIFT_EVENTHUB_CONNECTION_STRING").String()
+		driftEventHubNamespace     = app.Flag("drift-eventhub-namespace", "Fully qualified Event Hubs namespace to receive drift events from with the default Azure credential, if no connection string is set.").Default("").Envar("DRIFT_EVENTHUB_NAMESPACE").String()
+		driftEventHubConsumerGroup = app.Flag("drift-eventhub-consumer-group", "Event Hub consumer group to receive drift events with.").Default("$Default").Envar("DRIFT_EVENTHUB_CONSUMER_GROUP").String()
+		driftEventMinInterval      = app.Flag("drift-event-min-interval", "Minimum interval between two event-driven reconciliations of the same Azure resource.").Default("10s").Envar("DRIFT_EVENT_MIN_INTERVAL").Duration()
+
+		enableOwnershipTags = app.Flag("enable-ownership-tags", "Tag the Azure resources of the Resource kinds with the cluster ID and the UID of their managed resources, and refuse to manage the Azure resources owned by other managed resources unless the azapi.upbound.io/takeover annotation is set. The resource types that do not support tags cannot be managed with the tagging enabled.").Default("false").Envar("ENABLE_OWNERSHIP_TAGS").Bool()
+		clusterID           = app.Flag("cluster-id", "ID of the cluster recorded in the ownership tags. Defaults to the UID of the kube-system namespace.").Default("").Envar("CLUSTER_ID").String()
+
+		mirrorProviderConfigs  = app.Flag("mirror-provider-configs", "Mirror the legacy ProviderConfigs into ClusterProviderConfigs of the same names in the namespaced API group, keep them in sync and delete them along with the ProviderConfigs.").Default("false").Envar("MIRROR_PROVIDER_CONFIGS").Bool()
+		migrateStorageVersions = app.Flag("migrate-storage-versions", "Rewrite the objects stored in previous versions of the provider's CRDs in their storage versions and update the stored versions of the CRDs. Requires the permissions to list the CRDs and to update their status, which must be granted to the provider's service account.").Default("false").Envar("MIGRATE_STORAGE_VERSIONS").Bool()
+
 		certsDirSet = false
 		// we record whether the command-line option "--certs-dir" was supplied
 		// in the registered PreAction for the flag.
@@ -172,6 +199,25 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot initialize the cluster-scoped provider configuration")
 	providerNamespaced, err := config.GetProviderNamespaced(ctx, false)
 	kingpin.FatalIfError(err, "Cannot initialize the namespaced provider configuration")
+	var setupOpts []clients.SetupOption
+	if *enableResourceGraphObserve {
+		rgCache := resourcegraph.NewCache(
+			resourcegraph.WithInterval(*resourceGraphInterval),
+			resourcegraph.WithEndpoint(*resourceGraphEndpoint),
+			resourcegraph.WithLogger(logr.WithValues("component", "resource-graph")))
+		kingpin.FatalIfError(mgr.Add(rgCache), "Cannot add the Resource Graph cache to the controller manager")
+		setupOpts = append(setupOpts, clients.WithResourceGraphCache(rgCache))
+		logr.Info("Resource Graph batch observation enabled", "interval", resourceGraphInterval.String())
+	}
+	if *enableOwnershipTags {
+		if *clusterID == "" {
+			ns := &corev1.Namespace{}
+			kingpin.FatalIfError(mgr.GetAPIReader().Get(ctx, client.ObjectKey{Name: metav1.NamespaceSystem}, ns), "Cannot get the kube-system namespace to identify the cluster, set the cluster ID with --cluster-id")
+			*clusterID = string(ns.GetUID())
+		}
+		setupOpts = append(setupOpts, clients.WithOwnership(*clusterID))
+		logr.Info("Ownership tagging enabled", "cluster-id", *clusterID)
+	}
 	oc := tjcontroller.Options{
 		Options: xpcontroller.Options{
 			Logger:                  logr,
@@ -186,7 +232,7 @@ func main() {
 			},
 		},
 		Provider:              provider,
-		SetupFn:               clients.TerraformSetupBuilder(),
+		SetupFn:               clients.TerraformSetupBuilder(setupOpts...),
 		PollJitter:            pollJitter,
 		OperationTrackerStore: tjcontroller.NewOperationStore(logr),
 		StartWebhooks:         *certsDir != "",
@@ -206,7 +252,7 @@ func main() {
 			},
 		},
 		Provider:              providerNamespaced,
-		SetupFn:               clients.TerraformSetupBuilder(),
+		SetupFn:               clients.TerraformSetupBuilder(setupOpts...),
 		PollJitter:            pollJitter,
 		OperationTrackerStore: tjcontroller.NewOperationStore(logr),
 		StartWebhooks:         *certsDir != "",
@@ -248,15 +294,73 @@ func main() {
 		kingpin.FatalIfError(customresourcesgate.Setup(mgr, gateControllerOpts), "Cannot setup CRD gate")
 		kingpin.FatalIfError(controllercluster.SetupGated(mgr, oc), "Cannot setup cluster-scoped AzAPI controllers")
 		kingpin.FatalIfError(controllernamespaced.SetupGated(mgr, ons), "Cannot setup namespaced AzAPI controllers")
+		kingpin.FatalIfError(resourceset.SetupGated(mgr, oc, false), "Cannot setup the cluster-scoped ResourceSet controller")
+		kingpin.FatalIfError(resourceset.SetupGated(mgr, ons, true), "Cannot setup the namespaced ResourceSet controller")
+		if *mirrorProviderConfigs {
+			kingpin.FatalIfError(providerconfig.SetupMirrorGated(mgr, oc), "Cannot setup the ProviderConfig mirroring controller")
+		}
 	} else {
 		logr.Info("Provider has missing RBAC permissions for watching CRDs, controller SafeStart capability will be disabled")
 		kingpin.FatalIfError(controllercluster.Setup(mgr, oc), "Cannot setup cluster-scoped AzAPI controllers")
 		kingpin.FatalIfError(controllernamespaced.Setup(mgr, ons), "Cannot setup namespaced AzAPI controllers")
+		kingpin.FatalIfError(resourceset.Setup(mgr, oc, false), "Cannot setup the cluster-scoped ResourceSet controller")
+		kingpin.FatalIfError(resourceset.Setup(mgr, ons, true), "Cannot setup the namespaced ResourceSet controller")
+		if *mirrorProviderConfigs {
+			kingpin.FatalIfError(providerconfig.SetupMirror(mgr, oc), "Cannot setup the ProviderConfig mirroring controller")
+		}
+	}
+	if *driftWebhookAddress != "" || *driftEventHubName != "" {
+		kingpin.FatalIfError(drift.Setup(mgr, drift.Options{
+			Logger:         logr.WithValues("component", "drift-events"),
+			MinInterval:    *driftEventMinInterval,
+			WebhookAddress: *driftWebhookAddress,
+			WebhookToken:   *driftWebhookToken,
+
+			WebhookAllowUnauthenticated: *driftWebhookAllowUnauth,
+			EventHub: drift.EventHubOptions{
+				ConnectionString: *driftEventHubConnection,
+				Namespace:        *driftEventHubNamespace,
+				Name:             *driftEventHubName,
+				ConsumerGroup:    *driftEventHubConsumerGroup,
+			},
+		}), "Cannot setup the event-driven drift detection")
+	}
+	if *migrateStorageVersions {
+		canMigrate, err := canUpdateCRDStatus(ctx, mgr)
+		kingpin.FatalIfError(err, "Cannot check the RBAC permissions for updating the status of CRDs")
+		if canMigrate {
+			kingpin.FatalIfError(storageversion.Setup(mgr, storageversion.Options{
+				Logger: logr.WithValues("component", "storage-version-migration"),
+			}), "Cannot setup the storage version migration")
+			logr.Info("Storage version migration enabled")
+		} else {
+			logr.Info("Provider has missing RBAC permissions for updating the status of CRDs, the storage version migration is disabled and the previous versions remain stored. Grant them as described in the README")
+		}
 	}
 	kingpin.FatalIfError(conversion.RegisterConversions(oc.Provider, ons.Provider, mgr.GetScheme()), "Cannot initialize the webhook conversion registry")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
 
+func canUpdateCRDStatus(ctx context.Context, mgr manager.Manager) (bool, error) {
+	if err := authv1.AddToScheme(mgr.GetScheme()); err != nil {
+		return false, err
+	}
+	sar := &authv1.SelfSubjectAccessReview{
+		Spec: authv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Group:       "apiextensions.k8s.io",
+				Resource:    "customresourcedefinitions",
+				Subresource: "status",
+				Verb:        "update",
+			},
+		},
+	}
+	if err := mgr.GetClient().Create(ctx, sar); err != nil {
+		return false, errors.Wrap(err, "unable to perform RBAC check for verb update on the status of CustomResourceDefinitions")
+	}
+	return sar.Status.Allowed, nil
+}
+
 func canWatchCRD(ctx context.Context, mgr manager.Manager) (bool, error) {
 	if err := authv1.AddToScheme(mgr.GetScheme()); err != nil {
 		return false, err
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin/v2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/upbound/provider-azapi/v2/internal/manifest"
+	"github.com/upbound/provider-azapi/v2/internal/tfstate"
+)
+
+const (
+	modeObserve = "observe"
+	modeFull    = "full"
+)
+
+func main() {
+	var (
+		app                = kingpin.New(filepath.Base(os.Args[0]), "Translates the azapi_resource, azapi_update_resource and azapi_data_plane_resource instances of a Terraform state into managed resource manifests adopting the Azure resources.").DefaultEnvars()
+		stateFile          = app.Arg("state", "Path of the Terraform state, e.g. terraform.tfstate or the output of terraform state pull.").Required().ExistingFile()
+		mode               = app.Flag("mode", "Management mode of the managed resources: observe translates them with the Observe management policy, full with the full management.").Default(modeFull).Enum(modeObserve, modeFull)
+		namespace          = app.Flag("namespace", "Namespace of the namespaced managed resources. Cluster-scoped managed resources are emitted if unset.").Short('n').String()
+		providerConfigKind = app.Flag("provider-config-kind", "Kind of the provider config referenced by the namespaced managed resources.").Default("ClusterProviderConfig").Enum("ClusterProviderConfig", "ProviderConfig")
+		providerConfigName = app.Flag("provider-config-name", "Name of the provider config referenced by the managed resources. The default provider config is used if unset.").String()
+		output             = app.Flag("output", "Path of the file the managed resource manifests are written to. Defaults to the standard output.").Short('o').String()
+		strict             = app.Flag("strict", "Fail if any azapi resource or attribute of the state cannot be translated.").Default("false").Bool()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	s, err := os.ReadFile(filepath.Clean(*stateFile))
+	kingpin.FatalIfError(err, "Cannot read the Terraform state")
+	res, err := tfstate.Convert(s, tfstate.Options{
+		Manifest: manifest.Options{
+			Namespaced:         *namespace != "",
+			Namespace:          *namespace,
+			ProviderConfigKind: *providerConfigKind,
+			ProviderConfigName: *providerConfigName,
+		},
+		ObserveOnly: *mode == modeObserve,
+	})
+	kingpin.FatalIfError(err, "Cannot translate the Terraform state")
+	for _, w := range res.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
+	if *strict && len(res.Warnings) > 0 {
+		kingpin.Fatalf("%d resources or attributes of the Terraform state cannot be translated", len(res.Warnings))
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		out, err = os.Create(filepath.Clean(*output))
+		kingpin.FatalIfError(err, "Cannot create the output file")
+		defer out.Close() //nolint:errcheck // closed on exit
+	}
+	for _, r := range res.Resources {
+		b, err := yaml.Marshal(r.Object)
+		kingpin.FatalIfError(err, "Cannot marshal the managed resource %s", r.GetName())
+		_, err = fmt.Fprintf(out, "---\n%s", b)
+		kingpin.FatalIfError(err, "Cannot write the managed resource %s", r.GetName())
+	}
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/crossplane/upjet/v2/pkg/config/conversion"
 	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta1"
 	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	"github.com/upbound/provider-azapi/v2/config/common"
 )
 
 const (
-	group          = "resources"
-	versionV1Beta1 = "v1beta1"
-	versionV1Beta2 = "v1beta2"
+	group           = "resources"
+	versionV1Beta1  = "v1beta1"
+	versionV1Beta2  = "v1beta2"
+	versionV1Alpha1 = "v1alpha1"
 )
 
 // Configure configures individual resources by adding custom ResourceConfigurators.
@@ -30,7 +32,7 @@ func Configure(p *config.Provider) {
 		r.Version = versionV1Beta2
 		r.PreviousVersions = []string{versionV1Beta1}
 		r.ControllerReconcileVersion = versionV1Beta2 //nolint:staticcheck // still handling the deprecated behavior until rollout
-		r.SetCRDStorageVersion(versionV1Beta1)
+		r.SetCRDStorageVersion(versionV1Beta2)
 		r.Conversions = r.Conversions[1:]
 		typeChangingPaths := []string{"body", "output", "responseExportValues"}
 		r.Conversions = append(r.Conversions,
@@ -46,6 +48,8 @@ func Configure(p *config.Provider) {
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.DeletionPolicy(r)
+		common.BodyTemplating(r)
 	})
 
 	p.AddResourceConfigurator("azapi_resource", func(r *config.Resource) {
@@ -54,7 +58,7 @@ func Configure(p *config.Provider) {
 		r.Version = versionV1Beta2
 		r.PreviousVersions = []string{versionV1Beta1}
 		r.ControllerReconcileVersion = versionV1Beta2 //nolint:staticcheck // still handling the deprecated behavior until rollout
-		r.SetCRDStorageVersion(versionV1Beta1)
+		r.SetCRDStorageVersion(versionV1Beta2)
 		r.Conversions = r.Conversions[1:]
 		typeChangingPaths := []string{"body", "output", "responseExportValues"}
 		r.Conversions = append(r.Conversions,
@@ -70,6 +74,10 @@ func Configure(p *config.Provider) {
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.DeletionPolicy(r)
+		common.AzureLock(r)
+		common.BodyTemplating(r)
+		common.TagPropagation(r)
 	})
 
 	p.AddResourceConfigurator("azapi_resource_action", func(r *config.Resource) {
@@ -79,7 +87,7 @@ func Configure(p *config.Provider) {
 		r.Version = versionV1Beta2
 		r.PreviousVersions = []string{versionV1Beta1}
 		r.ControllerReconcileVersion = versionV1Beta2 //nolint:staticcheck // still handling the deprecated behavior until rollout
-		r.SetCRDStorageVersion(versionV1Beta1)
+		r.SetCRDStorageVersion(versionV1Beta2)
 		r.Conversions = r.Conversions[1:]
 		typeChangingPaths := []string{"body", "output", "responseExportValues"}
 		r.Conversions = append(r.Conversions,
@@ -91,6 +99,29 @@ func Configure(p *config.Provider) {
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.ResourceActionSchedule(r)
+		common.ResourceActionHistory(r, false)
+	})
+
+	p.AddResourceConfigurator(common.ListKeysActionTypeName, func(r *config.Resource) {
+		r.Kind = "ListKeysAction"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.ListKeysAction(r, false)
+	})
+
+	p.AddResourceConfigurator(common.RegenerateKeyActionTypeName, func(r *config.Resource) {
+		r.Kind = "RegenerateKeyAction"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.RegenerateKeyAction(r, false)
+	})
+
+	p.AddResourceConfigurator(common.EphemeralResourceActionTypeName, func(r *config.Resource) {
+		r.Kind = "EphemeralResourceAction"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.EphemeralResourceAction(r, false)
 	})
 
 	p.AddResourceConfigurator("azapi_update_resource", func(r *config.Resource) {
@@ -102,7 +133,7 @@ func Configure(p *config.Provider) {
 		r.Version = versionV1Beta2
 		r.PreviousVersions = []string{versionV1Beta1}
 		r.ControllerReconcileVersion = versionV1Beta2 //nolint:staticcheck // still handling the deprecated behavior until rollout
-		r.SetCRDStorageVersion(versionV1Beta1)
+		r.SetCRDStorageVersion(versionV1Beta2)
 		r.Conversions = r.Conversions[1:]
 		typeChangingPaths := []string{"body", "output", "responseExportValues"}
 		r.Conversions = append(r.Conversions,
@@ -117,6 +148,8 @@ func Configure(p *config.Provider) {
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.BodyTemplating(r)
+		common.TagPropagation(r)
 	})
 }
 
@@ -168,22 +201,22 @@ func dataPlaneResourceConverterFromv1beta2Tov1beta1(src resource.Managed, target
 		return err
 	}
 
+	restoreInvalidJSON(srcTyped, targetTyped, map[string]**string{
+		"spec.forProvider.body":    &targetTyped.Spec.ForProvider.Body,
+		"spec.initProvider.body":   &targetTyped.Spec.InitProvider.Body,
+		"status.atProvider.body":   &targetTyped.Status.AtProvider.Body,
+		"status.atProvider.output": &targetTyped.Status.AtProvider.Output,
+	})
 	return nil
 }
 
 func dataPlaneResourceConverterFromv1beta1Tov1beta2(src resource.Managed, target resource.Managed) error { //nolint:gocyclo // easier to follow as a unit
 	srcTyped := src.(*v1beta1.DataPlaneResource)
 	targetTyped := target.(*v1beta2.DataPlaneResource)
-
-	if srcTyped.Spec.ForProvider.Body != nil {
-		targetTyped.Spec.ForProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.ForProvider.Body)}
-	}
-	if srcTyped.Spec.InitProvider.Body != nil {
-		targetTyped.Spec.InitProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.InitProvider.Body)}
-	}
-	if srcTyped.Status.AtProvider.Body != nil {
-		targetTyped.Status.AtProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Body)}
-	}
+	c := newJSONConversion(targetTyped)
+	c.convert("spec.forProvider.body", srcTyped.Spec.ForProvider.Body, &targetTyped.Spec.ForProvider.Body)
+	c.convert("spec.initProvider.body", srcTyped.Spec.InitProvider.Body, &targetTyped.Spec.InitProvider.Body)
+	c.convert("status.atProvider.body", srcTyped.Status.AtProvider.Body, &targetTyped.Status.AtProvider.Body)
 
 	if srcTyped.Spec.ForProvider.ResponseExportValues != nil {
 		bytes, err := json.Marshal(srcTyped.Spec.ForProvider.ResponseExportValues)
@@ -207,9 +240,8 @@ func dataPlaneResourceConverterFromv1beta1Tov1beta2(src resource.Managed, target
 		targetTyped.Status.AtProvider.ResponseExportValues = &apiextv1.JSON{Raw: bytes}
 	}
 
-	if srcTyped.Status.AtProvider.Output != nil {
-		targetTyped.Status.AtProvider.Output = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Output)}
-	}
+	c.convert("status.atProvider.output", srcTyped.Status.AtProvider.Output, &targetTyped.Status.AtProvider.Output)
+	c.finish()
 	return nil
 }
 
@@ -241,22 +273,22 @@ func azapiResourceConverterFromv1beta2Tov1beta1(src resource.Managed, target res
 		return err
 	}
 
+	restoreInvalidJSON(srcTyped, targetTyped, map[string]**string{
+		"spec.forProvider.body":    &targetTyped.Spec.ForProvider.Body,
+		"spec.initProvider.body":   &targetTyped.Spec.InitProvider.Body,
+		"status.atProvider.body":   &targetTyped.Status.AtProvider.Body,
+		"status.atProvider.output": &targetTyped.Status.AtProvider.Output,
+	})
 	return nil
 }
 
 func azapiResourceConverterFromv1beta1Tov1beta2(src resource.Managed, target resource.Managed) error { //nolint:gocyclo // easier to follow as a unit
 	srcTyped := src.(*v1beta1.Resource)
 	targetTyped := target.(*v1beta2.Resource)
-
-	if srcTyped.Spec.ForProvider.Body != nil {
-		targetTyped.Spec.ForProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.ForProvider.Body)}
-	}
-	if srcTyped.Spec.InitProvider.Body != nil {
-		targetTyped.Spec.InitProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.InitProvider.Body)}
-	}
-	if srcTyped.Status.AtProvider.Body != nil {
-		targetTyped.Status.AtProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Body)}
-	}
+	c := newJSONConversion(targetTyped)
+	c.convert("spec.forProvider.body", srcTyped.Spec.ForProvider.Body, &targetTyped.Spec.ForProvider.Body)
+	c.convert("spec.initProvider.body", srcTyped.Spec.InitProvider.Body, &targetTyped.Spec.InitProvider.Body)
+	c.convert("status.atProvider.body", srcTyped.Status.AtProvider.Body, &targetTyped.Status.AtProvider.Body)
 
 	if srcTyped.Spec.ForProvider.ResponseExportValues != nil {
 		bytes, err := json.Marshal(srcTyped.Spec.ForProvider.ResponseExportValues)
@@ -279,9 +311,8 @@ func azapiResourceConverterFromv1beta1Tov1beta2(src resource.Managed, target res
 		}
 		targetTyped.Status.AtProvider.ResponseExportValues = &apiextv1.JSON{Raw: bytes}
 	}
-	if srcTyped.Status.AtProvider.Output != nil {
-		targetTyped.Status.AtProvider.Output = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Output)}
-	}
+	c.convert("status.atProvider.output", srcTyped.Status.AtProvider.Output, &targetTyped.Status.AtProvider.Output)
+	c.finish()
 	return nil
 }
 
@@ -313,22 +344,22 @@ func resourceActionConverterFromv1beta2Tov1beta1(src resource.Managed, target re
 		return err
 	}
 
+	restoreInvalidJSON(srcTyped, targetTyped, map[string]**string{
+		"spec.forProvider.body":    &targetTyped.Spec.ForProvider.Body,
+		"spec.initProvider.body":   &targetTyped.Spec.InitProvider.Body,
+		"status.atProvider.body":   &targetTyped.Status.AtProvider.Body,
+		"status.atProvider.output": &targetTyped.Status.AtProvider.Output,
+	})
 	return nil
 }
 
 func resourceActionConverterFromv1beta1Tov1beta2(src resource.Managed, target resource.Managed) error { //nolint:gocyclo // easier to follow as a unit
 	srcTyped := src.(*v1beta1.ResourceAction)
 	targetTyped := target.(*v1beta2.ResourceAction)
-
-	if srcTyped.Spec.ForProvider.Body != nil {
-		targetTyped.Spec.ForProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.ForProvider.Body)}
-	}
-	if srcTyped.Spec.InitProvider.Body != nil {
-		targetTyped.Spec.InitProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.InitProvider.Body)}
-	}
-	if srcTyped.Status.AtProvider.Body != nil {
-		targetTyped.Status.AtProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Body)}
-	}
+	c := newJSONConversion(targetTyped)
+	c.convert("spec.forProvider.body", srcTyped.Spec.ForProvider.Body, &targetTyped.Spec.ForProvider.Body)
+	c.convert("spec.initProvider.body", srcTyped.Spec.InitProvider.Body, &targetTyped.Spec.InitProvider.Body)
+	c.convert("status.atProvider.body", srcTyped.Status.AtProvider.Body, &targetTyped.Status.AtProvider.Body)
 
 	if srcTyped.Spec.ForProvider.ResponseExportValues != nil {
 		bytes, err := json.Marshal(srcTyped.Spec.ForProvider.ResponseExportValues)
@@ -351,9 +382,8 @@ func resourceActionConverterFromv1beta1Tov1beta2(src resource.Managed, target re
 		}
 		targetTyped.Status.AtProvider.ResponseExportValues = &apiextv1.JSON{Raw: bytes}
 	}
-	if srcTyped.Status.AtProvider.Output != nil {
-		targetTyped.Status.AtProvider.Output = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Output)}
-	}
+	c.convert("status.atProvider.output", srcTyped.Status.AtProvider.Output, &targetTyped.Status.AtProvider.Output)
+	c.finish()
 	return nil
 }
 
@@ -385,22 +415,22 @@ func updateResourceConverterFromv1beta2Tov1beta1(src resource.Managed, target re
 		return err
 	}
 
+	restoreInvalidJSON(srcTyped, targetTyped, map[string]**string{
+		"spec.forProvider.body":    &targetTyped.Spec.ForProvider.Body,
+		"spec.initProvider.body":   &targetTyped.Spec.InitProvider.Body,
+		"status.atProvider.body":   &targetTyped.Status.AtProvider.Body,
+		"status.atProvider.output": &targetTyped.Status.AtProvider.Output,
+	})
 	return nil
 }
 
 func updateResourceConverterFromv1beta1Tov1beta2(src resource.Managed, target resource.Managed) error { //nolint:gocyclo // easier to follow as a unit
 	srcTyped := src.(*v1beta1.UpdateResource)
 	targetTyped := target.(*v1beta2.UpdateResource)
-
-	if srcTyped.Spec.ForProvider.Body != nil {
-		targetTyped.Spec.ForProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.ForProvider.Body)}
-	}
-	if srcTyped.Spec.InitProvider.Body != nil {
-		targetTyped.Spec.InitProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Spec.InitProvider.Body)}
-	}
-	if srcTyped.Status.AtProvider.Body != nil {
-		targetTyped.Status.AtProvider.Body = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Body)}
-	}
+	c := newJSONConversion(targetTyped)
+	c.convert("spec.forProvider.body", srcTyped.Spec.ForProvider.Body, &targetTyped.Spec.ForProvider.Body)
+	c.convert("spec.initProvider.body", srcTyped.Spec.InitProvider.Body, &targetTyped.Spec.InitProvider.Body)
+	c.convert("status.atProvider.body", srcTyped.Status.AtProvider.Body, &targetTyped.Status.AtProvider.Body)
 
 	if srcTyped.Spec.ForProvider.ResponseExportValues != nil {
 		bytes, err := json.Marshal(srcTyped.Spec.ForProvider.ResponseExportValues)
@@ -424,8 +454,7 @@ func updateResourceConverterFromv1beta1Tov1beta2(src resource.Managed, target re
 		targetTyped.Status.AtProvider.ResponseExportValues = &apiextv1.JSON{Raw: bytes}
 	}
 
-	if srcTyped.Status.AtProvider.Output != nil {
-		targetTyped.Status.AtProvider.Output = &apiextv1.JSON{Raw: []byte(*srcTyped.Status.AtProvider.Output)}
-	}
+	c.convert("status.atProvider.output", srcTyped.Status.AtProvider.Output, &targetTyped.Status.AtProvider.Output)
+	c.finish()
 	return nil
 }
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AnnotationKeyOriginalPrefix prefixes the annotations preserving the
+	// v1beta1 strings that are not valid JSON, and thus cannot be converted
+	// into v1beta2 JSON fields. The prefix is followed by the path of the
+	// field, e.g. spec.forProvider.body.
+	AnnotationKeyOriginalPrefix = "azapi.upbound.io/original-"
+
+	// TypeConversionWarning is the type of the condition reporting the
+	// v1beta1 strings that could not be converted into v1beta2 JSON fields.
+	TypeConversionWarning xpv1.ConditionType = "ConversionWarning"
+
+	reasonInvalidJSON xpv1.ConditionReason = "InvalidJSON"
+	reasonValidJSON   xpv1.ConditionReason = "ValidJSON"
+)
+
+// jsonConversion converts the v1beta1 strings holding JSON documents into
+// the JSON fields of a v1beta2 managed resource. Strings that are not valid
+// JSON are not converted: they are preserved in annotations, from which they
+// are restored when converting back to v1beta1, and reported with a warning
+// condition. The provider doesn't reconcile a managed resource whose spec
+// fields are preserved this way until they are set.
+type jsonConversion struct {
+	target  resource.Managed
+	invalid []string
+}
+
+func newJSONConversion(target resource.Managed) *jsonConversion {
+	return &jsonConversion{target: target}
+}
+
+// convert sets the JSON field at the supplied path from the supplied string.
+func (c *jsonConversion) convert(path string, sp *string, jf **apiextv1.JSON) {
+	if sp == nil {
+		return
+	}
+	if !json.Valid([]byte(*sp)) {
+		c.invalid = append(c.invalid, path)
+		meta.AddAnnotations(c.target, map[string]string{AnnotationKeyOriginalPrefix + path: *sp})
+		return
+	}
+	*jf = &apiextv1.JSON{Raw: []byte(*sp)}
+}
+
+// finish reports the strings that could not be converted, if any.
+func (c *jsonConversion) finish() {
+	if len(c.invalid) == 0 {
+		if c.target.GetCondition(TypeConversionWarning).Status == corev1.ConditionTrue {
+			c.target.SetConditions(xpv1.Condition{
+				Type:               TypeConversionWarning,
+				Status:             corev1.ConditionFalse,
+				Reason:             reasonValidJSON,
+				LastTransitionTime: metav1.Now(),
+			})
+		}
+		return
+	}
+	sort.Strings(c.invalid)
+	c.target.SetConditions(xpv1.Condition{
+		Type:               TypeConversionWarning,
+		Status:             corev1.ConditionTrue,
+		Reason:             reasonInvalidJSON,
+		LastTransitionTime: metav1.Now(),
+		Message:            "The following v1beta1 fields are not valid JSON and are preserved in the " + AnnotationKeyOriginalPrefix + "<field> annotations: " + strings.Join(c.invalid, ", ") + ". The resource is not reconciled until they are set.",
+	})
+}
+
+// restoreInvalidJSON restores the v1beta1 strings that were not valid JSON
+// from the annotations of the source managed resource, unless the fields
+// have been set since, and removes the annotations from the target managed
+// resource.
+func restoreInvalidJSON(src, target resource.Managed, fields map[string]**string) {
+	annotations := src.GetAnnotations()
+	for path, sp := range fields {
+		original, ok := annotations[AnnotationKeyOriginalPrefix+path]
+		if !ok {
+			continue
+		}
+		if *sp == nil {
+			*sp = &original
+		}
+		meta.RemoveAnnotations(target, AnnotationKeyOriginalPrefix+path)
+	}
+}
@@ -0,0 +1,438 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"encoding/json"
+	"maps"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta1"
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+)
+
+// iterations is the number of random objects checked by each property.
+const iterations = 500
+
+// bodyPaths are the paths of the fields holding JSON documents, which are
+// strings in v1beta1 and JSON in v1beta2.
+var bodyPaths = []string{
+	"Spec.ForProvider.Body",
+	"Spec.InitProvider.Body",
+	"Status.AtProvider.Body",
+	"Status.AtProvider.Output",
+}
+
+// exportPaths are the paths of the responseExportValues fields, which are
+// string lists in v1beta1 and JSON in v1beta2.
+var exportPaths = []string{
+	"Spec.ForProvider.ResponseExportValues",
+	"Spec.InitProvider.ResponseExportValues",
+	"Status.AtProvider.ResponseExportValues",
+}
+
+type converter func(src, target resource.Managed) error
+
+type kind struct {
+	name       string
+	newV1beta1 func() resource.Managed
+	newV1beta2 func() resource.Managed
+	toV1beta2  converter
+	toV1beta1  converter
+}
+
+var kinds = []kind{
+	{
+		name:       "DataPlaneResource",
+		newV1beta1: func() resource.Managed { return &v1beta1.DataPlaneResource{} },
+		newV1beta2: func() resource.Managed { return &v1beta2.DataPlaneResource{} },
+		toV1beta2:  dataPlaneResourceConverterFromv1beta1Tov1beta2,
+		toV1beta1:  dataPlaneResourceConverterFromv1beta2Tov1beta1,
+	},
+	{
+		name:       "Resource",
+		newV1beta1: func() resource.Managed { return &v1beta1.Resource{} },
+		newV1beta2: func() resource.Managed { return &v1beta2.Resource{} },
+		toV1beta2:  azapiResourceConverterFromv1beta1Tov1beta2,
+		toV1beta1:  azapiResourceConverterFromv1beta2Tov1beta1,
+	},
+	{
+		name:       "ResourceAction",
+		newV1beta1: func() resource.Managed { return &v1beta1.ResourceAction{} },
+		newV1beta2: func() resource.Managed { return &v1beta2.ResourceAction{} },
+		toV1beta2:  resourceActionConverterFromv1beta1Tov1beta2,
+		toV1beta1:  resourceActionConverterFromv1beta2Tov1beta1,
+	},
+	{
+		name:       "UpdateResource",
+		newV1beta1: func() resource.Managed { return &v1beta1.UpdateResource{} },
+		newV1beta2: func() resource.Managed { return &v1beta2.UpdateResource{} },
+		toV1beta2:  updateResourceConverterFromv1beta1Tov1beta2,
+		toV1beta1:  updateResourceConverterFromv1beta2Tov1beta1,
+	},
+}
+
+// field returns the addressable field at the supplied dot separated path of
+// a managed resource.
+func field(mg resource.Managed, path string) reflect.Value {
+	v := reflect.ValueOf(mg).Elem()
+	for _, name := range strings.Split(path, ".") {
+		v = v.FieldByName(name)
+	}
+	return v
+}
+
+func newRand(t *testing.T) *rand.Rand {
+	t.Helper()
+	seed := uint64(time.Now().UnixNano()) //nolint:gosec // the seed is not sensitive
+	t.Logf("random seed: %d", seed)
+	return rand.New(rand.NewPCG(seed, seed)) //nolint:gosec // not used for security
+}
+
+// randomValue returns a random JSON compatible value nested up to the
+// supplied depth.
+func randomValue(r *rand.Rand, depth int) any {
+	n := 6
+	if depth <= 0 {
+		n = 4
+	}
+	switch r.IntN(n) {
+	case 0:
+		return nil
+	case 1:
+		return r.IntN(2) == 0
+	case 2:
+		if r.IntN(2) == 0 {
+			return float64(r.Int64N(1<<53) - 1<<52)
+		}
+		return r.NormFloat64() * 1e6
+	case 3:
+		return randomString(r)
+	case 4:
+		l := make([]any, r.IntN(4))
+		for i := range l {
+			l[i] = randomValue(r, depth-1)
+		}
+		return l
+	default:
+		m := make(map[string]any, 4)
+		for range r.IntN(5) {
+			m[randomString(r)] = randomValue(r, depth-1)
+		}
+		return m
+	}
+}
+
+// randomString returns a random string including characters that are
+// escaped in JSON and multi-byte runes.
+func randomString(r *rand.Rand) string {
+	const alphabet = "abcXYZ019 _-./@$\"\\\n\t<>&{}[]:,éü漢🙂"
+	runes := []rune(alphabet)
+	b := strings.Builder{}
+	for range r.IntN(12) {
+		b.WriteRune(runes[r.IntN(len(runes))])
+	}
+	return b.String()
+}
+
+// randomDocument returns a random JSON document, randomly indented.
+func randomDocument(t *testing.T, r *rand.Rand) []byte {
+	t.Helper()
+	v := randomValue(r, 3)
+	var b []byte
+	var err error
+	if r.IntN(2) == 0 {
+		b, err = json.Marshal(v)
+	} else {
+		b, err = json.MarshalIndent(v, "", "  ")
+	}
+	if err != nil {
+		t.Fatalf("cannot marshal a random value: %v", err)
+	}
+	return b
+}
+
+func randomExportValues(r *rand.Rand) []*string {
+	l := make([]*string, r.IntN(4))
+	for i := range l {
+		s := randomString(r)
+		l[i] = &s
+	}
+	return l
+}
+
+// invalidDocuments are strings that are not JSON documents, e.g. HCL
+// fragments that were accepted by v1beta1.
+var invalidDocuments = []string{
+	"",
+	" ",
+	"{",
+	`{"a": 1`,
+	`{"a": 1}}`,
+	`{"a": 1} trailing`,
+	`{a: 1}`,
+	`{'a': 1}`,
+	`{ properties = { enabled = true } }`,
+	`jsonencode({ properties = {} })`,
+	"${var.body}",
+	"not json",
+	`[1, 2,]`,
+	"\x00",
+	"\xff",
+}
+
+// randomInvalidDocument returns a random string that is not a JSON
+// document.
+func randomInvalidDocument(r *rand.Rand) string {
+	if r.IntN(2) == 0 {
+		return invalidDocuments[r.IntN(len(invalidDocuments))]
+	}
+	for {
+		s := randomString(r)
+		if !json.Valid([]byte(s)) {
+			return s
+		}
+	}
+}
+
+func equalJSON(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// TestRoundTripFromV1beta2 checks that converting random v1beta2 objects to
+// v1beta1 and back preserves their JSON documents and response export
+// values.
+func TestRoundTripFromV1beta2(t *testing.T) {
+	for _, k := range kinds {
+		t.Run(k.name, func(t *testing.T) {
+			r := newRand(t)
+			for i := range iterations {
+				src := k.newV1beta2()
+				for _, p := range bodyPaths {
+					if r.IntN(4) != 0 {
+						field(src, p).Set(reflect.ValueOf(&apiextv1.JSON{Raw: randomDocument(t, r)}))
+					}
+				}
+				for _, p := range exportPaths {
+					if r.IntN(4) != 0 {
+						b, _ := json.Marshal(randomExportValues(r))
+						field(src, p).Set(reflect.ValueOf(&apiextv1.JSON{Raw: b}))
+					}
+				}
+				mid := k.newV1beta1()
+				if err := k.toV1beta1(src, mid); err != nil {
+					t.Fatalf("iteration %d: cannot convert to v1beta1: %v", i, err)
+				}
+				got := k.newV1beta2()
+				if err := k.toV1beta2(mid, got); err != nil {
+					t.Fatalf("iteration %d: cannot convert back to v1beta2: %v", i, err)
+				}
+				for _, p := range append(append([]string{}, bodyPaths...), exportPaths...) {
+					want, have := field(src, p).Interface().(*apiextv1.JSON), field(got, p).Interface().(*apiextv1.JSON)
+					switch {
+					case want == nil && have == nil:
+					case want == nil || have == nil:
+						t.Errorf("iteration %d: %s: want %v, got %v", i, p, want, have)
+					case !equalJSON(want.Raw, have.Raw):
+						t.Errorf("iteration %d: %s: want %s, got %s", i, p, want.Raw, have.Raw)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestRoundTripFromV1beta1 checks that converting random v1beta1 objects
+// holding JSON documents to v1beta2 and back preserves them as is.
+func TestRoundTripFromV1beta1(t *testing.T) {
+	for _, k := range kinds {
+		t.Run(k.name, func(t *testing.T) {
+			r := newRand(t)
+			for i := range iterations {
+				src := k.newV1beta1()
+				for _, p := range bodyPaths {
+					if r.IntN(4) != 0 {
+						s := string(randomDocument(t, r))
+						field(src, p).Set(reflect.ValueOf(&s))
+					}
+				}
+				for _, p := range exportPaths {
+					if r.IntN(4) != 0 {
+						field(src, p).Set(reflect.ValueOf(randomExportValues(r)))
+					}
+				}
+				mid := k.newV1beta2()
+				if err := k.toV1beta2(src, mid); err != nil {
+					t.Fatalf("iteration %d: cannot convert to v1beta2: %v", i, err)
+				}
+				for _, p := range append(append([]string{}, bodyPaths...), exportPaths...) {
+					if j := field(mid, p).Interface().(*apiextv1.JSON); j != nil && !json.Valid(j.Raw) {
+						t.Fatalf("iteration %d: %s: invalid JSON emitted: %q", i, p, j.Raw)
+					}
+				}
+				got := k.newV1beta1()
+				if err := k.toV1beta1(mid, got); err != nil {
+					t.Fatalf("iteration %d: cannot convert back to v1beta1: %v", i, err)
+				}
+				for _, p := range bodyPaths {
+					want, have := field(src, p).Interface().(*string), field(got, p).Interface().(*string)
+					if !reflect.DeepEqual(want, have) {
+						t.Errorf("iteration %d: %s: want %v, got %v", i, p, want, have)
+					}
+				}
+				for _, p := range exportPaths {
+					want, have := field(src, p).Interface().([]*string), field(got, p).Interface().([]*string)
+					if len(want) == 0 && len(have) == 0 {
+						continue
+					}
+					if !reflect.DeepEqual(want, have) {
+						t.Errorf("iteration %d: %s: want %v, got %v", i, p, want, have)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestInvalidJSONFromV1beta1 checks the conversion of v1beta1 objects whose
+// body or output strings are not JSON documents. No invalid JSON is emitted
+// into the v1beta2 object: the strings are preserved in annotations, a
+// warning condition is set and the resource is not paused. Converting back
+// to v1beta1 restores the strings as is.
+func TestInvalidJSONFromV1beta1(t *testing.T) {
+	for _, k := range kinds {
+		t.Run(k.name, func(t *testing.T) {
+			r := newRand(t)
+			for i := range iterations {
+				src := k.newV1beta1()
+				invalid := map[string]string{}
+				for _, p := range bodyPaths {
+					switch r.IntN(3) {
+					case 0:
+						s := randomInvalidDocument(r)
+						invalid[p] = s
+						field(src, p).Set(reflect.ValueOf(&s))
+					case 1:
+						s := string(randomDocument(t, r))
+						field(src, p).Set(reflect.ValueOf(&s))
+					}
+				}
+				mid := k.newV1beta2()
+				if err := k.toV1beta2(src, mid); err != nil {
+					t.Fatalf("iteration %d: cannot convert to v1beta2: %v", i, err)
+				}
+				for _, p := range bodyPaths {
+					j := field(mid, p).Interface().(*apiextv1.JSON)
+					if j != nil && !json.Valid(j.Raw) {
+						t.Errorf("iteration %d: %s: invalid JSON emitted: %q", i, p, j.Raw)
+					}
+					s, ok := invalid[p]
+					if !ok {
+						continue
+					}
+					if j != nil {
+						t.Errorf("iteration %d: %s: want no value, got %q", i, p, j.Raw)
+					}
+					if got := mid.GetAnnotations()[AnnotationKeyOriginalPrefix+annotationPath(p)]; got != s {
+						t.Errorf("iteration %d: %s: want the original %q in the annotation, got %q", i, p, s, got)
+					}
+				}
+				wantCondition := corev1.ConditionFalse
+				if len(invalid) > 0 {
+					wantCondition = corev1.ConditionTrue
+				}
+				if c := mid.GetCondition(TypeConversionWarning); len(invalid) > 0 && c.Status != wantCondition {
+					t.Errorf("iteration %d: want the %s condition %s, got %s", i, TypeConversionWarning, wantCondition, c.Status)
+				}
+				if meta.IsPaused(mid) {
+					t.Errorf("iteration %d: want the v1beta2 object not paused by the conversion", i)
+				}
+
+				got := k.newV1beta1()
+				// the identity conversion copies the metadata
+				got.SetAnnotations(maps.Clone(mid.GetAnnotations()))
+				if err := k.toV1beta1(mid, got); err != nil {
+					t.Fatalf("iteration %d: cannot convert back to v1beta1: %v", i, err)
+				}
+				for _, p := range bodyPaths {
+					want, have := field(src, p).Interface().(*string), field(got, p).Interface().(*string)
+					if !reflect.DeepEqual(want, have) {
+						t.Errorf("iteration %d: %s: want %v, got %v", i, p, want, have)
+					}
+				}
+				for k := range got.GetAnnotations() {
+					if strings.HasPrefix(k, AnnotationKeyOriginalPrefix) || k == meta.AnnotationKeyReconciliationPaused {
+						t.Errorf("iteration %d: want the %s annotation removed", i, k)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestFixedJSONToV1beta1 checks that a field set in v1beta2 after a failed
+// conversion takes precedence over the preserved v1beta1 string.
+func TestFixedJSONToV1beta1(t *testing.T) {
+	for _, k := range kinds {
+		t.Run(k.name, func(t *testing.T) {
+			src := k.newV1beta1()
+			s := "{ properties = {} }"
+			field(src, "Spec.ForProvider.Body").Set(reflect.ValueOf(&s))
+			mid := k.newV1beta2()
+			if err := k.toV1beta2(src, mid); err != nil {
+				t.Fatalf("cannot convert to v1beta2: %v", err)
+			}
+			field(mid, "Spec.ForProvider.Body").Set(reflect.ValueOf(&apiextv1.JSON{Raw: []byte(`{"properties":{}}`)}))
+			got := k.newV1beta1()
+			got.SetAnnotations(maps.Clone(mid.GetAnnotations()))
+			if err := k.toV1beta1(mid, got); err != nil {
+				t.Fatalf("cannot convert back to v1beta1: %v", err)
+			}
+			if b := field(got, "Spec.ForProvider.Body").Interface().(*string); b == nil || *b != `{"properties":{}}` {
+				t.Errorf("want the fixed body, got %v", b)
+			}
+		})
+	}
+}
+
+// annotationPath returns the path of a field in its annotation key, e.g.
+// spec.forProvider.body for Spec.ForProvider.Body.
+func annotationPath(p string) string {
+	parts := strings.Split(p, ".")
+	for i, s := range parts {
+		parts[i] = strings.ToLower(s[:1]) + s[1:]
+	}
+	return strings.Join(parts, ".")
+}
+
+// TestMapExportValuesToV1beta1 checks that the response export values of a
+// v1beta2 object given as a map, which cannot be represented in v1beta1,
+// fail the conversion.
+func TestMapExportValuesToV1beta1(t *testing.T) {
+	for _, k := range kinds {
+		t.Run(k.name, func(t *testing.T) {
+			for _, p := range exportPaths {
+				src := k.newV1beta2()
+				field(src, p).Set(reflect.ValueOf(&apiextv1.JSON{Raw: []byte(`{"id": "id", "name": "properties.name"}`)}))
+				if err := k.toV1beta1(src, k.newV1beta1()); err == nil {
+					t.Errorf("%s: want an error, got none", p)
+				}
+			}
+		})
+	}
+}
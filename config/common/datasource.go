@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/crossplane/upjet/v2/pkg/registry"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ResourceDataTypeName is the Terraform resource type name of the
+	// ResourceData kinds, which read the azapi_resource data source.
+	ResourceDataTypeName = "azapi_resource_data"
+	// ResourceListDataTypeName is the Terraform resource type name of the
+	// ResourceList kinds, which are listed by the provider with the
+	// arguments of the azapi_resource_list data source. See ResourceList.
+	ResourceListDataTypeName = "azapi_resource_list_data"
+	// ClientConfigDataTypeName is the Terraform resource type name of the
+	// ClientConfig kinds, which read the azapi_client_config data source.
+	ClientConfigDataTypeName = "azapi_client_config_data"
+
+	errNoDataSourceFmt = "the Terraform provider schema has no %s data source"
+)
+
+// DataSources maps the Terraform resource type names of the data kinds to
+// the Terraform data sources they read.
+var DataSources = map[string]string{
+	ResourceDataTypeName:     "azapi_resource",
+	ResourceListDataTypeName: "azapi_resource_list",
+	ClientConfigDataTypeName: "azapi_client_config",
+}
+
+// AddDataSourceSchemas returns the supplied Terraform provider schema
+// document with the resource schemas of the data kinds, which are the
+// schemas of the data sources they read.
+func AddDataSourceSchemas(doc string) (string, error) {
+	ps := &tfjson.ProviderSchemas{}
+	if err := ps.UnmarshalJSON([]byte(doc)); err != nil {
+		return "", errors.Wrap(err, "cannot unmarshal the Terraform provider schema")
+	}
+	for _, s := range ps.Schemas {
+		for typeName, name := range DataSources {
+			ds, ok := s.DataSourceSchemas[name]
+			if !ok {
+				return "", errors.Errorf(errNoDataSourceFmt, name)
+			}
+			// the adapter resources are not versioned
+			rs := *ds
+			rs.Version = 0
+			s.ResourceSchemas[typeName] = &rs
+		}
+	}
+	b, err := json.Marshal(ps)
+	return string(b), errors.Wrap(err, "cannot marshal the Terraform provider schema")
+}
+
+// DataSource configures a data kind, which reads a Terraform data source
+// whenever it's observed and publishes the result into its status. The
+// data kinds never create, update or delete anything. They have no scraped
+// metadata, their fields have the descriptions of the data source.
+func DataSource(r *config.Resource, description string) {
+	r.MetaResource = &registry.Resource{
+		Name:         r.Name,
+		Description:  description,
+		ArgumentDocs: map[string]string{},
+	}
+	// the looked up resource is identified either by its ID or by its name
+	// and parent ID, which must not be set from each other
+	r.LateInitializer = config.LateInitializer{
+		IgnoredFields: []string{"name", "parent_id", "resource_id"},
+	}
+}
+
+// dataSourceProvider wraps a Terraform plugin framework provider so that it
+// serves some of its data sources as resources.
+type dataSourceProvider struct {
+	fwprovider.Provider
+	dataSources map[string]string
+}
+
+// DataSourceProvider returns a provider serving the resources of the
+// supplied provider and, for each entry of the supplied map, the data source
+// with the type name of the value as a read-only resource with the type
+// name of the key.
+func DataSourceProvider(p fwprovider.Provider, dataSources map[string]string) fwprovider.Provider {
+	if p == nil || len(dataSources) == 0 {
+		return p
+	}
+	return &dataSourceProvider{Provider: p, dataSources: dataSources}
+}
+
+func (p *dataSourceProvider) Resources(ctx context.Context) []func() fwresource.Resource {
+	mResp := &fwprovider.MetadataResponse{}
+	p.Metadata(ctx, fwprovider.MetadataRequest{}, mResp)
+
+	byName := map[string]func() datasource.DataSource{}
+	for _, fn := range p.DataSources(ctx) {
+		dResp := &datasource.MetadataResponse{}
+		fn().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: mResp.TypeName}, dResp)
+		byName[dResp.TypeName] = fn
+	}
+	fns := p.Provider.Resources(ctx)
+	result := make([]func() fwresource.Resource, 0, len(fns)+len(p.dataSources))
+	result = append(result, fns...)
+	for typeName, name := range p.dataSources {
+		fn, ok := byName[name]
+		if !ok {
+			continue
+		}
+		result = append(result, func() fwresource.Resource {
+			return &dataSourceResource{DataSource: fn(), typeName: typeName}
+		})
+	}
+	return result
+}
+
+// dataSourceResource is a resource reading a data source. Creating,
+// reading and updating the resource read the data source with the
+// arguments of the resource, deleting it only removes it from the state.
+type dataSourceResource struct {
+	datasource.DataSource
+	typeName string
+	schema   datasource.SchemaResponse
+}
+
+func (r *dataSourceResource) Metadata(_ context.Context, _ fwresource.MetadataRequest, resp *fwresource.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *dataSourceResource) Schema(ctx context.Context, _ fwresource.SchemaRequest, resp *fwresource.SchemaResponse) {
+	s := r.dataSourceSchema(ctx)
+	resp.Diagnostics.Append(s.Diagnostics...)
+	// the attributes and blocks of both schema kinds are the framework
+	// schema attributes and blocks
+	resp.Schema = rschema.Schema{
+		Attributes:          make(map[string]rschema.Attribute, len(s.Schema.Attributes)),
+		Blocks:              make(map[string]rschema.Block, len(s.Schema.Blocks)),
+		Description:         s.Schema.Description,
+		MarkdownDescription: s.Schema.MarkdownDescription,
+		DeprecationMessage:  s.Schema.DeprecationMessage,
+	}
+	for n, a := range s.Schema.Attributes {
+		resp.Schema.Attributes[n] = a
+	}
+	for n, b := range s.Schema.Blocks {
+		resp.Schema.Blocks[n] = b
+	}
+}
+
+func (r *dataSourceResource) dataSourceSchema(ctx context.Context) datasource.SchemaResponse {
+	if r.schema.Schema.Attributes == nil {
+		r.DataSource.Schema(ctx, datasource.SchemaRequest{}, &r.schema)
+	}
+	return r.schema
+}
+
+func (r *dataSourceResource) Configure(ctx context.Context, req fwresource.ConfigureRequest, resp *fwresource.ConfigureResponse) {
+	dc, ok := r.DataSource.(datasource.DataSourceWithConfigure)
+	if !ok {
+		return
+	}
+	// the azapi provider configures its resources and data sources with the
+	// same client
+	dResp := &datasource.ConfigureResponse{}
+	dc.Configure(ctx, datasource.ConfigureRequest{ProviderData: req.ProviderData}, dResp)
+	resp.Diagnostics.Append(dResp.Diagnostics...)
+}
+
+func (r *dataSourceResource) Create(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse) {
+	r.read(ctx, req.Plan.Raw, &resp.State, &resp.Diagnostics)
+}
+
+func (r *dataSourceResource) Read(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse) {
+	r.read(ctx, req.State.Raw, &resp.State, &resp.Diagnostics)
+}
+
+func (r *dataSourceResource) Update(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse) {
+	r.read(ctx, req.Plan.Raw, &resp.State, &resp.Diagnostics)
+}
+
+// Delete leaves the data in place, the state is removed by the framework.
+func (r *dataSourceResource) Delete(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse) {
+}
+
+// read reads the data source with the arguments in the supplied value into
+// the supplied state.
+func (r *dataSourceResource) read(ctx context.Context, v tftypes.Value, state *tfsdk.State, diags *diag.Diagnostics) {
+	s := r.dataSourceSchema(ctx)
+	diags.Append(s.Diagnostics...)
+	if diags.HasError() {
+		return
+	}
+	cfg, err := dataSourceConfig(v, s)
+	if err != nil {
+		diags.AddError("Invalid data source configuration", err.Error())
+		return
+	}
+	dReq := datasource.ReadRequest{Config: tfsdk.Config{Raw: cfg, Schema: s.Schema}}
+	dResp := &datasource.ReadResponse{State: tfsdk.State{Raw: cfg.Copy(), Schema: s.Schema}}
+	r.DataSource.Read(ctx, dReq, dResp)
+	diags.Append(dResp.Diagnostics...)
+	if diags.HasError() {
+		return
+	}
+	state.Raw = dResp.State.Raw
+}
+
+// dataSourceConfig returns the configuration of a data source from a plan
+// or state value of the resource reading it, i.e. the value without the
+// unknown values and the values of the computed attributes.
+func dataSourceConfig(v tftypes.Value, s datasource.SchemaResponse) (tftypes.Value, error) {
+	computed := map[string]bool{}
+	for n, a := range s.Schema.Attributes {
+		computed[n] = a.IsComputed() && !a.IsOptional() && !a.IsRequired()
+	}
+	return tftypes.Transform(v, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		if steps := p.Steps(); len(steps) == 1 {
+			if n, ok := steps[0].(tftypes.AttributeName); ok && computed[string(n)] {
+				return tftypes.NewValue(v.Type(), nil), nil
+			}
+		}
+		return v, nil
+	})
+}
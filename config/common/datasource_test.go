@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testDataSourceSchema = dschema.Schema{Attributes: map[string]dschema.Attribute{
+	"resource_id": dschema.StringAttribute{Optional: true},
+	"name":        dschema.StringAttribute{Optional: true, Computed: true},
+	"output":      dschema.StringAttribute{Computed: true},
+}}
+
+var testDataSourceType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"resource_id": tftypes.String,
+	"name":        tftypes.String,
+	"output":      tftypes.String,
+}}
+
+// testDataSource is a data source returning the output of the resource it
+// looks up and recording the configurations it's read with.
+type testDataSource struct {
+	configs *[]tftypes.Value
+}
+
+func (d testDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+func (d testDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = testDataSourceSchema
+}
+
+func (d testDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	*d.configs = append(*d.configs, req.Config.Raw)
+	var id *string
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resource_id"), &id)...)
+	if id == nil {
+		resp.Diagnostics.AddError("Missing resource ID", "resource_id must be set")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("output"), "output of "+*id)...)
+}
+
+// testProvider is a provider with no resources and the supplied data
+// source.
+type testProvider struct {
+	dataSource datasource.DataSource
+}
+
+func (p testProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "azapi"
+}
+
+func (p testProvider) Schema(context.Context, fwprovider.SchemaRequest, *fwprovider.SchemaResponse) {}
+
+func (p testProvider) Configure(context.Context, fwprovider.ConfigureRequest, *fwprovider.ConfigureResponse) {
+}
+
+func (p testProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{func() datasource.DataSource { return p.dataSource }}
+}
+
+func (p testProvider) Resources(context.Context) []func() fwresource.Resource {
+	return nil
+}
+
+// testDataResource returns the azapi_resource_data resource served by the
+// DataSourceProvider of a testProvider.
+func testDataResource(t *testing.T, configs *[]tftypes.Value) fwresource.Resource {
+	t.Helper()
+	p := DataSourceProvider(testProvider{dataSource: testDataSource{configs: configs}}, map[string]string{
+		ResourceDataTypeName:     "azapi_resource",
+		ClientConfigDataTypeName: "azapi_client_config",
+	})
+	fns := p.Resources(context.Background())
+	if len(fns) != 1 {
+		t.Fatalf("want 1 resource, got %d", len(fns))
+	}
+	r := fns[0]()
+	mResp := &fwresource.MetadataResponse{}
+	r.Metadata(context.Background(), fwresource.MetadataRequest{}, mResp)
+	if mResp.TypeName != ResourceDataTypeName {
+		t.Fatalf("want the type name %q, got %q", ResourceDataTypeName, mResp.TypeName)
+	}
+	return r
+}
+
+// testDataValue returns a value of the schema of the testDataSource.
+func testDataValue(resourceID, name, output any) tftypes.Value {
+	return tftypes.NewValue(testDataSourceType, map[string]tftypes.Value{
+		"resource_id": tftypes.NewValue(tftypes.String, resourceID),
+		"name":        tftypes.NewValue(tftypes.String, name),
+		"output":      tftypes.NewValue(tftypes.String, output),
+	})
+}
+
+func TestDataSourceResourceRead(t *testing.T) {
+	cases := map[string]struct {
+		read       func(r fwresource.Resource, v tftypes.Value) (tfsdk.State, int)
+		v          tftypes.Value
+		wantConfig tftypes.Value
+		wantState  tftypes.Value
+		wantErrors int
+	}{
+		"Create": {
+			read: func(r fwresource.Resource, v tftypes.Value) (tfsdk.State, int) {
+				resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: testResourceSchema(r)}}
+				r.Create(context.Background(), fwresource.CreateRequest{Plan: tfsdk.Plan{Raw: v, Schema: testResourceSchema(r)}}, resp)
+				return resp.State, resp.Diagnostics.ErrorsCount()
+			},
+			v:          testDataValue("/a", tftypes.UnknownValue, tftypes.UnknownValue),
+			wantConfig: testDataValue("/a", nil, nil),
+			wantState:  testDataValue("/a", nil, "output of /a"),
+		},
+		"Read": {
+			read: func(r fwresource.Resource, v tftypes.Value) (tfsdk.State, int) {
+				resp := &fwresource.ReadResponse{State: tfsdk.State{Raw: v, Schema: testResourceSchema(r)}}
+				r.Read(context.Background(), fwresource.ReadRequest{State: tfsdk.State{Raw: v, Schema: testResourceSchema(r)}}, resp)
+				return resp.State, resp.Diagnostics.ErrorsCount()
+			},
+			v:          testDataValue("/a", "a", "stale output"),
+			wantConfig: testDataValue("/a", "a", nil),
+			wantState:  testDataValue("/a", "a", "output of /a"),
+		},
+		"Update": {
+			read: func(r fwresource.Resource, v tftypes.Value) (tfsdk.State, int) {
+				resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: testResourceSchema(r)}}
+				r.Update(context.Background(), fwresource.UpdateRequest{Plan: tfsdk.Plan{Raw: v, Schema: testResourceSchema(r)}}, resp)
+				return resp.State, resp.Diagnostics.ErrorsCount()
+			},
+			v:          testDataValue("/b", "b", tftypes.UnknownValue),
+			wantConfig: testDataValue("/b", "b", nil),
+			wantState:  testDataValue("/b", "b", "output of /b"),
+		},
+		"ReadError": {
+			read: func(r fwresource.Resource, v tftypes.Value) (tfsdk.State, int) {
+				resp := &fwresource.ReadResponse{State: tfsdk.State{Raw: v, Schema: testResourceSchema(r)}}
+				r.Read(context.Background(), fwresource.ReadRequest{State: tfsdk.State{Raw: v, Schema: testResourceSchema(r)}}, resp)
+				return resp.State, resp.Diagnostics.ErrorsCount()
+			},
+			v:          testDataValue(nil, "a", "stale output"),
+			wantConfig: testDataValue(nil, "a", nil),
+			wantState:  testDataValue(nil, "a", "stale output"),
+			wantErrors: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var configs []tftypes.Value
+			r := testDataResource(t, &configs)
+			state, errs := tc.read(r, tc.v)
+			if errs != tc.wantErrors {
+				t.Errorf("want %d errors, got %d", tc.wantErrors, errs)
+			}
+			if len(configs) != 1 {
+				t.Fatalf("want the data source read once, got %d reads", len(configs))
+			}
+			if !configs[0].Equal(tc.wantConfig) {
+				t.Errorf("want the config %s, got %s", tc.wantConfig, configs[0])
+			}
+			if !state.Raw.Equal(tc.wantState) {
+				t.Errorf("want the state %s, got %s", tc.wantState, state.Raw)
+			}
+		})
+	}
+}
+
+func TestDataSourceResourceDelete(t *testing.T) {
+	var configs []tftypes.Value
+	r := testDataResource(t, &configs)
+	v := testDataValue("/a", "a", "output of /a")
+	resp := &fwresource.DeleteResponse{State: tfsdk.State{Raw: v, Schema: testResourceSchema(r)}}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: tfsdk.State{Raw: v, Schema: testResourceSchema(r)}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("want no errors, got %v", resp.Diagnostics)
+	}
+	if len(configs) != 0 {
+		t.Errorf("want the data source not read, got %d reads", len(configs))
+	}
+	if !resp.State.Raw.Equal(v) {
+		t.Errorf("want the state %s, got %s", v, resp.State.Raw)
+	}
+}
+
+// testResourceSchema returns the schema of the supplied resource.
+func testResourceSchema(r fwresource.Resource) rschema.Schema {
+	resp := &fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, resp)
+	return resp.Schema
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DeletionPolicy adds the arguments and attributes controlling how an
+// azapi_resource or an azapi_data_plane_resource is deleted. They are
+// handled by the provider and are not passed to the Terraform provider.
+func DeletionPolicy(r *config.Resource) {
+	r.TerraformResource.Schema["deletion_mode"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "How the resource is deleted, value must be one of: `Delete`, `NoPurge`, `DetachOnly`, `DeleteWithRetention`. `Delete` deletes the resource. `NoPurge` enables the purge protection of the resource types that support it, e.g. Key Vaults, before deleting the resource so that it can be recovered during its soft-delete retention period. `DetachOnly` leaves the resource in place and only removes the tags and locks managed by the provider. `DeleteWithRetention` keeps the resource in the `PendingDelete` state for `deletion_retention_days` before deleting it. Default is `Delete`.",
+	}
+	r.TerraformResource.Schema["deletion_retention_days"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The number of days the resource is kept in the `PendingDelete` state with the `DeleteWithRetention` deletion mode. Default is `7`.",
+	}
+	r.TerraformResource.Schema["deletion_state"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The deletion state of the resource. It's `PendingDelete` while the deletion is held back by the `DeleteWithRetention` deletion mode.",
+	}
+	r.TerraformResource.Schema["deletion_scheduled_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the resource is deleted at with the `DeleteWithRetention` deletion mode, in RFC 3339 format.",
+	}
+}
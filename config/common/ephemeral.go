@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/crossplane/upjet/v2/pkg/registry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// EphemeralResourceActionTypeName is the Terraform resource type name
+	// of the EphemeralResourceAction kinds.
+	EphemeralResourceActionTypeName = "azapi_resource_action_ephemeral"
+)
+
+// EphemeralKinds maps the Terraform resource type names of the ephemeral
+// kinds to the resources whose schemas they extend. The ephemeral kinds
+// produce short-lived values, e.g. SAS or access tokens, which are only
+// written into a Secret and never into the status or the Terraform state.
+// They are reconciled by the provider, the embedded Terraform provider
+// schema has no ephemeral resources.
+var EphemeralKinds = map[string]string{
+	EphemeralResourceActionTypeName: ResourceActionTypeName,
+}
+
+// AddEphemeralSchemas returns the supplied Terraform provider schema
+// document with the schemas of the ephemeral kinds.
+func AddEphemeralSchemas(doc string) (string, error) {
+	return addAliasSchemas(doc, EphemeralKinds)
+}
+
+// EphemeralResourceAction configures an ephemeral kind running a resource
+// action, e.g. listAccountSas, and writing the exported values of its
+// response into the referenced Secret. The values are valid for the ttl and
+// the action is run again refreshBefore their expiry, at the first
+// observation after that time. The namespace of the Secret can only be
+// chosen for cluster-scoped resources, namespaced resources use their own
+// namespace.
+func EphemeralResourceAction(r *config.Resource, namespaced bool) {
+	// the outputs are never stored and the Terraform provider is not
+	// involved in running the action
+	for _, k := range []string{"when", "output", "sensitive_output", "sensitive_response_export_values", "exist", "ignore_not_found", "locks", "retry"} {
+		delete(r.TerraformResource.Schema, k)
+	}
+	r.TerraformResource.Schema["body"].Description = "A dynamic attribute that contains the request body. The `${issuedAt}` and `${expiresAt}` placeholders in its string values are replaced with the time the action is run and the expiry of the values, in RFC 3339 format, e.g. to set the `signedExpiry` of a SAS."
+	r.TerraformResource.Schema["response_export_values"].Description = "The values of the response written into the Secret, either a list of paths, e.g. `[\"accountSasToken\"]`, or a map of names to JMESPath queries. Default is `[\"*\"]`, the whole response. The top level string values are written under their names, the other values JSON encoded."
+	secretRef := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Secret the values are written into. It's created if it does not exist.",
+		},
+	}
+	if !namespaced {
+		secretRef["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The namespace of the Secret the values are written into.",
+		}
+	}
+	r.TerraformResource.Schema["secret_ref"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "The Secret the values are written into. It's controlled by the resource, which removes the values when they expire without being refreshed and when it's deleted.",
+		Elem:        &schema.Resource{Schema: secretRef},
+	}
+	r.AddSingletonListConversion("secret_ref", "secretRef")
+	r.TerraformResource.Schema["ttl"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "How long the values are valid after the action is run, as a duration of at least `5m`, e.g. `8h`. Default is `1h`.",
+	}
+	r.TerraformResource.Schema["refresh_before"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "How long before their expiry the values are refreshed, as a duration shorter than the `ttl`. It should exceed the poll interval of the provider. Default is `15m`.",
+	}
+	r.TerraformResource.Schema["issued_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the values in the Secret were issued, in RFC 3339 format.",
+	}
+	r.TerraformResource.Schema["expires_at"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the values in the Secret expire, in RFC 3339 format.",
+	}
+	r.TerraformResource.Schema["refresh_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the values in the Secret are refreshed, in RFC 3339 format.",
+	}
+	r.TerraformResource.Schema["secret_keys"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The keys of the values in the Secret.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	r.References["resource_id"] = config.Reference{
+		TerraformName: "azapi_resource",
+	}
+	r.MetaResource = &registry.Resource{
+		Name:         EphemeralResourceActionTypeName,
+		Description:  "EphemeralResourceAction runs a resource action returning short-lived values, e.g. a SAS or an access token, on a cadence and writes them only into a Secret.",
+		ArgumentDocs: map[string]string{},
+	}
+}
@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/crossplane/upjet/v2/pkg/registry"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ResourceActionTypeName is the Terraform resource the key actions are
+	// run with.
+	ResourceActionTypeName = "azapi_resource_action"
+	// ListKeysActionTypeName is the Terraform resource type name of the
+	// ListKeysAction kinds.
+	ListKeysActionTypeName = "azapi_resource_action_list_keys"
+	// RegenerateKeyActionTypeName is the Terraform resource type name of
+	// the RegenerateKeyAction kinds.
+	RegenerateKeyActionTypeName = "azapi_resource_action_regenerate_key"
+
+	// The well-known connection secret keys the keys returned by the key
+	// actions are published under.
+	ConnectionKeyPrimaryKey                = "primaryKey"
+	ConnectionKeySecondaryKey              = "secondaryKey"
+	ConnectionKeyPrimaryConnectionString   = "primaryConnectionString"
+	ConnectionKeySecondaryConnectionString = "secondaryConnectionString"
+
+	actionListKeys      = "listKeys"
+	actionRegenerateKey = "regenerateKey"
+
+	keyActionName                    = "action"
+	keyBody                          = "body"
+	keyKeyName                       = "key_name"
+	keyMethod                        = "method"
+	keySensitiveOutput               = "sensitive_output"
+	keySensitiveResponseExportValues = "sensitive_response_export_values"
+
+	errNoAliasedResourceFmt = "the Terraform provider schema has no %s"
+	errKeyNameBody          = "body must be an object to set the keyName"
+)
+
+// KeyActions maps the Terraform resource type names of the key actions to
+// the azapi_resource_action they are run with.
+var KeyActions = map[string]string{
+	ListKeysActionTypeName:      ResourceActionTypeName,
+	RegenerateKeyActionTypeName: ResourceActionTypeName,
+}
+
+var (
+	// keyAliases maps the names some Azure APIs return the keys under to
+	// the well-known connection secret keys.
+	keyAliases = map[string]string{
+		"key1":               ConnectionKeyPrimaryKey,
+		"primaryMasterKey":   ConnectionKeyPrimaryKey,
+		"key2":               ConnectionKeySecondaryKey,
+		"secondaryMasterKey": ConnectionKeySecondaryKey,
+	}
+
+	invalidSecretKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+)
+
+// AddKeyActionSchemas returns the supplied Terraform provider schema
+// document with the schemas of the key actions, which are the schema of the
+// azapi_resource_action.
+func AddKeyActionSchemas(doc string) (string, error) {
+	return addAliasSchemas(doc, KeyActions)
+}
+
+// addAliasSchemas returns the supplied Terraform provider schema document
+// with, for each entry of the supplied map, the schema of the resource with
+// the type name of the value under the type name of the key.
+func addAliasSchemas(doc string, aliases map[string]string) (string, error) {
+	ps := &tfjson.ProviderSchemas{}
+	if err := ps.UnmarshalJSON([]byte(doc)); err != nil {
+		return "", errors.Wrap(err, "cannot unmarshal the Terraform provider schema")
+	}
+	for _, s := range ps.Schemas {
+		for alias, name := range aliases {
+			base, ok := s.ResourceSchemas[name]
+			if !ok {
+				return "", errors.Errorf(errNoAliasedResourceFmt, name)
+			}
+			s.ResourceSchemas[alias] = base
+		}
+	}
+	b, err := json.Marshal(ps)
+	return string(b), errors.Wrap(err, "cannot marshal the Terraform provider schema")
+}
+
+// ListKeysAction configures an azapi_resource_action listing the keys of
+// the referenced resource. The key actions have no scraped metadata, their
+// fields have the descriptions of the azapi_resource_action. See keyAction.
+func ListKeysAction(r *config.Resource, namespaced bool) {
+	keyAction(r, actionListKeys, namespaced)
+	r.MetaResource = &registry.Resource{
+		Name:         ListKeysActionTypeName,
+		Description:  "ListKeysAction lists the keys of an existing Azure resource, e.g. a Storage Account, and publishes them into its connection secret.",
+		ArgumentDocs: map[string]string{},
+	}
+}
+
+// RegenerateKeyAction configures an azapi_resource_action regenerating a key
+// of the referenced resource. See keyAction.
+func RegenerateKeyAction(r *config.Resource, namespaced bool) {
+	keyAction(r, actionRegenerateKey, namespaced)
+	r.MetaResource = &registry.Resource{
+		Name:         RegenerateKeyActionTypeName,
+		Description:  "RegenerateKeyAction regenerates a key of an existing Azure resource, e.g. a Storage Account, and publishes the returned keys into its connection secret.",
+		ArgumentDocs: map[string]string{},
+	}
+}
+
+// keyAction configures a key action, an azapi_resource_action running the
+// supplied action by default and publishing the returned keys into the
+// connection secret. The keys are published under their names in the
+// response and under the well-known primaryKey, secondaryKey,
+// primaryConnectionString and secondaryConnectionString keys. The keys are
+// rotated by running the action again per its run policy and schedule.
+func keyAction(r *config.Resource, action string, namespaced bool) {
+	// the full response is exported as the sensitive output and the method
+	// is always POST
+	for _, k := range []string{keyMethod, "when", "output", "response_export_values", keySensitiveResponseExportValues} {
+		delete(r.TerraformResource.Schema, k)
+	}
+	r.TerraformResource.Schema[keyActionName].Description = "The name of the resource action. Default is `" + action + "`."
+	if action == actionRegenerateKey {
+		r.TerraformResource.Schema[keyKeyName] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the key to regenerate, e.g. `key1`. It's set as the `keyName` of the request body.",
+		}
+	}
+	r.References["resource_id"] = config.Reference{
+		TerraformName: "azapi_resource",
+	}
+	ResourceActionSchedule(r)
+	ResourceActionHistory(r, namespaced)
+	r.Sensitive.AdditionalConnectionDetailsFn = keyConnectionDetails
+	// the body must be set before it's wrapped as a dynamic value
+	r.TerraformConversions = append([]config.TerraformConversion{keyActionConversion{action: action}}, r.TerraformConversions...)
+}
+
+// keyActionConversion sets the fixed arguments of a key action.
+type keyActionConversion struct {
+	action string
+}
+
+func (c keyActionConversion) Convert(params map[string]any, _ *config.Resource, mode config.Mode) (map[string]any, error) {
+	if mode == config.FromTerraform {
+		body, ok := params[keyBody].(map[string]any)
+		if !ok {
+			return params, nil
+		}
+		if n, ok := body["keyName"]; ok && c.action == actionRegenerateKey {
+			params[keyKeyName] = n
+			delete(body, "keyName")
+			if len(body) == 0 {
+				delete(params, keyBody)
+			}
+		}
+		return params, nil
+	}
+	if a, ok := params[keyActionName].(string); !ok || a == "" {
+		params[keyActionName] = c.action
+	}
+	params[keyMethod] = "POST"
+	params[keySensitiveResponseExportValues] = []any{"*"}
+	if n, ok := params[keyKeyName]; ok {
+		delete(params, keyKeyName)
+		body := map[string]any{}
+		if b, ok := params[keyBody]; ok && b != nil {
+			if body, ok = b.(map[string]any); !ok {
+				return nil, errors.New(errKeyNameBody)
+			}
+		}
+		body["keyName"] = n
+		params[keyBody] = body
+	}
+	return params, nil
+}
+
+// keyConnectionDetails returns the keys in the sensitive output of a key
+// action. The top level strings are published under their names, the
+// entries of the keys and value lists under their keyName or name, and the
+// first two of them also as the primary and secondary keys.
+func keyConnectionDetails(attr map[string]any) (map[string][]byte, error) {
+	out, ok := attr[keySensitiveOutput].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	conn := map[string][]byte{}
+	set := func(k, v string) {
+		if k = SecretKey(k); k != "" {
+			conn[k] = []byte(v)
+		}
+	}
+	for k, v := range out {
+		if s, ok := v.(string); ok {
+			set(k, s)
+		}
+	}
+	for _, l := range []string{"keys", "value"} {
+		entries, _ := out[l].([]any)
+		for i, e := range entries {
+			m, ok := e.(map[string]any)
+			if !ok {
+				continue
+			}
+			v, _ := m["value"].(string)
+			n, ok := m["keyName"].(string)
+			if !ok {
+				n, _ = m["name"].(string)
+			}
+			if n != "" && v != "" {
+				set(n, v)
+			}
+			cs, _ := m["connectionString"].(string)
+			switch i {
+			case 0:
+				setDefault(conn, ConnectionKeyPrimaryKey, v)
+				setDefault(conn, ConnectionKeyPrimaryConnectionString, cs)
+			case 1:
+				setDefault(conn, ConnectionKeySecondaryKey, v)
+				setDefault(conn, ConnectionKeySecondaryConnectionString, cs)
+			}
+		}
+	}
+	for alias, k := range keyAliases {
+		if s, ok := out[alias].(string); ok {
+			setDefault(conn, k, s)
+		}
+	}
+	return conn, nil
+}
+
+// setDefault sets the supplied connection detail unless it's already set or
+// the value is empty.
+func setDefault(conn map[string][]byte, k, v string) {
+	if _, ok := conn[k]; ok || v == "" {
+		return
+	}
+	conn[k] = []byte(v)
+}
+
+// SecretKey returns the supplied name as a valid Secret key, or an empty
+// string if it cannot be one. The keys prefixed with attribute. are
+// reserved for the Terraform state.
+func SecretKey(name string) string {
+	k := strings.Trim(invalidSecretKeyChars.ReplaceAllString(name, "-"), "-")
+	if strings.HasPrefix(k, "attribute.") {
+		return ""
+	}
+	return k
+}
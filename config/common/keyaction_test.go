@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/crossplane/upjet/v2/pkg/config"
+)
+
+func TestKeyConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		attr map[string]any
+		want map[string][]byte
+	}{
+		"NoOutput": {
+			attr: map[string]any{"id": "/action"},
+		},
+		"StorageAccountKeys": {
+			attr: map[string]any{keySensitiveOutput: map[string]any{"keys": []any{
+				map[string]any{"keyName": "key1", "value": "k1", "permissions": "FULL"},
+				map[string]any{"keyName": "key2", "value": "k2", "permissions": "FULL"},
+			}}},
+			want: map[string][]byte{
+				"key1":                    []byte("k1"),
+				"key2":                    []byte("k2"),
+				ConnectionKeyPrimaryKey:   []byte("k1"),
+				ConnectionKeySecondaryKey: []byte("k2"),
+			},
+		},
+		"CosmosDBMasterKeys": {
+			attr: map[string]any{keySensitiveOutput: map[string]any{
+				"primaryMasterKey":           "m1",
+				"secondaryMasterKey":         "m2",
+				"primaryReadonlyMasterKey":   "r1",
+				"secondaryReadonlyMasterKey": "r2",
+			}},
+			want: map[string][]byte{
+				"primaryMasterKey":           []byte("m1"),
+				"secondaryMasterKey":         []byte("m2"),
+				"primaryReadonlyMasterKey":   []byte("r1"),
+				"secondaryReadonlyMasterKey": []byte("r2"),
+				ConnectionKeyPrimaryKey:      []byte("m1"),
+				ConnectionKeySecondaryKey:    []byte("m2"),
+			},
+		},
+		"TopLevelKeysWin": {
+			attr: map[string]any{keySensitiveOutput: map[string]any{
+				"primaryKey":              "p",
+				"primaryConnectionString": "pcs",
+				"key1":                    "k1",
+				"keyName":                 "RootManageSharedAccessKey",
+			}},
+			want: map[string][]byte{
+				ConnectionKeyPrimaryKey:              []byte("p"),
+				ConnectionKeyPrimaryConnectionString: []byte("pcs"),
+				"key1":                               []byte("k1"),
+				"keyName":                            []byte("RootManageSharedAccessKey"),
+			},
+		},
+		"ValueListWithConnectionStrings": {
+			attr: map[string]any{keySensitiveOutput: map[string]any{"value": []any{
+				map[string]any{"name": "primary", "value": "k1", "connectionString": "cs1"},
+				map[string]any{"name": "secondary", "value": "k2", "connectionString": "cs2"},
+				map[string]any{"name": "third", "value": "k3", "connectionString": "cs3"},
+				"not an object",
+			}}},
+			want: map[string][]byte{
+				"primary":                              []byte("k1"),
+				"secondary":                            []byte("k2"),
+				"third":                                []byte("k3"),
+				ConnectionKeyPrimaryKey:                []byte("k1"),
+				ConnectionKeySecondaryKey:              []byte("k2"),
+				ConnectionKeyPrimaryConnectionString:   []byte("cs1"),
+				ConnectionKeySecondaryConnectionString: []byte("cs2"),
+			},
+		},
+		"InvalidAndReservedNames": {
+			attr: map[string]any{keySensitiveOutput: map[string]any{
+				"attribute.id": "reserved",
+				"a key/1":      "k",
+				"!!!":          "invalid",
+				"count":        float64(2),
+			}},
+			want: map[string][]byte{
+				"a-key-1": []byte("k"),
+			},
+		},
+		"EntriesWithoutNames": {
+			attr: map[string]any{keySensitiveOutput: map[string]any{"keys": []any{
+				map[string]any{"value": "k1"},
+				map[string]any{"keyName": "key2"},
+			}}},
+			want: map[string][]byte{
+				ConnectionKeyPrimaryKey: []byte("k1"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := keyConnectionDetails(tc.attr)
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if tc.want == nil {
+				if got != nil {
+					t.Errorf("want no connection details, got %v", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSecretKey(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"Valid":           {name: "primary.Key_1", want: "primary.Key_1"},
+		"InvalidChars":    {name: "a key/1", want: "a-key-1"},
+		"TrimmedDashes":   {name: " key ", want: "key"},
+		"OnlyInvalid":     {name: "!!!", want: ""},
+		"ReservedPrefix":  {name: "attribute.id", want: ""},
+		"ReservedCleaned": {name: " attribute.id", want: ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := SecretKey(tc.name); got != tc.want {
+				t.Errorf("want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestKeyActionConversion(t *testing.T) {
+	cases := map[string]struct {
+		action  string
+		mode    config.Mode
+		params  map[string]any
+		want    map[string]any
+		wantErr string
+	}{
+		"ListKeysDefaults": {
+			action: actionListKeys,
+			mode:   config.ToTerraform,
+			params: map[string]any{"resource_id": "/sa"},
+			want: map[string]any{
+				"resource_id":                    "/sa",
+				keyActionName:                    actionListKeys,
+				keyMethod:                        "POST",
+				keySensitiveResponseExportValues: []any{"*"},
+			},
+		},
+		"CustomAction": {
+			action: actionListKeys,
+			mode:   config.ToTerraform,
+			params: map[string]any{keyActionName: "listConnectionStrings"},
+			want: map[string]any{
+				keyActionName:                    "listConnectionStrings",
+				keyMethod:                        "POST",
+				keySensitiveResponseExportValues: []any{"*"},
+			},
+		},
+		"KeyNameToBody": {
+			action: actionRegenerateKey,
+			mode:   config.ToTerraform,
+			params: map[string]any{keyKeyName: "key1", keyBody: map[string]any{"other": true}},
+			want: map[string]any{
+				keyActionName:                    actionRegenerateKey,
+				keyMethod:                        "POST",
+				keySensitiveResponseExportValues: []any{"*"},
+				keyBody:                          map[string]any{"keyName": "key1", "other": true},
+			},
+		},
+		"KeyNameWithInvalidBody": {
+			action:  actionRegenerateKey,
+			mode:    config.ToTerraform,
+			params:  map[string]any{keyKeyName: "key1", keyBody: "body"},
+			wantErr: errKeyNameBody,
+		},
+		"KeyNameFromBody": {
+			action: actionRegenerateKey,
+			mode:   config.FromTerraform,
+			params: map[string]any{keyBody: map[string]any{"keyName": "key1"}},
+			want:   map[string]any{keyKeyName: "key1"},
+		},
+		"ListKeysBodyKept": {
+			action: actionListKeys,
+			mode:   config.FromTerraform,
+			params: map[string]any{keyBody: map[string]any{"keyName": "key1"}},
+			want:   map[string]any{keyBody: map[string]any{"keyName": "key1"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := keyActionConversion{action: tc.action}.Convert(tc.params, nil, tc.mode)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AzureLock adds the arguments and attributes of the Azure management lock
+// of an azapi_resource. The lock is maintained by the provider and is not
+// passed to the Terraform provider.
+func AzureLock(r *config.Resource) {
+	r.TerraformResource.Schema["azure_lock"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The Azure management lock of the resource. The lock is lifted while the resource is updated or deleted by the provider and removed when this block is removed.",
+		Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"level": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The level of the lock, value must be one of: `CanNotDelete`, `ReadOnly`.",
+			},
+			"notes": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The notes of the lock, at most 512 characters.",
+			},
+		}},
+	}
+	r.AddSingletonListConversion("azure_lock", "azureLock")
+	r.TerraformResource.Schema["azure_lock_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the Azure management lock of the resource.",
+	}
+	r.TerraformResource.Schema["azure_lock_state"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The state of the Azure management lock of the resource. It's `Locked` while the lock is in place and `Lifted` while it's lifted for an update or the deletion of the resource.",
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Aliases returns the type names of the resources served under additional
+// type names, the key actions and the ephemeral kinds, mapped to the
+// resources they are served by.
+func Aliases() map[string]string {
+	aliases := make(map[string]string, len(KeyActions)+len(EphemeralKinds))
+	for k, v := range KeyActions {
+		aliases[k] = v
+	}
+	for k, v := range EphemeralKinds {
+		aliases[k] = v
+	}
+	return aliases
+}
+
+// aliasProvider wraps a Terraform plugin framework provider so that it
+// serves some of its resources under additional type names.
+type aliasProvider struct {
+	fwprovider.Provider
+	aliases map[string]string
+}
+
+// AliasProvider returns a provider serving the resources of the supplied
+// provider and, for each entry of the supplied map, the resource with the
+// type name of the value under the type name of the key.
+func AliasProvider(p fwprovider.Provider, aliases map[string]string) fwprovider.Provider {
+	if p == nil || len(aliases) == 0 {
+		return p
+	}
+	return &aliasProvider{Provider: p, aliases: aliases}
+}
+
+func (p *aliasProvider) Resources(ctx context.Context) []func() fwresource.Resource {
+	mResp := &fwprovider.MetadataResponse{}
+	p.Metadata(ctx, fwprovider.MetadataRequest{}, mResp)
+
+	fns := p.Provider.Resources(ctx)
+	byName := make(map[string]func() fwresource.Resource, len(fns))
+	for _, fn := range fns {
+		rResp := &fwresource.MetadataResponse{}
+		fn().Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: mResp.TypeName}, rResp)
+		byName[rResp.TypeName] = fn
+	}
+	result := make([]func() fwresource.Resource, 0, len(fns)+len(p.aliases))
+	result = append(result, fns...)
+	for alias, name := range p.aliases {
+		fn, ok := byName[name]
+		if !ok {
+			continue
+		}
+		result = append(result, func() fwresource.Resource {
+			return renameResource(fn(), alias)
+		})
+	}
+	return result
+}
+
+// renameResource returns the supplied resource under the supplied type
+// name.
+func renameResource(r fwresource.Resource, typeName string) fwresource.Resource {
+	return ResourceWrapper{TypeName: typeName}.Wrap(r)
+}
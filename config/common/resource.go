@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// A ResourceWrapper wraps Terraform plugin framework resources. The wrapped
+// resources forward the optional interfaces reachable through the
+// ReadResource, PlanResourceChange, ApplyResourceChange, ImportResourceState
+// and UpgradeResourceState RPCs to the resources they wrap. The type name
+// and the operations of the wrapper that are set replace the ones of the
+// wrapped resources.
+type ResourceWrapper struct {
+	TypeName string
+
+	Create func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse)
+	Read   func(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse)
+	Update func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse)
+	Delete func(ctx context.Context, req fwresource.DeleteRequest, resp *fwresource.DeleteResponse)
+	// ModifyPlan is called whether or not the wrapped resource implements
+	// resource.ResourceWithModifyPlan.
+	ModifyPlan func(ctx context.Context, req fwresource.ModifyPlanRequest, resp *fwresource.ModifyPlanResponse)
+}
+
+// Wrap returns the supplied resource wrapped by the wrapper.
+func (w ResourceWrapper) Wrap(r fwresource.Resource) fwresource.Resource {
+	wr := &wrappedResource{Resource: r, wrapper: w}
+	if _, ok := r.(fwresource.ResourceWithIdentity); ok {
+		return &wrappedResourceWithIdentity{wrappedResource: wr}
+	}
+	return wr
+}
+
+type wrappedResource struct {
+	fwresource.Resource
+	wrapper ResourceWrapper
+}
+
+func (r *wrappedResource) Metadata(ctx context.Context, req fwresource.MetadataRequest, resp *fwresource.MetadataResponse) {
+	if r.wrapper.TypeName == "" {
+		r.Resource.Metadata(ctx, req, resp)
+		return
+	}
+	resp.TypeName = r.wrapper.TypeName
+}
+
+func (r *wrappedResource) Create(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse) {
+	if r.wrapper.Create == nil {
+		r.Resource.Create(ctx, req, resp)
+		return
+	}
+	r.wrapper.Create(ctx, req, resp)
+}
+
+func (r *wrappedResource) Read(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse) {
+	if r.wrapper.Read == nil {
+		r.Resource.Read(ctx, req, resp)
+		return
+	}
+	r.wrapper.Read(ctx, req, resp)
+}
+
+func (r *wrappedResource) Update(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse) {
+	if r.wrapper.Update == nil {
+		r.Resource.Update(ctx, req, resp)
+		return
+	}
+	r.wrapper.Update(ctx, req, resp)
+}
+
+func (r *wrappedResource) Delete(ctx context.Context, req fwresource.DeleteRequest, resp *fwresource.DeleteResponse) {
+	if r.wrapper.Delete == nil {
+		r.Resource.Delete(ctx, req, resp)
+		return
+	}
+	r.wrapper.Delete(ctx, req, resp)
+}
+
+func (r *wrappedResource) Configure(ctx context.Context, req fwresource.ConfigureRequest, resp *fwresource.ConfigureResponse) {
+	if rc, ok := r.Resource.(fwresource.ResourceWithConfigure); ok {
+		rc.Configure(ctx, req, resp)
+	}
+}
+
+func (r *wrappedResource) ModifyPlan(ctx context.Context, req fwresource.ModifyPlanRequest, resp *fwresource.ModifyPlanResponse) {
+	if r.wrapper.ModifyPlan != nil {
+		r.wrapper.ModifyPlan(ctx, req, resp)
+		return
+	}
+	if rm, ok := r.Resource.(fwresource.ResourceWithModifyPlan); ok {
+		rm.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (r *wrappedResource) ValidateConfig(ctx context.Context, req fwresource.ValidateConfigRequest, resp *fwresource.ValidateConfigResponse) {
+	if rv, ok := r.Resource.(fwresource.ResourceWithValidateConfig); ok {
+		rv.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (r *wrappedResource) ConfigValidators(ctx context.Context) []fwresource.ConfigValidator {
+	if rv, ok := r.Resource.(fwresource.ResourceWithConfigValidators); ok {
+		return rv.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (r *wrappedResource) ImportState(ctx context.Context, req fwresource.ImportStateRequest, resp *fwresource.ImportStateResponse) {
+	if ri, ok := r.Resource.(fwresource.ResourceWithImportState); ok {
+		ri.ImportState(ctx, req, resp)
+	}
+}
+
+func (r *wrappedResource) UpgradeState(ctx context.Context) map[int64]fwresource.StateUpgrader {
+	if ru, ok := r.Resource.(fwresource.ResourceWithUpgradeState); ok {
+		return ru.UpgradeState(ctx)
+	}
+	return nil
+}
+
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (r *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, req fwresource.IdentitySchemaRequest, resp *fwresource.IdentitySchemaResponse) {
+	r.Resource.(fwresource.ResourceWithIdentity).IdentitySchema(ctx, req, resp)
+}
+
+func (r *wrappedResourceWithIdentity) UpgradeIdentity(ctx context.Context) map[int64]fwresource.IdentityUpgrader {
+	if ru, ok := r.Resource.(fwresource.ResourceWithUpgradeIdentity); ok {
+		return ru.UpgradeIdentity(ctx)
+	}
+	return nil
+}
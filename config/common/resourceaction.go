@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package common contains the resource configurations shared by the
+// cluster-scoped and the namespaced resources.
+package common
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceActionSchedule adds the arguments and attributes controlling the
+// repeated execution of an azapi_resource_action. They are handled by the
+// provider and are not passed to the Terraform provider.
+func ResourceActionSchedule(r *config.Resource) {
+	r.TerraformResource.Schema["run_policy"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "When to run the action, value must be one of: `Once`, `OnChange`, `Scheduled`. `Once` runs the action only when the resource is created, `OnChange` also runs it whenever the arguments change and `Scheduled` also runs it at the times given by `schedule`. Default is `Scheduled` if `schedule` is set and `OnChange` otherwise.",
+	}
+	r.TerraformResource.Schema["schedule"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "A cron expression in UTC specifying when to run the action again, e.g. `0 3 * * *`, `@daily` or `@every 12h`. Only used with the `Scheduled` run policy. A scheduled run happens at the first reconciliation after the scheduled time.",
+	}
+	r.TerraformResource.Schema["last_run_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the action was last run, in RFC 3339 format. The `output` holds the output of that run.",
+	}
+	r.TerraformResource.Schema["next_run_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the action is next run according to the `schedule`, in RFC 3339 format.",
+	}
+}
+
+// ResourceActionHistory adds the arguments and attributes recording the
+// history of the runs of an azapi_resource_action. The namespace of the
+// output store can only be chosen for cluster-scoped resources, namespaced
+// resources use their own namespace.
+func ResourceActionHistory(r *config.Resource, namespaced bool) {
+	r.TerraformResource.Schema["history_limit"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The maximum number of action runs kept in `history`, between `0` and `50`. Default is `10`.",
+	}
+	store := map[string]*schema.Schema{
+		"kind": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The kind of the object the full outputs are stored in, value must be one of: `ConfigMap`, `Secret`. The `sensitive_output` is only stored in Secrets. The values of the keys that look like credentials, e.g. `primaryKey`, and the strings that look like connection strings, SAS tokens or JWTs are redacted from ConfigMaps, other credentials are not detected and require a Secret.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the object the full outputs are stored in. It's created if it does not exist.",
+		},
+	}
+	if !namespaced {
+		store["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The namespace of the object the full outputs are stored in.",
+		}
+	}
+	r.TerraformResource.Schema["output_store"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "A ConfigMap or Secret the full output of each run in `history` is stored in, under the `outputRef` key of the run.",
+		Elem:        &schema.Resource{Schema: store},
+	}
+	r.AddSingletonListConversion("output_store", "outputStore")
+	r.TerraformResource.Schema["history"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The latest runs of the action, the most recent first.",
+		Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the run completed, in RFC 3339 format.",
+			},
+			"method": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The HTTP method of the run.",
+			},
+			"status_code": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The HTTP status code of the last response of the run. It's `0` if no response was received.",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error of a failed run, truncated.",
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON encoded `output` of the run, truncated. The values of the keys that look like credentials, e.g. `primaryKey`, and the strings that look like connection strings, SAS tokens or JWTs are redacted, other credentials are not detected.",
+			},
+			"output_truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether `output` is truncated.",
+			},
+			"output_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 digest of the full JSON encoded `output` of the run.",
+			},
+			"output_ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The key of the full output of the run in the `output_store`.",
+			},
+		}},
+	}
+}
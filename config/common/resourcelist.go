@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceList configures the ResourceList kinds, which are listed by the
+// provider rather than the azapi_resource_list data source so that the
+// number of listed items can be bounded. The output is published into the
+// status, or into a ConfigMap when it's too large for the status. The
+// namespace of the ConfigMap can only be chosen for cluster-scoped
+// resources, namespaced resources use their own namespace.
+func ResourceList(r *config.Resource, namespaced bool) {
+	// the requests are not retried by the provider
+	delete(r.TerraformResource.Schema, "retry")
+	r.TerraformResource.Schema["max_items"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The maximum number of listed items, between `1` and `10000`. The pages are no longer requested once it's reached and `truncated` is set. Default is `1000`.",
+	}
+	cm := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the ConfigMap the output is stored in when it's too large for the status. It's created if it does not exist.",
+		},
+	}
+	desc := "The ConfigMap the output is stored in when it's too large for the status, under the `outputRef` key. Default is a ConfigMap with the name of the ResourceList."
+	if !namespaced {
+		cm["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The namespace of the ConfigMap the output is stored in.",
+		}
+		desc = "The ConfigMap the output is stored in when it's too large for the status, under the `outputRef` key. Outputs too large for the status are rejected if unset."
+	}
+	r.TerraformResource.Schema["output_config_map"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: desc,
+		Elem:        &schema.Resource{Schema: cm},
+	}
+	r.AddSingletonListConversion("output_config_map", "outputConfigMap")
+	r.TerraformResource.Schema["item_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of listed items.",
+	}
+	r.TerraformResource.Schema["truncated"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Indicates whether there are more items than `max_items`, which are not listed.",
+	}
+	r.TerraformResource.Schema["output_ref"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The key of the output in the `output_config_map` if it's too large for the status, in which case the `output` is not set.",
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TagPropagation adds the attribute recording the tags propagated from the
+// metadata of an azapi_resource or an azapi_update_resource by its provider
+// config. The tags of the resources with a tags argument are not
+// late-initialized, so that the propagated tags do not become tags set in
+// the managed resource.
+func TagPropagation(r *config.Resource) {
+	r.TerraformResource.Schema["propagated_tags"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The tags propagated from the metadata of the managed resource by the tag propagation of its provider config.",
+	}
+	if _, ok := r.TerraformResource.Schema["tags"]; ok {
+		r.LateInitializer.IgnoredFields = append(r.LateInitializer.IgnoredFields, "tags")
+	}
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BodyTemplating adds the arguments and attributes of the templating of the
+// body of an azapi_resource, an azapi_update_resource or an
+// azapi_data_plane_resource. The body is rendered by the provider and the
+// rendered body is passed to the Terraform provider in place of the
+// configured one.
+func BodyTemplating(r *config.Resource) {
+	r.TerraformResource.Schema["body_templating"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the string values of `body` are rendered as Go templates with the metadata of the managed resource and the defaults of its provider config, e.g. `{{ .Metadata.Name }}`, `{{ .Defaults.Location }}` or `{{ .Labels }}`. A string value consisting of a single template action is replaced with the value of the action, e.g. an object. Default is `false`.",
+	}
+	r.TerraformResource.Schema["rendered_body"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The `body` rendered with `body_templating`, in JSON.",
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/crossplane/upjet/v2/pkg/config"
+
+	"github.com/upbound/provider-azapi/v2/config/typed"
 )
 
 // ExternalNameConfigs contains all external name configurations for this
@@ -20,6 +22,15 @@ var ExternalNameConfigs = map[string]config.ExternalName{
 	"azapi_resource":            azapiResource(),
 	"azapi_resource_action":     azapiResourceAction(),
 	"azapi_update_resource":     azapiUpdateResource(),
+	// the key actions are run with the azapi_resource_action
+	"azapi_resource_action_list_keys":      azapiResourceAction(),
+	"azapi_resource_action_regenerate_key": azapiResourceAction(),
+	// the ephemeral kinds run their actions through the provider
+	"azapi_resource_action_ephemeral": azapiResourceAction(),
+	// the data kinds are identified by the IDs their data sources read
+	"azapi_resource_data":      config.IdentifierFromProvider,
+	"azapi_resource_list_data": config.IdentifierFromProvider,
+	"azapi_client_config_data": config.IdentifierFromProvider,
 }
 
 func dataPlaneResource() config.ExternalName {
@@ -141,3 +152,31 @@ func resourceConfigurator() config.ResourceOption {
 		r.ExternalName = e
 	}
 }
+
+// typedExternalName returns the external name configuration of the supplied
+// typed kind, which is the configuration of the azapi_resource with the
+// fixed type of the kind.
+func typedExternalName(k typed.Kind) config.ExternalName {
+	e := azapiResource()
+	getIDFn := e.GetIDFn
+	e.GetIDFn = func(ctx context.Context, externalName string, parameters map[string]any, terraformProviderConfig map[string]any) (string, error) {
+		if _, ok := parameters["type"]; !ok {
+			parameters["type"] = k.TypeAPIVersion()
+		}
+		return getIDFn(ctx, externalName, parameters, terraformProviderConfig)
+	}
+	return e
+}
+
+// typedResourceConfigurator sets the external name configurations of the
+// supplied typed kinds.
+func typedResourceConfigurator(kinds []typed.Kind) config.ResourceOption {
+	return func(r *config.Resource) {
+		for _, k := range kinds {
+			if k.TerraformName == r.Name {
+				r.ExternalName = typedExternalName(k)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+
+	"github.com/upbound/provider-azapi/v2/config/common"
+)
+
+const (
+	group           = "data"
+	versionV1Alpha1 = "v1alpha1"
+)
+
+// Configure configures the read-only kinds of the data sources.
+func Configure(p *config.Provider) {
+	p.AddResourceConfigurator(common.ResourceDataTypeName, func(r *config.Resource) {
+		r.Kind = "ResourceData"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.DataSource(r, "ResourceData reads an existing Azure resource with the azapi_resource data source and publishes its output into its status. It never creates, updates or deletes the resource.")
+	})
+	p.AddResourceConfigurator(common.ResourceListDataTypeName, func(r *config.Resource) {
+		r.Kind = "ResourceList"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.DataSource(r, "ResourceList lists the existing Azure resources of a type under a parent, following the nextLinks of the list operation up to a maximum number of items, and publishes them into its status or into a ConfigMap when they are large. It never creates, updates or deletes any resource.")
+		common.ResourceList(r, true)
+	})
+	p.AddResourceConfigurator(common.ClientConfigDataTypeName, func(r *config.Resource) {
+		r.Kind = "ClientConfig"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.DataSource(r, "ClientConfig reads the configuration of the Azure client of its ProviderConfig with the azapi_client_config data source and publishes its tenantId, subscriptionId and objectId into its status, e.g. to be patched into role assignments. It never creates, updates or deletes anything.")
+	})
+}
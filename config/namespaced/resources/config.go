@@ -6,15 +6,23 @@ package resources
 
 import (
 	"github.com/crossplane/upjet/v2/pkg/config"
+
+	"github.com/upbound/provider-azapi/v2/config/common"
 )
 
-const group = "resources"
+const (
+	group           = "resources"
+	versionV1Beta1  = "v1beta1"
+	versionV1Beta2  = "v1beta2"
+	versionV1Alpha1 = "v1alpha1"
+)
 
 // Configure configures individual resources by adding custom ResourceConfigurators.
 func Configure(p *config.Provider) {
 	p.AddResourceConfigurator("azapi_data_plane_resource", func(r *config.Resource) {
 		r.Kind = "DataPlaneResource"
 		r.ShortGroup = group
+		configureVersions(r)
 		// Following attributes trigger TF resource replacement, which is not
 		// supported per XRM in Crossplane.
 		delete(r.TerraformResource.Schema, "replace_triggers_external_values")
@@ -23,10 +31,13 @@ func Configure(p *config.Provider) {
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.DeletionPolicy(r)
+		common.BodyTemplating(r)
 	})
 	p.AddResourceConfigurator("azapi_resource", func(r *config.Resource) {
 		r.Kind = "Resource"
 		r.ShortGroup = group
+		configureVersions(r)
 		// Following attributes trigger TF resource replacement, which is not
 		// supported per XRM in Crossplane.
 		delete(r.TerraformResource.Schema, "replace_triggers_external_values")
@@ -35,18 +46,44 @@ func Configure(p *config.Provider) {
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.DeletionPolicy(r)
+		common.AzureLock(r)
+		common.BodyTemplating(r)
+		common.TagPropagation(r)
 	})
 	p.AddResourceConfigurator("azapi_resource_action", func(r *config.Resource) {
 		r.Kind = "ResourceAction"
 		r.ShortGroup = group
+		configureVersions(r)
 		// disable scraped argument docs to prevent duplicate field
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.ResourceActionSchedule(r)
+		common.ResourceActionHistory(r, true)
+	})
+	p.AddResourceConfigurator(common.ListKeysActionTypeName, func(r *config.Resource) {
+		r.Kind = "ListKeysAction"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.ListKeysAction(r, true)
+	})
+	p.AddResourceConfigurator(common.RegenerateKeyActionTypeName, func(r *config.Resource) {
+		r.Kind = "RegenerateKeyAction"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.RegenerateKeyAction(r, true)
+	})
+	p.AddResourceConfigurator(common.EphemeralResourceActionTypeName, func(r *config.Resource) {
+		r.Kind = "EphemeralResourceAction"
+		r.ShortGroup = group
+		r.Version = versionV1Alpha1
+		common.EphemeralResourceAction(r, true)
 	})
 	p.AddResourceConfigurator("azapi_update_resource", func(r *config.Resource) {
 		r.Kind = "UpdateResource"
 		r.ShortGroup = group
+		configureVersions(r)
 		r.LateInitializer = config.LateInitializer{
 			IgnoredFields: []string{"name", "parent_id"},
 		}
@@ -57,5 +94,18 @@ func Configure(p *config.Provider) {
 		// descriptions in CRD schema as all fields have descriptions
 		// provided by their TF schema
 		r.MetaResource.ArgumentDocs = map[string]string{}
+		common.BodyTemplating(r)
+		common.TagPropagation(r)
 	})
 }
+
+// configureVersions serves the kinds in v1beta2, with v1beta1 as a previous
+// version, and stores them in v1beta2 like the cluster-scoped kinds. Both
+// versions have the same schema, so the default identity conversion
+// suffices.
+func configureVersions(r *config.Resource) {
+	r.Version = versionV1Beta2
+	r.PreviousVersions = []string{versionV1Beta1}
+	r.ControllerReconcileVersion = versionV1Beta2 //nolint:staticcheck // still handling the deprecated behavior until rollout
+	r.SetCRDStorageVersion(versionV1Beta2)
+}
@@ -15,8 +15,12 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
+	dataCluster "github.com/upbound/provider-azapi/v2/config/cluster/data"
 	resourcesCluster "github.com/upbound/provider-azapi/v2/config/cluster/resources"
+	"github.com/upbound/provider-azapi/v2/config/common"
+	dataNamespaced "github.com/upbound/provider-azapi/v2/config/namespaced/data"
 	resourcesNamespaced "github.com/upbound/provider-azapi/v2/config/namespaced/resources"
+	"github.com/upbound/provider-azapi/v2/config/typed"
 )
 
 const (
@@ -52,33 +56,57 @@ func getProviderSchema(s string) (*schema.Provider, error) {
 func GetProvider(ctx context.Context, generationProvider bool) (*ujconfig.Provider, error) {
 	var p *schema.Provider
 	var err error
+	fwProvider, err := xpprovider.FrameworkProvider(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get the Terraform provider schema with generation mode set to %t", generationProvider)
+	}
+	kinds, err := typed.Kinds()
+	if err != nil {
+		return nil, err
+	}
+	fwProvider = common.DataSourceProvider(common.AliasProvider(typed.Provider(fwProvider, kinds), common.Aliases()), common.DataSources)
+	schemaDoc, err := typed.AddSchemas(providerSchema, kinds)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the typed kinds")
+	}
+	schemaDoc, err = common.AddKeyActionSchemas(schemaDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the key actions")
+	}
+	schemaDoc, err = common.AddEphemeralSchemas(schemaDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the ephemeral kinds")
+	}
+	schemaDoc, err = common.AddDataSourceSchemas(schemaDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the data kinds")
+	}
 	if generationProvider {
-		p, err = getProviderSchema(providerSchema)
+		p, err = getProviderSchema(schemaDoc)
 		if err != nil {
 			return nil, errors.Wrapf(err, "cannot get the Terraform provider schema with generation mode set to %t", generationProvider)
 		}
 	}
-	fwProvider, err := xpprovider.FrameworkProvider(ctx)
-	if err != nil {
-		return nil, errors.Wrapf(err, "cannot get the Terraform provider schema with generation mode set to %t", generationProvider)
-	}
-	pc := ujconfig.NewProvider([]byte(providerSchema), resourcePrefix, modulePath, []byte(providerMetadata),
+	pc := ujconfig.NewProvider([]byte(schemaDoc), resourcePrefix, modulePath, []byte(providerMetadata),
 		ujconfig.WithIncludeList(resourceList(cliReconciledExternalNameConfigs)),
 		ujconfig.WithRootGroup("azapi.upbound.io"),
-		ujconfig.WithTerraformPluginFrameworkIncludeList(resourceList(ExternalNameConfigs)),
+		ujconfig.WithTerraformPluginFrameworkIncludeList(append(resourceList(ExternalNameConfigs), typedResourceList(kinds)...)),
 		ujconfig.WithFeaturesPackage("internal/features"),
 		ujconfig.WithTerraformProvider(p),
 		ujconfig.WithTerraformPluginFrameworkProvider(fwProvider),
 		ujconfig.WithDefaultResourceOptions(
 			resourceConfigurator(),
+			typedResourceConfigurator(kinds),
 		))
 
 	for _, configure := range []func(provider *ujconfig.Provider){
 		// add custom config functions
 		resourcesCluster.Configure,
+		dataCluster.Configure,
 	} {
 		configure(pc)
 	}
+	typed.Configure(pc, kinds)
 
 	pc.ConfigureResources()
 	return pc, nil
@@ -88,34 +116,58 @@ func GetProvider(ctx context.Context, generationProvider bool) (*ujconfig.Provid
 func GetProviderNamespaced(ctx context.Context, generationProvider bool) (*ujconfig.Provider, error) {
 	var p *schema.Provider
 	var err error
-	if generationProvider {
-		p, err = getProviderSchema(providerSchema)
-		if err != nil {
-			return nil, errors.Wrapf(err, "cannot get the Terraform provider schema with generation mode set to %t", generationProvider)
-		}
-	}
 
 	fwProvider, err := xpprovider.FrameworkProvider(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot get the Terraform provider schema with generation mode set to %t", generationProvider)
 	}
-	pc := ujconfig.NewProvider([]byte(providerSchema), resourcePrefix, modulePath, []byte(providerMetadata),
+	kinds, err := typed.Kinds()
+	if err != nil {
+		return nil, err
+	}
+	fwProvider = common.DataSourceProvider(common.AliasProvider(typed.Provider(fwProvider, kinds), common.Aliases()), common.DataSources)
+	schemaDoc, err := typed.AddSchemas(providerSchema, kinds)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the typed kinds")
+	}
+	schemaDoc, err = common.AddKeyActionSchemas(schemaDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the key actions")
+	}
+	schemaDoc, err = common.AddEphemeralSchemas(schemaDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the ephemeral kinds")
+	}
+	schemaDoc, err = common.AddDataSourceSchemas(schemaDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot add the schemas of the data kinds")
+	}
+	if generationProvider {
+		p, err = getProviderSchema(schemaDoc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get the Terraform provider schema with generation mode set to %t", generationProvider)
+		}
+	}
+	pc := ujconfig.NewProvider([]byte(schemaDoc), resourcePrefix, modulePath, []byte(providerMetadata),
 		ujconfig.WithIncludeList(resourceList(cliReconciledExternalNameConfigs)),
 		ujconfig.WithRootGroup("azapi.m.upbound.io"),
-		ujconfig.WithTerraformPluginFrameworkIncludeList(resourceList(ExternalNameConfigs)),
+		ujconfig.WithTerraformPluginFrameworkIncludeList(append(resourceList(ExternalNameConfigs), typedResourceList(kinds)...)),
 		ujconfig.WithFeaturesPackage("internal/features"),
 		ujconfig.WithTerraformProvider(p),
 		ujconfig.WithTerraformPluginFrameworkProvider(fwProvider),
 		ujconfig.WithDefaultResourceOptions(
 			resourceConfigurator(),
+			typedResourceConfigurator(kinds),
 		))
 
 	for _, configure := range []func(provider *ujconfig.Provider){
 		// add custom config functions
 		resourcesNamespaced.Configure,
+		dataNamespaced.Configure,
 	} {
 		configure(pc)
 	}
+	typed.Configure(pc, kinds)
 
 	pc.ConfigureResources()
 	return pc, nil
@@ -133,3 +185,13 @@ func resourceList(t map[string]ujconfig.ExternalName) []string {
 	}
 	return l
 }
+
+// typedResourceList returns the list of the Terraform resources of the
+// supplied typed kinds.
+func typedResourceList(kinds []typed.Kind) []string {
+	l := make([]string, len(kinds))
+	for i, k := range kinds {
+		l[i] = k.TerraformName + "$"
+	}
+	return l
+}
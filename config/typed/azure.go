@@ -0,0 +1,515 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/upjet/v2/pkg/types/name"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SchemaPath is the path of the Azure schema in the azapi Terraform
+	// provider module.
+	SchemaPath = "internal/azure/generated"
+
+	azapiModule = "github.com/Azure/terraform-provider-azapi"
+
+	// flags of the properties in the Azure schema
+	flagRequired = 1
+	flagReadOnly = 2
+
+	// maxDepth is the depth beyond which the objects are dynamic
+	maxDepth = 8
+
+	errInvalidEntry = "invalid entry %q: must be <type>@<apiVersion>, optionally followed by =<Kind>"
+	errReadIndex    = "cannot read the Azure schema index"
+	errUnknownType  = "resource type %s is not in the Azure schema"
+	errReadTypes    = "cannot read the Azure schema types"
+	errInvalidRef   = "invalid reference %q"
+	errNotResource  = "%s is not a resource type"
+	errDuplicate    = "duplicate kind %s.%s"
+	errFindModule   = "cannot find the azapi Terraform provider module"
+)
+
+// the resource body properties that are azapi_resource arguments or are
+// managed by Azure
+var skippedRootProperties = map[string]bool{
+	"id":         true,
+	"name":       true,
+	"type":       true,
+	"apiVersion": true,
+	"location":   true,
+	"tags":       true,
+	"identity":   true,
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]`)
+
+type azureRef struct {
+	Ref string `json:"$ref"`
+}
+
+type azureProperty struct {
+	Type        azureRef `json:"type"`
+	Flags       int      `json:"flags"`
+	Description string   `json:"description"`
+}
+
+type azureType struct {
+	Type                 string                   `json:"$type"`
+	Name                 string                   `json:"name"`
+	Value                string                   `json:"value"`
+	Properties           map[string]azureProperty `json:"properties"`
+	AdditionalProperties *azureRef                `json:"additionalProperties"`
+	ItemType             *azureRef                `json:"itemType"`
+	Elements             json.RawMessage          `json:"elements"`
+	Discriminator        string                   `json:"discriminator"`
+	BaseProperties       map[string]azureProperty `json:"baseProperties"`
+	Body                 *azureRef                `json:"body"`
+	Sensitive            bool                     `json:"sensitive"`
+}
+
+// azureSchema reads the Azure schema, as generated by bicep-types-az and
+// embedded in the azapi Terraform provider.
+type azureSchema struct {
+	dir   string
+	files map[string][]azureType
+	// the references being resolved, to detect the recursive types
+	visiting map[string]bool
+}
+
+// Load returns the typed kinds of the supplied entries, of the form
+// <type>@<apiVersion>[=<Kind>], e.g.
+// Microsoft.Storage/storageAccounts@2023-01-01, from the Azure schema in the
+// supplied directory, i.e. the internal/azure/generated directory of the
+// azapi Terraform provider.
+func Load(dir string, entries []string) ([]Kind, error) {
+	s, err := LoadSchema(dir)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Kind, 0, len(entries))
+	seen := map[string]bool{}
+	for _, e := range entries {
+		k, err := parseEntry(e)
+		if err != nil {
+			return nil, err
+		}
+		if seen[k.Kind+"."+k.Group] {
+			return nil, errors.Errorf(errDuplicate, k.Kind, k.Group)
+		}
+		seen[k.Kind+"."+k.Group] = true
+		if k.Body, err = s.Body(k.TypeAPIVersion()); err != nil {
+			return nil, err
+		}
+		result = append(result, k)
+	}
+	return result, nil
+}
+
+// ModuleSchemaDir returns the directory of the Azure schema in the azapi
+// Terraform provider module of the module cache.
+func ModuleSchemaDir() (string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", azapiModule).Output()
+	if err != nil {
+		return "", errors.Wrap(err, errFindModule)
+	}
+	return filepath.Join(strings.TrimSpace(string(out)), SchemaPath), nil
+}
+
+// A Schema is the Azure schema of the resource types, as generated by
+// bicep-types-az and embedded in the azapi Terraform provider.
+type Schema struct {
+	s         *azureSchema
+	resources map[string]azureRef
+}
+
+// LoadSchema returns the Azure schema in the supplied directory, i.e. the
+// internal/azure/generated directory of the azapi Terraform provider. The
+// schemas of the resource types are read on demand.
+func LoadSchema(dir string) (*Schema, error) {
+	b, err := os.ReadFile(filepath.Join(dir, "index.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, errReadIndex)
+	}
+	var index struct {
+		Resources map[string]azureRef `json:"resources"`
+	}
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, errors.Wrap(err, errReadIndex)
+	}
+	resources := make(map[string]azureRef, len(index.Resources))
+	for k, v := range index.Resources {
+		resources[strings.ToLower(k)] = v
+	}
+	return &Schema{
+		s:         &azureSchema{dir: dir, files: map[string][]azureType{}, visiting: map[string]bool{}},
+		resources: resources,
+	}, nil
+}
+
+// Has returns true if the schema has the supplied resource type at the
+// supplied API version, e.g. Microsoft.Storage/storageAccounts@2023-01-01.
+func (s *Schema) Has(typeAPIVersion string) bool {
+	_, ok := s.resources[strings.ToLower(typeAPIVersion)]
+	return ok
+}
+
+// Body returns the writable properties of the body of the supplied resource
+// type at the supplied API version, except for the properties that are
+// arguments of the azapi_resource.
+func (s *Schema) Body(typeAPIVersion string) ([]*Property, error) {
+	ref, ok := s.resources[strings.ToLower(typeAPIVersion)]
+	if !ok {
+		return nil, errors.Errorf(errUnknownType, typeAPIVersion)
+	}
+	file, rt, err := s.s.resolve("", ref)
+	if err != nil {
+		return nil, err
+	}
+	if rt.Type != "ResourceType" || rt.Body == nil {
+		return nil, errors.Errorf(errNotResource, typeAPIVersion)
+	}
+	body := &Property{}
+	if err := s.s.setType(file, *rt.Body, body, 0); err != nil {
+		return nil, errors.Wrap(err, typeAPIVersion)
+	}
+	var result []*Property
+	for _, p := range body.Properties {
+		if !skippedRootProperties[p.Name] {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// UntaggableTypes returns the lower case resource types, e.g.
+// microsoft.network/virtualnetworks/subnets, whose body has no writable tags
+// at any API version. The azapi Terraform provider checks the same before
+// adding its default tags.
+func (s *Schema) UntaggableTypes() ([]string, error) {
+	taggable := map[string]bool{}
+	for k, ref := range s.resources {
+		t, _, _ := strings.Cut(k, "@")
+		if taggable[t] {
+			continue
+		}
+		ok, err := s.taggable(ref)
+		if err != nil {
+			return nil, errors.Wrap(err, k)
+		}
+		taggable[t] = ok
+	}
+	result := make([]string, 0, len(taggable))
+	for t, ok := range taggable {
+		if !ok {
+			result = append(result, t)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (s *Schema) taggable(ref azureRef) (bool, error) {
+	file, rt, err := s.s.resolve("", ref)
+	if err != nil {
+		return false, err
+	}
+	if rt.Type != "ResourceType" || rt.Body == nil {
+		return false, nil
+	}
+	_, body, err := s.s.resolve(file, *rt.Body)
+	if err != nil {
+		return false, err
+	}
+	for _, props := range []map[string]azureProperty{body.Properties, body.BaseProperties} {
+		if p, ok := props["tags"]; ok && p.Flags&flagReadOnly == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseEntry returns the kind of the supplied entry, without its body.
+func parseEntry(e string) (Kind, error) {
+	e, kind, _ := strings.Cut(strings.TrimSpace(e), "=")
+	t, apiVersion, ok := strings.Cut(e, "@")
+	namespace, resourceType, ok2 := strings.Cut(t, "/")
+	if !ok || !ok2 || apiVersion == "" || resourceType == "" {
+		return Kind{}, errors.Errorf(errInvalidEntry, e)
+	}
+	if kind == "" {
+		segments := strings.Split(resourceType, "/")
+		kind = singular(segments[len(segments)-1])
+		kind = strings.ToUpper(kind[:1]) + kind[1:]
+	}
+	group := nonAlphanumeric.ReplaceAllString(strings.TrimPrefix(strings.ToLower(namespace), "microsoft."), "")
+	return Kind{
+		Type:          t,
+		APIVersion:    apiVersion,
+		Kind:          kind,
+		Group:         group,
+		TerraformName: "azapi_" + group + "_" + name.NewFromCamel(kind).Snake,
+	}, nil
+}
+
+// singular returns the singular of the supplied plural resource type name.
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "sses"), strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	case strings.HasSuffix(s, "s") && !strings.HasSuffix(s, "ss"):
+		return strings.TrimSuffix(s, "s")
+	}
+	return s
+}
+
+// resolve returns the file and the type of the supplied reference, which is
+// relative to the supplied file.
+func (s *azureSchema) resolve(file string, ref azureRef) (string, *azureType, error) {
+	f, i, ok := strings.Cut(ref.Ref, "#/")
+	index, err := strconv.Atoi(i)
+	if !ok || err != nil {
+		return "", nil, errors.Errorf(errInvalidRef, ref.Ref)
+	}
+	if f != "" {
+		file = path.Join(path.Dir(file), f)
+	}
+	types, ok := s.files[file]
+	if !ok {
+		b, err := os.ReadFile(filepath.Join(s.dir, filepath.FromSlash(file)))
+		if err != nil {
+			return "", nil, errors.Wrap(err, errReadTypes)
+		}
+		if err := json.Unmarshal(b, &types); err != nil {
+			return "", nil, errors.Wrapf(err, "%s: %s", errReadTypes, file)
+		}
+		s.files[file] = types
+	}
+	if index < 0 || index >= len(types) {
+		return "", nil, errors.Errorf(errInvalidRef, ref.Ref)
+	}
+	return file, &types[index], nil
+}
+
+// setType sets the type of the supplied property from the Azure type with
+// the supplied reference.
+func (s *azureSchema) setType(file string, ref azureRef, p *Property, depth int) error { //nolint:gocyclo // easier to follow as a unit
+	file, t, err := s.resolve(file, ref)
+	if err != nil {
+		return err
+	}
+	key := file + ref.Ref
+	if s.visiting[key] || depth > maxDepth {
+		p.Type = TypeDynamic
+		return nil
+	}
+	s.visiting[key] = true
+	defer delete(s.visiting, key)
+	p.Sensitive = p.Sensitive || t.Sensitive
+
+	switch t.Type {
+	case "StringType":
+		p.Type = TypeString
+	case "StringLiteralType":
+		p.Type = TypeString
+		p.Description = appendValues(p.Description, []string{t.Value})
+	case "IntegerType":
+		p.Type = TypeNumber
+	case "BooleanType":
+		p.Type = TypeBool
+	case "UnionType":
+		var elements []azureRef
+		if err := json.Unmarshal(t.Elements, &elements); err != nil {
+			return errors.Wrap(err, errReadTypes)
+		}
+		return s.setUnionType(file, elements, p)
+	case "ObjectType":
+		if len(t.Properties) > 0 {
+			p.Type = TypeObject
+			p.Properties, err = s.properties(file, t.Properties, depth)
+			return err
+		}
+		if t.AdditionalProperties == nil {
+			p.Type = TypeDynamic
+			return nil
+		}
+		e := &Property{}
+		if err := s.setType(file, *t.AdditionalProperties, e, depth+1); err != nil {
+			return err
+		}
+		p.Type = TypeDynamic
+		if isPrimitive(e.Type) {
+			p.Type = TypeMap
+			p.ElementType = e.Type
+		}
+	case "DiscriminatedObjectType":
+		p.Type = TypeObject
+		return s.setDiscriminatedType(file, t, p, depth)
+	case "ArrayType":
+		e := &Property{}
+		if err := s.setType(file, *t.ItemType, e, depth+1); err != nil {
+			return err
+		}
+		switch {
+		case isPrimitive(e.Type):
+			p.Type = TypeList
+			p.ElementType = e.Type
+		case e.Type == TypeObject:
+			p.Type = TypeObjectList
+			p.Properties = e.Properties
+		default:
+			p.Type = TypeDynamic
+		}
+	case "ResourceType":
+		return s.setType(file, *t.Body, p, depth)
+	default:
+		p.Type = TypeDynamic
+	}
+	return nil
+}
+
+// setUnionType sets the type of a union property, which is a string if all
+// the elements are strings, and dynamic otherwise.
+func (s *azureSchema) setUnionType(file string, elements []azureRef, p *Property) error {
+	var values []string
+	open := false
+	for _, e := range elements {
+		_, t, err := s.resolve(file, e)
+		if err != nil {
+			return err
+		}
+		switch t.Type {
+		case "StringLiteralType":
+			values = append(values, t.Value)
+		case "StringType":
+			open = true
+		default:
+			p.Type = TypeDynamic
+			return nil
+		}
+	}
+	p.Type = TypeString
+	if !open {
+		p.Description = appendValues(p.Description, values)
+	}
+	return nil
+}
+
+// setDiscriminatedType sets the properties of a discriminated object
+// property, which are its base properties, its discriminator and the
+// optional properties of all its elements.
+func (s *azureSchema) setDiscriminatedType(file string, t *azureType, p *Property, depth int) error {
+	props, err := s.properties(file, t.BaseProperties, depth)
+	if err != nil {
+		return err
+	}
+	var elements map[string]azureRef
+	if err := json.Unmarshal(t.Elements, &elements); err != nil {
+		return errors.Wrap(err, errReadTypes)
+	}
+	keys := make([]string, 0, len(elements))
+	for k := range elements {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	names := map[string]bool{t.Discriminator: true}
+	for _, bp := range props {
+		names[bp.Name] = true
+	}
+	props = append(props, &Property{
+		Name:          t.Discriminator,
+		TerraformName: name.NewFromCamel(t.Discriminator).Snake,
+		Type:          TypeString,
+		Required:      true,
+		Description:   appendValues("Discriminator of the object.", keys),
+	})
+	for _, k := range keys {
+		ef, et, err := s.resolve(file, elements[k])
+		if err != nil {
+			return err
+		}
+		ep, err := s.properties(ef, et.Properties, depth)
+		if err != nil {
+			return err
+		}
+		for _, e := range ep {
+			if names[e.Name] {
+				continue
+			}
+			names[e.Name] = true
+			// the properties of an element are only required if the
+			// element is the selected one
+			e.Required = false
+			props = append(props, e)
+		}
+	}
+	p.Properties = sortProperties(props)
+	return nil
+}
+
+// properties returns the writable properties among the supplied ones, sorted
+// by name.
+func (s *azureSchema) properties(file string, props map[string]azureProperty, depth int) ([]*Property, error) {
+	result := make([]*Property, 0, len(props))
+	tfNames := map[string]bool{}
+	for n, ap := range props {
+		if ap.Flags&flagReadOnly != 0 {
+			continue
+		}
+		p := &Property{
+			Name:          n,
+			TerraformName: name.NewFromCamel(n).Snake,
+			Required:      ap.Flags&flagRequired != 0,
+			Description:   ap.Description,
+		}
+		if tfNames[p.TerraformName] {
+			// properties whose names only differ by their casing
+			continue
+		}
+		tfNames[p.TerraformName] = true
+		if err := s.setType(file, ap.Type, p, depth+1); err != nil {
+			return nil, errors.Wrap(err, n)
+		}
+		if p.Type == TypeObject && len(p.Properties) == 0 {
+			p.Type = TypeDynamic
+		}
+		result = append(result, p)
+	}
+	return sortProperties(result), nil
+}
+
+func sortProperties(props []*Property) []*Property {
+	sort.Slice(props, func(i, j int) bool {
+		return props[i].Name < props[j].Name
+	})
+	return props
+}
+
+func isPrimitive(t string) bool {
+	return t == TypeString || t == TypeNumber || t == TypeBool
+}
+
+func appendValues(description string, values []string) string {
+	if len(values) == 0 {
+		return description
+	}
+	if description != "" && !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+	return strings.TrimSpace(description + " Possible values are " + strings.Join(values, ", ") + ".")
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// testSchemaIndex and testSchemaTypes are an Azure schema of a widget with
+// properties of all the supported types and of its untaggable parts.
+const (
+	testSchemaIndex = `{"resources": {
+  "Microsoft.Test/widgets@2024-01-01": {"$ref": "test/types.json#/0"},
+  "Microsoft.Test/widgets/parts@2024-01-01": {"$ref": "test/types.json#/15"}
+}}`
+	testSchemaTypes = `[
+  {"$type": "ResourceType", "name": "Microsoft.Test/widgets@2024-01-01", "body": {"$ref": "#/1"}},
+  {"$type": "ObjectType", "name": "Widget", "properties": {
+    "id": {"type": {"$ref": "#/2"}, "flags": 2},
+    "name": {"type": {"$ref": "#/2"}, "flags": 1},
+    "location": {"type": {"$ref": "#/2"}},
+    "tags": {"type": {"$ref": "#/3"}},
+    "kind": {"type": {"$ref": "#/5"}, "description": "The kind"},
+    "properties": {"type": {"$ref": "#/4"}, "flags": 1, "description": "The properties."}
+  }},
+  {"$type": "StringType"},
+  {"$type": "ObjectType", "name": "Tags", "additionalProperties": {"$ref": "#/2"}},
+  {"$type": "ObjectType", "name": "WidgetProperties", "properties": {
+    "provisioningState": {"type": {"$ref": "#/2"}, "flags": 2},
+    "secret": {"type": {"$ref": "#/6"}},
+    "count": {"type": {"$ref": "#/7"}},
+    "enabled": {"type": {"$ref": "#/8"}},
+    "addresses": {"type": {"$ref": "#/9"}},
+    "rules": {"type": {"$ref": "#/10"}},
+    "node": {"type": {"$ref": "#/12"}},
+    "labels": {"type": {"$ref": "#/3"}}
+  }},
+  {"$type": "UnionType", "elements": [{"$ref": "#/13"}, {"$ref": "#/14"}]},
+  {"$type": "StringType", "sensitive": true},
+  {"$type": "IntegerType"},
+  {"$type": "BooleanType"},
+  {"$type": "ArrayType", "itemType": {"$ref": "#/2"}},
+  {"$type": "ArrayType", "itemType": {"$ref": "#/11"}},
+  {"$type": "ObjectType", "name": "Rule", "properties": {
+    "name": {"type": {"$ref": "#/2"}, "flags": 1}
+  }},
+  {"$type": "ObjectType", "name": "Node", "properties": {
+    "child": {"type": {"$ref": "#/12"}}
+  }},
+  {"$type": "StringLiteralType", "value": "A"},
+  {"$type": "StringLiteralType", "value": "B"},
+  {"$type": "ResourceType", "name": "Microsoft.Test/widgets/parts@2024-01-01", "body": {"$ref": "#/11"}}
+]`
+)
+
+// testSchemaDir returns the directory of the test Azure schema.
+func testSchemaDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "test"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), []byte(testSchemaIndex), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "test", "types.json"), []byte(testSchemaTypes), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// testWidgetBody is the body of the widgets of the test Azure schema.
+func testWidgetBody() []*Property {
+	return []*Property{
+		{Name: "kind", TerraformName: "kind", Type: TypeString, Description: "The kind. Possible values are A, B."},
+		{Name: "properties", TerraformName: "properties", Type: TypeObject, Required: true, Description: "The properties.", Properties: []*Property{
+			{Name: "addresses", TerraformName: "addresses", Type: TypeList, ElementType: TypeString},
+			{Name: "count", TerraformName: "count", Type: TypeNumber},
+			{Name: "enabled", TerraformName: "enabled", Type: TypeBool},
+			{Name: "labels", TerraformName: "labels", Type: TypeMap, ElementType: TypeString},
+			{Name: "node", TerraformName: "node", Type: TypeObject, Properties: []*Property{
+				{Name: "child", TerraformName: "child", Type: TypeDynamic},
+			}},
+			{Name: "rules", TerraformName: "rules", Type: TypeObjectList, Properties: []*Property{
+				{Name: "name", TerraformName: "name", Type: TypeString, Required: true},
+			}},
+			{Name: "secret", TerraformName: "secret", Type: TypeString, Sensitive: true},
+		}},
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := testSchemaDir(t)
+	cases := map[string]struct {
+		entries []string
+		want    []Kind
+		wantErr string
+	}{
+		"None": {
+			want: []Kind{},
+		},
+		"Widget": {
+			entries: []string{"Microsoft.Test/widgets@2024-01-01"},
+			want: []Kind{{
+				Type:          "Microsoft.Test/widgets",
+				APIVersion:    "2024-01-01",
+				Kind:          "Widget",
+				Group:         "test",
+				TerraformName: "azapi_test_widget",
+				Body:          testWidgetBody(),
+			}},
+		},
+		"Duplicate": {
+			entries: []string{"Microsoft.Test/widgets@2024-01-01", "Microsoft.Test/widgets/parts@2024-01-01=Widget"},
+			wantErr: "duplicate kind Widget.test",
+		},
+		"Unknown": {
+			entries: []string{"Microsoft.Test/gadgets@2024-01-01"},
+			wantErr: "resource type Microsoft.Test/gadgets@2024-01-01 is not in the Azure schema",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Load(dir, tc.entries)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUntaggableTypes(t *testing.T) {
+	s, err := LoadSchema(testSchemaDir(t))
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	got, err := s.UntaggableTypes()
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if want := []string{"microsoft.test/widgets/parts"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestParseEntry(t *testing.T) {
+	cases := map[string]struct {
+		entry   string
+		want    Kind
+		wantErr bool
+	}{
+		"Plural": {
+			entry: "Microsoft.Storage/storageAccounts@2023-05-01",
+			want:  Kind{Type: "Microsoft.Storage/storageAccounts", APIVersion: "2023-05-01", Kind: "StorageAccount", Group: "storage", TerraformName: "azapi_storage_storage_account"},
+		},
+		"ChildType": {
+			entry: "Microsoft.Network/virtualNetworks/subnets@2023-04-01",
+			want:  Kind{Type: "Microsoft.Network/virtualNetworks/subnets", APIVersion: "2023-04-01", Kind: "Subnet", Group: "network", TerraformName: "azapi_network_subnet"},
+		},
+		"PluralIes": {
+			entry: "Microsoft.Cdn/profiles/securityPolicies@2024-02-01",
+			want:  Kind{Type: "Microsoft.Cdn/profiles/securityPolicies", APIVersion: "2024-02-01", Kind: "SecurityPolicy", Group: "cdn", TerraformName: "azapi_cdn_security_policy"},
+		},
+		"Kind": {
+			entry: " Microsoft.DocumentDB/databaseAccounts@2024-05-15=CosmosAccount ",
+			want:  Kind{Type: "Microsoft.DocumentDB/databaseAccounts", APIVersion: "2024-05-15", Kind: "CosmosAccount", Group: "documentdb", TerraformName: "azapi_documentdb_cosmos_account"},
+		},
+		"NoAPIVersion": {
+			entry:   "Microsoft.Storage/storageAccounts",
+			wantErr: true,
+		},
+		"NoNamespace": {
+			entry:   "storageAccounts@2023-05-01",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseEntry(tc.entry)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("want error %t, got %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSingular(t *testing.T) {
+	cases := map[string]string{
+		"policies":      "policy",
+		"addresses":     "address",
+		"boxes":         "box",
+		"accounts":      "account",
+		"access":        "access",
+		"configuration": "configuration",
+	}
+	for plural, want := range cases {
+		t.Run(plural, func(t *testing.T) {
+			if got := singular(plural); got != want {
+				t.Errorf("want %q, got %q", want, got)
+			}
+		})
+	}
+}
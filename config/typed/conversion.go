@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	errInvalidMode = "invalid conversion mode %s"
+	errObject      = "%s: expected an object"
+	errList        = "%s: expected a list"
+)
+
+// bodyConversion converts the typed body of a kind, whose field names are
+// the Terraform names of the properties and whose objects are singleton
+// lists, into the body of the azapi_resource, whose field names are the
+// Azure names of the properties, and vice versa.
+type bodyConversion struct {
+	kind Kind
+}
+
+// NewBodyConversion returns the Terraform conversion of the body of the
+// supplied typed kind. It must run before the conversion of the
+// DynamicPseudoType attributes, which wraps the converted body.
+func NewBodyConversion(k Kind) config.TerraformConversion {
+	return bodyConversion{kind: k}
+}
+
+func (c bodyConversion) Convert(params map[string]any, _ *config.Resource, mode config.Mode) (map[string]any, error) {
+	switch mode {
+	case config.ToTerraform:
+		params[attrType] = c.kind.TypeAPIVersion()
+		body, err := toAzure(attrBody, params[attrBody], &Property{Type: TypeObject, Properties: c.kind.Body})
+		if err != nil {
+			return nil, err
+		}
+		if body != nil {
+			params[attrBody] = body
+		}
+	case config.FromTerraform:
+		delete(params, attrType)
+		body, ok := params[attrBody].(map[string]any)
+		if !ok {
+			return params, nil
+		}
+		params[attrBody] = []any{fromAzure(body, c.kind.Body)}
+	default:
+		return nil, errors.Errorf(errInvalidMode, mode.String())
+	}
+	return params, nil
+}
+
+// toAzure converts the supplied typed value of the property at the supplied
+// path into its Azure representation.
+func toAzure(path string, v any, p *Property) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch p.Type {
+	case TypeObject:
+		if l, ok := v.([]any); ok {
+			// singleton list
+			if len(l) == 0 {
+				return nil, nil
+			}
+			v = l[0]
+		}
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, errors.Errorf(errObject, path)
+		}
+		return objectToAzure(path, m, p.Properties)
+	case TypeObjectList:
+		l, ok := v.([]any)
+		if !ok {
+			return nil, errors.Errorf(errList, path)
+		}
+		result := make([]any, 0, len(l))
+		for _, e := range l {
+			m, ok := e.(map[string]any)
+			if !ok {
+				return nil, errors.Errorf(errObject, path)
+			}
+			o, err := objectToAzure(path, m, p.Properties)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, o)
+		}
+		return result, nil
+	default:
+		return v, nil
+	}
+}
+
+func objectToAzure(path string, m map[string]any, props []*Property) (map[string]any, error) {
+	result := make(map[string]any, len(m))
+	for _, p := range props {
+		v, err := toAzure(path+"."+p.TerraformName, m[p.TerraformName], p)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			result[p.Name] = v
+		}
+	}
+	return result, nil
+}
+
+// fromAzure converts the supplied Azure object into its typed
+// representation. The properties that are not in the typed body, e.g. the
+// read-only properties, are dropped.
+func fromAzure(m map[string]any, props []*Property) map[string]any {
+	result := make(map[string]any, len(props))
+	for _, p := range props {
+		v, ok := m[p.Name]
+		if !ok || v == nil {
+			continue
+		}
+		switch p.Type {
+		case TypeObject:
+			if o, ok := v.(map[string]any); ok {
+				result[p.TerraformName] = []any{fromAzure(o, p.Properties)}
+			}
+		case TypeObjectList:
+			l, ok := v.([]any)
+			if !ok {
+				continue
+			}
+			objects := make([]any, 0, len(l))
+			for _, e := range l {
+				if o, ok := e.(map[string]any); ok {
+					objects = append(objects, fromAzure(o, p.Properties))
+				}
+			}
+			result[p.TerraformName] = objects
+		default:
+			result[p.TerraformName] = v
+		}
+	}
+	return result
+}
+
+// Writable returns the supplied Azure object without the properties that
+// are not among the supplied ones, e.g. the read-only properties, keeping
+// the Azure names of the properties.
+func Writable(m map[string]any, props []*Property) map[string]any {
+	result := make(map[string]any, len(props))
+	for _, p := range props {
+		v, ok := m[p.Name]
+		if !ok || v == nil {
+			continue
+		}
+		switch p.Type {
+		case TypeObject:
+			if o, ok := v.(map[string]any); ok {
+				result[p.Name] = Writable(o, p.Properties)
+			}
+		case TypeObjectList:
+			l, ok := v.([]any)
+			if !ok {
+				continue
+			}
+			objects := make([]any, 0, len(l))
+			for _, e := range l {
+				if o, ok := e.(map[string]any); ok {
+					objects = append(objects, Writable(o, p.Properties))
+				}
+			}
+			result[p.Name] = objects
+		default:
+			result[p.Name] = v
+		}
+	}
+	return result
+}
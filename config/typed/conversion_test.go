@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/crossplane/upjet/v2/pkg/config"
+)
+
+func TestBodyConversion(t *testing.T) {
+	k := Kind{Type: "Microsoft.Test/widgets", APIVersion: "2024-01-01", Body: testWidgetBody()}
+	cases := map[string]struct {
+		mode    config.Mode
+		params  map[string]any
+		want    map[string]any
+		wantErr string
+	}{
+		"ToTerraform": {
+			mode: config.ToTerraform,
+			params: map[string]any{"name": "w", "body": []any{map[string]any{
+				"kind": "A",
+				"properties": []any{map[string]any{
+					"count":  float64(2),
+					"labels": map[string]any{"env": "test"},
+					"node":   []any{},
+					"rules":  []any{map[string]any{"name": "r"}},
+				}},
+			}}},
+			want: map[string]any{"name": "w", "type": "Microsoft.Test/widgets@2024-01-01", "body": map[string]any{
+				"kind": "A",
+				"properties": map[string]any{
+					"count":  float64(2),
+					"labels": map[string]any{"env": "test"},
+					"rules":  []any{map[string]any{"name": "r"}},
+				},
+			}},
+		},
+		"ToTerraformNoBody": {
+			mode:   config.ToTerraform,
+			params: map[string]any{"name": "w"},
+			want:   map[string]any{"name": "w", "type": "Microsoft.Test/widgets@2024-01-01"},
+		},
+		"ToTerraformInvalidObject": {
+			mode:    config.ToTerraform,
+			params:  map[string]any{"body": []any{map[string]any{"properties": []any{"invalid"}}}},
+			wantErr: "body.properties: expected an object",
+		},
+		"ToTerraformInvalidList": {
+			mode:    config.ToTerraform,
+			params:  map[string]any{"body": []any{map[string]any{"properties": []any{map[string]any{"rules": "invalid"}}}}},
+			wantErr: "body.properties.rules: expected a list",
+		},
+		"FromTerraform": {
+			mode: config.FromTerraform,
+			params: map[string]any{"name": "w", "type": "Microsoft.Test/widgets@2024-01-01", "body": map[string]any{
+				"kind": "A",
+				"properties": map[string]any{
+					"provisioningState": "Succeeded",
+					"node":              map[string]any{"child": map[string]any{"child": nil}},
+					"rules":             []any{map[string]any{"name": "r", "id": "/r"}},
+				},
+			}},
+			want: map[string]any{"name": "w", "body": []any{map[string]any{
+				"kind": "A",
+				"properties": []any{map[string]any{
+					"node":  []any{map[string]any{"child": map[string]any{"child": nil}}},
+					"rules": []any{map[string]any{"name": "r"}},
+				}},
+			}}},
+		},
+		"FromTerraformNoBody": {
+			mode:   config.FromTerraform,
+			params: map[string]any{"name": "w", "type": "Microsoft.Test/widgets@2024-01-01"},
+			want:   map[string]any{"name": "w"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewBodyConversion(k).Convert(tc.params, nil, tc.mode)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWritable(t *testing.T) {
+	obj := map[string]any{
+		"kind": "A",
+		"etag": "etag",
+		"properties": map[string]any{
+			"provisioningState": "Succeeded",
+			"secret":            nil,
+			"node":              map[string]any{"child": "c", "id": "/n"},
+			"rules":             []any{map[string]any{"name": "r", "id": "/r"}},
+		},
+	}
+	want := map[string]any{
+		"kind": "A",
+		"properties": map[string]any{
+			"node":  map[string]any{"child": "c"},
+			"rules": []any{map[string]any{"name": "r"}},
+		},
+	}
+	if got := Writable(obj, testWidgetBody()); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
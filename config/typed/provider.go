@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"github.com/crossplane/upjet/v2/pkg/config"
+	"github.com/crossplane/upjet/v2/pkg/registry"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+
+	"github.com/upbound/provider-azapi/v2/config/common"
+)
+
+// Provider returns a provider serving the resources of the supplied
+// provider and an azapi_resource under the Terraform name of each of the
+// supplied typed kinds.
+func Provider(p fwprovider.Provider, kinds []Kind) fwprovider.Provider {
+	aliases := make(map[string]string, len(kinds))
+	for _, k := range kinds {
+		aliases[k.TerraformName] = ResourceTypeName
+	}
+	return common.AliasProvider(p, aliases)
+}
+
+// Configure adds the configurations of the supplied typed kinds, which are
+// reconciled like the azapi_resources.
+func Configure(p *config.Provider, kinds []Kind) {
+	for _, k := range kinds {
+		p.AddResourceConfigurator(k.TerraformName, func(r *config.Resource) {
+			r.Kind = k.Kind
+			r.ShortGroup = k.Group
+			r.Version = Version
+			// Following attributes trigger TF resource replacement, which is
+			// not supported per XRM in Crossplane.
+			delete(r.TerraformResource.Schema, "replace_triggers_external_values")
+			delete(r.TerraformResource.Schema, "replace_triggers_refs")
+			// the typed kinds have no scraped metadata, their fields have
+			// descriptions provided by the Azure schema
+			r.MetaResource = &registry.Resource{
+				Name:         k.TerraformName,
+				Description:  k.Kind + " is the Schema for the " + k.TypeAPIVersion() + " Azure resources.",
+				ArgumentDocs: map[string]string{},
+			}
+			// the body must be converted before it's wrapped as a dynamic
+			// value
+			r.TerraformConversions = append([]config.TerraformConversion{NewBodyConversion(k)}, r.TerraformConversions...)
+			common.DeletionPolicy(r)
+			common.AzureLock(r)
+			common.TagPropagation(r)
+		})
+	}
+}
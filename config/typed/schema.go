@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"encoding/json"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	// ResourceTypeName is the Terraform resource the typed kinds are
+	// reconciled with.
+	ResourceTypeName = "azapi_resource"
+
+	attrType = "type"
+	attrBody = "body"
+
+	errUnmarshalSchema = "cannot unmarshal the Terraform provider schema"
+	errMarshalSchema   = "cannot marshal the Terraform provider schema"
+	errNoResource      = "the Terraform provider schema has no azapi_resource"
+)
+
+// AddSchemas returns the supplied Terraform provider schema document with
+// the schemas of the supplied typed kinds. The schema of a typed kind is the
+// schema of the azapi_resource, without the type argument and with a body
+// block in place of the dynamic body argument.
+func AddSchemas(doc string, kinds []Kind) (string, error) {
+	if len(kinds) == 0 {
+		return doc, nil
+	}
+	ps := &tfjson.ProviderSchemas{}
+	if err := ps.UnmarshalJSON([]byte(doc)); err != nil {
+		return "", errors.Wrap(err, errUnmarshalSchema)
+	}
+	for _, s := range ps.Schemas {
+		base, ok := s.ResourceSchemas[ResourceTypeName]
+		if !ok {
+			return "", errors.New(errNoResource)
+		}
+		for _, k := range kinds {
+			rs, err := copySchema(base)
+			if err != nil {
+				return "", err
+			}
+			delete(rs.Block.Attributes, attrType)
+			delete(rs.Block.Attributes, attrBody)
+			rs.Block.NestedBlocks[attrBody] = &tfjson.SchemaBlockType{
+				NestingMode: tfjson.SchemaNestingModeSingle,
+				Block:       block(k.Body),
+				MinItems:    minItems(k.Body),
+				MaxItems:    1,
+			}
+			rs.Block.Description = "Manages a " + k.TypeAPIVersion() + " resource."
+			s.ResourceSchemas[k.TerraformName] = rs
+		}
+	}
+	b, err := json.Marshal(ps)
+	return string(b), errors.Wrap(err, errMarshalSchema)
+}
+
+func copySchema(s *tfjson.Schema) (*tfjson.Schema, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalSchema)
+	}
+	c := &tfjson.Schema{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalSchema)
+	}
+	if c.Block.NestedBlocks == nil {
+		c.Block.NestedBlocks = map[string]*tfjson.SchemaBlockType{}
+	}
+	return c, nil
+}
+
+// block returns the Terraform schema block of the supplied properties. The
+// objects are nested blocks, so that their fields are typed in the CRDs.
+func block(props []*Property) *tfjson.SchemaBlock {
+	b := &tfjson.SchemaBlock{
+		Attributes:      map[string]*tfjson.SchemaAttribute{},
+		NestedBlocks:    map[string]*tfjson.SchemaBlockType{},
+		DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+	}
+	for _, p := range props {
+		switch p.Type {
+		case TypeObject, TypeObjectList:
+			bt := &tfjson.SchemaBlockType{
+				NestingMode: tfjson.SchemaNestingModeList,
+				Block:       describe(block(p.Properties), p),
+			}
+			// an object is a list block of at most one item rather than a
+			// single block, which is required as soon as one of its
+			// properties is required, even if the object is optional
+			if p.Type == TypeObject {
+				bt.MaxItems = 1
+			}
+			if p.Required {
+				bt.MinItems = 1
+			}
+			b.NestedBlocks[p.TerraformName] = bt
+		default:
+			b.Attributes[p.TerraformName] = &tfjson.SchemaAttribute{
+				AttributeType:   ctyType(p),
+				Description:     p.Description,
+				DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				Required:        p.Required,
+				Optional:        !p.Required,
+				// only the string fields can be read from secrets
+				Sensitive: p.Sensitive && p.Type == TypeString,
+			}
+		}
+	}
+	return b
+}
+
+func describe(b *tfjson.SchemaBlock, p *Property) *tfjson.SchemaBlock {
+	b.Description = p.Description
+	return b
+}
+
+// minItems returns 1 if some of the supplied properties of the body are
+// required, so that the body is required.
+func minItems(props []*Property) uint64 {
+	for _, p := range props {
+		if p.Required {
+			return 1
+		}
+	}
+	return 0
+}
+
+func ctyType(p *Property) cty.Type {
+	switch p.Type {
+	case TypeString:
+		return cty.String
+	case TypeNumber:
+		return cty.Number
+	case TypeBool:
+		return cty.Bool
+	case TypeList:
+		return cty.List(ctyType(&Property{Type: p.ElementType}))
+	case TypeMap:
+		return cty.Map(ctyType(&Property{Type: p.ElementType}))
+	default:
+		return cty.DynamicPseudoType
+	}
+}
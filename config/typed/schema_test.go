@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"reflect"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const testProviderSchema = `{"format_version": "1.0", "provider_schemas": {"registry.terraform.io/azure/azapi": {
+  "provider": {"version": 0, "block": {}},
+  "resource_schemas": {"azapi_resource": {"version": 2, "block": {"attributes": {
+    "type": {"type": "string", "required": true},
+    "name": {"type": "string", "required": true},
+    "body": {"type": "dynamic", "optional": true}
+  }}}}
+}}}`
+
+func TestAddSchemas(t *testing.T) {
+	k := Kind{Type: "Microsoft.Test/widgets", APIVersion: "2024-01-01", TerraformName: "azapi_test_widget", Body: testWidgetBody()}
+	doc, err := AddSchemas(testProviderSchema, []Kind{k})
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	ps := &tfjson.ProviderSchemas{}
+	if err := ps.UnmarshalJSON([]byte(doc)); err != nil {
+		t.Fatal(err)
+	}
+	s := ps.Schemas["registry.terraform.io/azure/azapi"]
+	if _, ok := s.ResourceSchemas[ResourceTypeName].Block.Attributes[attrBody]; !ok {
+		t.Errorf("want the azapi_resource unchanged, got no body attribute")
+	}
+	rs, ok := s.ResourceSchemas[k.TerraformName]
+	if !ok {
+		t.Fatalf("want the %s schema, got none", k.TerraformName)
+	}
+	if rs.Version != 2 {
+		t.Errorf("want the version of the azapi_resource, got %d", rs.Version)
+	}
+	attrs := make([]string, 0, len(rs.Block.Attributes))
+	for n := range rs.Block.Attributes {
+		attrs = append(attrs, n)
+	}
+	if want := []string{"name"}; !reflect.DeepEqual(want, attrs) {
+		t.Errorf("want the attributes %v, got %v", want, attrs)
+	}
+
+	body := rs.Block.NestedBlocks[attrBody]
+	if body == nil || body.NestingMode != tfjson.SchemaNestingModeSingle || body.MinItems != 1 || body.MaxItems != 1 {
+		t.Fatalf("want a required single body block, got %+v", body)
+	}
+	if a := body.Block.Attributes["kind"]; a == nil || !a.AttributeType.Equals(cty.String) || !a.Optional {
+		t.Errorf("want an optional string kind, got %+v", a)
+	}
+	props := body.Block.NestedBlocks["properties"]
+	if props == nil || props.NestingMode != tfjson.SchemaNestingModeList || props.MinItems != 1 || props.MaxItems != 1 {
+		t.Fatalf("want a required properties block of at most one item, got %+v", props)
+	}
+	wantTypes := map[string]cty.Type{
+		"addresses": cty.List(cty.String),
+		"count":     cty.Number,
+		"enabled":   cty.Bool,
+		"labels":    cty.Map(cty.String),
+		"secret":    cty.String,
+	}
+	for n, want := range wantTypes {
+		if a := props.Block.Attributes[n]; a == nil || !a.AttributeType.Equals(want) {
+			t.Errorf("want %s of type %s, got %+v", n, want.FriendlyName(), a)
+		}
+	}
+	if !props.Block.Attributes["secret"].Sensitive {
+		t.Errorf("want secret sensitive, got not sensitive")
+	}
+	if node := props.Block.NestedBlocks["node"]; node == nil || node.MaxItems != 1 || node.MinItems != 0 ||
+		!node.Block.Attributes["child"].AttributeType.Equals(cty.DynamicPseudoType) {
+		t.Errorf("want an optional node block of at most one item with a dynamic child, got %+v", node)
+	}
+	if rules := props.Block.NestedBlocks["rules"]; rules == nil || rules.MaxItems != 0 || !rules.Block.Attributes["name"].Required {
+		t.Errorf("want a rules list block with a required name, got %+v", rules)
+	}
+}
+
+func TestAddSchemasNoKinds(t *testing.T) {
+	doc, err := AddSchemas(testProviderSchema, nil)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if doc != testProviderSchema {
+		t.Errorf("want the schema unchanged, got %s", doc)
+	}
+}
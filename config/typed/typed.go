@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package typed configures the typed kinds generated from the Azure API
+// schemas, e.g. StorageAccount.storage.azapi.upbound.io. A typed kind is
+// reconciled with the azapi_resource Terraform resource under its own
+// Terraform resource name: the typed spec.forProvider.body of the kind is
+// converted into the body of the azapi_resource, whose type is fixed.
+package typed
+
+import (
+	// embed the typed kinds and the untaggable types generated by the
+	// generator
+	_ "embed"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Version of the typed kinds.
+	Version = "v1alpha1"
+
+	// TypeString is the type of the string properties.
+	TypeString = "string"
+	// TypeNumber is the type of the integer properties.
+	TypeNumber = "number"
+	// TypeBool is the type of the boolean properties.
+	TypeBool = "bool"
+	// TypeDynamic is the type of the properties whose schema cannot be
+	// expressed in a CRD, e.g. unions of objects or recursive objects. Their
+	// values are arbitrary JSON values.
+	TypeDynamic = "dynamic"
+	// TypeObject is the type of the object properties.
+	TypeObject = "object"
+	// TypeObjectList is the type of the arrays of objects.
+	TypeObjectList = "objectList"
+	// TypeList is the type of the arrays of primitive values.
+	TypeList = "list"
+	// TypeMap is the type of the objects with primitive values and arbitrary
+	// keys.
+	TypeMap = "map"
+
+	errUnmarshalKinds = "cannot unmarshal the typed kinds"
+)
+
+// A Property is a property of the body of a typed kind.
+type Property struct {
+	// Name of the property in the Azure API.
+	Name string `json:"name"`
+	// TerraformName of the property, from which the name of the CRD field is
+	// derived.
+	TerraformName string `json:"terraformName"`
+	// Type of the property.
+	Type string `json:"type"`
+	// ElementType is the type of the elements of the list and map
+	// properties.
+	ElementType string `json:"elementType,omitempty"`
+	// Required properties must be set.
+	Required bool `json:"required,omitempty"`
+	// Sensitive properties are not returned by the Azure API.
+	Sensitive bool `json:"sensitive,omitempty"`
+	// Description of the property.
+	Description string `json:"description,omitempty"`
+	// Properties of the object properties and of the elements of the
+	// object list properties.
+	Properties []*Property `json:"properties,omitempty"`
+}
+
+// A Kind is a typed kind generated from the schema of an Azure resource type
+// at an API version.
+type Kind struct {
+	// Type is the Azure resource type, e.g. Microsoft.Storage/storageAccounts.
+	Type string `json:"type"`
+	// APIVersion of the Azure resource type.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the managed resource, e.g. StorageAccount.
+	Kind string `json:"kind"`
+	// Group is the short API group of the managed resource, e.g. storage.
+	Group string `json:"group"`
+	// TerraformName is the Terraform resource name the kind is reconciled
+	// with, e.g. azapi_storage_storage_account.
+	TerraformName string `json:"terraformName"`
+	// Body lists the writable properties of the resource body, except for
+	// the properties that are arguments of the azapi_resource, i.e. name,
+	// location, tags and identity.
+	Body []*Property `json:"body"`
+}
+
+// TypeAPIVersion returns the type argument of the azapi_resource of the
+// kind.
+func (k Kind) TypeAPIVersion() string {
+	return k.Type + "@" + k.APIVersion
+}
+
+//go:embed kinds.json
+var kindsJSON []byte
+
+var (
+	mu    sync.Mutex
+	kinds []Kind
+)
+
+// Kinds returns the typed kinds. These are the kinds embedded in the
+// provider, unless set with SetKinds.
+func Kinds() ([]Kind, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if kinds != nil {
+		return kinds, nil
+	}
+	var k []Kind
+	if err := json.Unmarshal(kindsJSON, &k); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalKinds)
+	}
+	kinds = k
+	return kinds, nil
+}
+
+//go:embed untaggable.json
+var untaggableJSON []byte
+
+var (
+	untaggableOnce sync.Once
+	untaggable     map[string]bool
+)
+
+// Taggable returns false if the supplied resource type, e.g.
+// Microsoft.Network/virtualNetworks/subnets@2023-04-01, has no tags at any
+// API version of the Azure schema the provider is generated with. The types
+// unknown to the schema are assumed to be taggable.
+func Taggable(resourceType string) bool {
+	untaggableOnce.Do(func() {
+		var types []string
+		// the file is generated, it's valid
+		_ = json.Unmarshal(untaggableJSON, &types)
+		untaggable = make(map[string]bool, len(types))
+		for _, t := range types {
+			untaggable[t] = true
+		}
+	})
+	t, _, _ := strings.Cut(resourceType, "@")
+	return !untaggable[strings.ToLower(t)]
+}
+
+// SetKinds sets the typed kinds, in place of the kinds embedded in the
+// provider. It's used by the generator, which generates the kinds before
+// generating their managed resources.
+func SetKinds(k []Kind) {
+	mu.Lock()
+	defer mu.Unlock()
+	kinds = k
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import "testing"
+
+func TestTaggable(t *testing.T) {
+	cases := map[string]struct {
+		resourceType string
+		want         bool
+	}{
+		"Taggable": {
+			resourceType: "Microsoft.Network/virtualNetworks@2023-04-01",
+			want:         true,
+		},
+		"Untaggable": {
+			resourceType: "Microsoft.Network/virtualNetworks/subnets@2023-04-01",
+		},
+		"UntaggableOtherCasing": {
+			resourceType: "microsoft.network/VirtualNetworks/Subnets@2023-04-01",
+		},
+		"UntaggableWithoutAPIVersion": {
+			resourceType: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		"Unknown": {
+			resourceType: "Microsoft.Test/widgets@2024-01-01",
+			want:         true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := Taggable(tc.resourceType); got != tc.want {
+				t.Errorf("want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
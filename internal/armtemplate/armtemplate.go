@@ -0,0 +1,388 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package armtemplate translates ARM deployment templates, including the
+// JSON compiled from Bicep, into Resource manifests. The template
+// expressions are evaluated with the supplied parameters. The constructs
+// that cannot be translated, e.g. copy loops, nested deployments or the
+// functions reading the deployed resources, are reported as warnings and
+// the resources using them are skipped.
+package armtemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/upbound/provider-azapi/v2/internal/manifest"
+)
+
+const (
+	kind = "Resource"
+
+	// AnnotationKeyTemplateResource is set on the Resources to the ID of the
+	// template resource they are translated from, relative to the deployment
+	// scope, e.g. Microsoft.Network/virtualNetworks/vnet/subnets/default.
+	AnnotationKeyTemplateResource = "azapi.upbound.io/arm-template-resource"
+
+	scopeResourceGroup   = "resourceGroup"
+	scopeSubscription    = "subscription"
+	scopeManagementGroup = "managementGroup"
+	scopeTenant          = "tenant"
+
+	errParseTemplate       = "cannot parse the template"
+	errParseParameters     = "cannot parse the parameters"
+	errParseResources      = "cannot parse the resources of the template"
+	errNoValueFmt          = "the parameter %q has no value"
+	errKeyVaultRefFmt      = "the parameter %q references a Key Vault secret"
+	errEvaluateFmt         = "cannot evaluate %s"
+	errNotStringFmt        = "%s must be a string"
+	errNameSegmentsFmt     = "the name %q must have %d segments for the type %q"
+	errScopeFmt            = "the scope %q is not a resource ID"
+	errUnsupportedFieldFmt = "%s is not supported"
+)
+
+// Options configures the translation of a template.
+type Options struct {
+	// Manifest configures the scope and the provider config of the
+	// Resources.
+	Manifest manifest.Options
+	// SubscriptionID is the ID of the subscription the template is deployed
+	// to.
+	SubscriptionID string
+	// ResourceGroup is the name of the resource group the template is
+	// deployed to, if it's a resource group deployment.
+	ResourceGroup string
+	// ManagementGroupID is the ID of the management group the template is
+	// deployed to, if it's a management group deployment.
+	ManagementGroupID string
+	// TenantID is the ID of the tenant returned by subscription().
+	TenantID string
+	// Location is the location of the resource group returned by
+	// resourceGroup().
+	Location string
+}
+
+// A Result is the translation of a template.
+type Result struct {
+	// Resources are the Resource manifests, in the order of the template.
+	Resources []*unstructured.Unstructured
+	// Warnings about the constructs of the template that are not
+	// translated.
+	Warnings []string
+}
+
+// A template is an ARM deployment template.
+type template struct {
+	Schema          string                         `json:"$schema"`
+	LanguageVersion string                         `json:"languageVersion"`
+	Parameters      map[string]parameterDefinition `json:"parameters"`
+	Variables       map[string]any                 `json:"variables"`
+	Functions       []any                          `json:"functions"`
+	Resources       json.RawMessage                `json:"resources"`
+	Outputs         map[string]any                 `json:"outputs"`
+}
+
+type parameterDefinition struct {
+	Type         string `json:"type"`
+	DefaultValue any    `json:"defaultValue"`
+}
+
+// parametersFile is an ARM deployment parameters file.
+type parametersFile struct {
+	Parameters map[string]struct {
+		Value     any `json:"value"`
+		Reference any `json:"reference"`
+	} `json:"parameters"`
+}
+
+// Convert translates the supplied template with the supplied parameters
+// file, which may be empty, into Resource manifests.
+func Convert(templateJSON, parametersJSON []byte, o Options) (*Result, error) {
+	t := &template{}
+	if err := json.Unmarshal(templateJSON, t); err != nil {
+		return nil, errors.Wrap(err, errParseTemplate)
+	}
+	p := &parametersFile{}
+	if len(parametersJSON) > 0 {
+		if err := json.Unmarshal(parametersJSON, p); err != nil {
+			return nil, errors.Wrap(err, errParseParameters)
+		}
+	}
+	e := newEvaluator(t, o)
+	for name, v := range p.Parameters {
+		if v.Reference != nil {
+			e.values[strings.ToLower(name)] = errors.Errorf(errKeyVaultRefFmt, name)
+			continue
+		}
+		e.values[strings.ToLower(name)] = v.Value
+	}
+	c := &converter{e: e, result: &Result{}, b: manifest.NewBuilder(o.Manifest)}
+	if len(t.Functions) > 0 {
+		c.warn("the user-defined functions of the template are not supported")
+	}
+	if len(t.Outputs) > 0 {
+		c.warn("the outputs of the template are not translated")
+	}
+	resources, err := resourceList(t.Resources)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseResources)
+	}
+	base, err := e.scopeID()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		c.resource(r, base, nil)
+	}
+	for _, n := range e.secureUsed() {
+		c.warn(fmt.Sprintf("the secure parameter %q is written in plain text into the manifests, consider moving it to the sensitiveBody of the Resources", n))
+	}
+	return c.result, nil
+}
+
+// resourceList returns the resources of a template, which are a list, or
+// an object keyed by symbolic name with the language version 2.0.
+func resourceList(raw json.RawMessage) ([]map[string]any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var list []map[string]any
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list, nil
+	}
+	byName := map[string]map[string]any{}
+	if err := json.Unmarshal(raw, &byName); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(byName))
+	for n := range byName {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	list = make([]map[string]any, 0, len(names))
+	for _, n := range names {
+		list = append(list, byName[n])
+	}
+	return list, nil
+}
+
+// A parent is the resource a nested resource is declared in.
+type parent struct {
+	resourceType string
+	name         string
+}
+
+type converter struct {
+	e      *evaluator
+	b      *manifest.Builder
+	result *Result
+}
+
+func (c *converter) warn(msg string) {
+	c.result.Warnings = append(c.result.Warnings, msg)
+}
+
+// resource translates the supplied template resource, declared in the
+// supplied scope and parent, and its nested resources.
+func (c *converter) resource(r map[string]any, base string, p *parent) { //nolint:gocyclo // a single translation step
+	label := describe(r, p)
+	fail := func(err error) {
+		c.warn(fmt.Sprintf("resource %s is skipped: %s", label, err))
+	}
+	for _, k := range []string{"copy", "parent"} {
+		if _, ok := r[k]; ok {
+			fail(errors.Errorf(errUnsupportedFieldFmt, k))
+			return
+		}
+	}
+	if existing, _ := r["existing"].(bool); existing {
+		c.warn(fmt.Sprintf("resource %s is skipped: it's an existing resource", label))
+		return
+	}
+	if cond, ok := r["condition"]; ok {
+		v, err := c.e.evaluate(cond)
+		if err != nil {
+			fail(errors.Wrapf(err, errEvaluateFmt, "the condition"))
+			return
+		}
+		if b, ok := v.(bool); ok && !b {
+			c.warn(fmt.Sprintf("resource %s is skipped: its condition is false", label))
+			return
+		}
+	}
+	strs := map[string]string{}
+	for _, k := range []string{"type", "apiVersion", "name"} {
+		v, err := c.e.evaluate(r[k])
+		if err != nil {
+			fail(errors.Wrapf(err, errEvaluateFmt, k))
+			return
+		}
+		s, ok := v.(string)
+		if !ok {
+			fail(errors.Errorf(errNotStringFmt, k))
+			return
+		}
+		strs[k] = s
+	}
+	resourceType, name := strs["type"], strs["name"]
+	if p != nil {
+		resourceType = p.resourceType + "/" + resourceType
+		if strings.Count(name, "/") < strings.Count(resourceType, "/")-1 {
+			name = p.name + "/" + name
+		}
+	}
+	if strings.EqualFold(resourceType, "Microsoft.Resources/deployments") {
+		c.warn(fmt.Sprintf("resource %s is skipped: nested deployments are not supported", label))
+		return
+	}
+	if s, ok := r["scope"]; ok {
+		v, err := c.e.evaluate(s)
+		if err != nil {
+			fail(errors.Wrapf(err, errEvaluateFmt, "the scope"))
+			return
+		}
+		scope, _ := v.(string)
+		if !strings.HasPrefix(scope, "/") {
+			fail(errors.Errorf(errScopeFmt, scope))
+			return
+		}
+		base = strings.TrimSuffix(scope, "/")
+	}
+	parentID, leaf, err := parentOf(base, resourceType, name)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	forProvider := map[string]any{
+		"type":     resourceType + "@" + strs["apiVersion"],
+		"name":     leaf,
+		"parentId": parentID,
+	}
+	body := map[string]any{}
+	for k, v := range r {
+		switch k {
+		case "type", "apiVersion", "name", "scope", "condition", "dependsOn", "comments", "metadata", "resources", "existing":
+			continue
+		}
+		ev, err := c.e.evaluate(v)
+		if err != nil {
+			fail(errors.Wrapf(err, errEvaluateFmt, k))
+			return
+		}
+		switch k {
+		case "location":
+			forProvider["location"] = ev
+		case "tags":
+			tags := map[string]any{}
+			m, _ := ev.(map[string]any)
+			for tk, tv := range m {
+				tags[tk] = stringify(tv)
+			}
+			forProvider["tags"] = tags
+		case "identity":
+			if id := identity(ev); id != nil {
+				forProvider["identity"] = []any{id}
+			}
+		default:
+			body[k] = ev
+		}
+	}
+	forProvider["body"] = body
+
+	if err := c.add(resourceType, name, forProvider); err != nil {
+		fail(err)
+		return
+	}
+	nested, _ := r["resources"].([]any)
+	if len(nested) == 0 {
+		return
+	}
+	self := &parent{resourceType: resourceType, name: name}
+	for _, n := range nested {
+		m, ok := n.(map[string]any)
+		if !ok {
+			continue
+		}
+		c.resource(m, base, self)
+	}
+}
+
+// add appends the Resource with the supplied forProvider to the result.
+func (c *converter) add(resourceType, name string, forProvider map[string]any) error {
+	u, err := c.b.Build(kind, name, map[string]string{
+		AnnotationKeyTemplateResource: relativeID(resourceType, name),
+	}, map[string]any{"forProvider": forProvider})
+	if err != nil {
+		return err
+	}
+	c.result.Resources = append(c.result.Resources, u)
+	return nil
+}
+
+// parentOf returns the parent ID and the last name segment of the resource
+// with the supplied type and full name in the supplied scope.
+func parentOf(base, resourceType, name string) (string, string, error) {
+	types := strings.Split(resourceType, "/")
+	names := strings.Split(name, "/")
+	if len(names) != len(types)-1 {
+		return "", "", errors.Errorf(errNameSegmentsFmt, name, len(types)-1, resourceType)
+	}
+	if len(names) == 1 {
+		return base, names[0], nil
+	}
+	id := base + "/providers/" + types[0]
+	for i, n := range names[:len(names)-1] {
+		id += "/" + types[i+1] + "/" + n
+	}
+	return id, names[len(names)-1], nil
+}
+
+// relativeID returns the ID of the resource with the supplied type and full
+// name relative to its scope.
+func relativeID(resourceType, name string) string {
+	types := strings.Split(resourceType, "/")
+	id := types[0]
+	for i, n := range strings.Split(name, "/") {
+		id += "/" + types[i+1] + "/" + n
+	}
+	return id
+}
+
+// identity returns the identity block of the Resource with the supplied
+// template identity, nil if there's none.
+func identity(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	t, _ := m["type"].(string)
+	if t == "" || strings.EqualFold(t, "None") {
+		return nil
+	}
+	result := map[string]any{"type": t}
+	ids, _ := m["userAssignedIdentities"].(map[string]any)
+	if len(ids) > 0 {
+		keys := make([]any, 0, len(ids))
+		for k := range ids {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i].(string) < keys[j].(string) })
+		result["identityIds"] = keys
+	}
+	return result
+}
+
+// describe returns the type and name of the supplied template resource as
+// they are written in the template.
+func describe(r map[string]any, p *parent) string {
+	t, _ := r["type"].(string)
+	n, _ := r["name"].(string)
+	if p != nil {
+		t = p.resourceType + "/" + t
+	}
+	return fmt.Sprintf("%s %q", t, n)
+}
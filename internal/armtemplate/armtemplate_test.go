@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package armtemplate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConvertWarnings(t *testing.T) {
+	cases := map[string]struct {
+		template      string
+		parameters    string
+		wantResources []string
+		wantWarnings  []string
+	}{
+		"Translated": {
+			template: `{
+				"resources": [{
+					"type": "Microsoft.Network/virtualNetworks",
+					"apiVersion": "2023-04-01",
+					"name": "vnet",
+					"location": "[resourceGroup().location]",
+					"properties": {},
+					"resources": [{
+						"type": "subnets",
+						"apiVersion": "2023-04-01",
+						"name": "default",
+						"properties": {}
+					}]
+				}]
+			}`,
+			wantResources: []string{"vnet", "vnet-default"},
+		},
+		"Unsupported": {
+			template: `{
+				"functions": [{"namespace": "ns"}],
+				"outputs": {"id": {"type": "string", "value": "x"}},
+				"resources": [
+					{"type": "Microsoft.Storage/storageAccounts", "apiVersion": "2023-05-01", "name": "[concat('sa', copyIndex())]", "copy": {"name": "loop", "count": 2}},
+					{"type": "Microsoft.Storage/storageAccounts", "apiVersion": "2023-05-01", "name": "existing", "existing": true},
+					{"type": "Microsoft.Storage/storageAccounts", "apiVersion": "2023-05-01", "name": "disabled", "condition": "[false()]"},
+					{"type": "Microsoft.Resources/deployments", "apiVersion": "2022-09-01", "name": "nested"},
+					{"type": "Microsoft.Web/sites", "apiVersion": "2023-01-01", "name": "app", "properties": {"key": "[listKeys('id', '2023-01-01')]"}}
+				]
+			}`,
+			wantWarnings: []string{
+				"the user-defined functions of the template are not supported",
+				"the outputs of the template are not translated",
+				`resource Microsoft.Storage/storageAccounts "[concat('sa', copyIndex())]" is skipped: copy is not supported`,
+				`resource Microsoft.Storage/storageAccounts "existing" is skipped: it's an existing resource`,
+				`resource Microsoft.Storage/storageAccounts "disabled" is skipped: its condition is false`,
+				`resource Microsoft.Resources/deployments "nested" is skipped: nested deployments are not supported`,
+				`resource Microsoft.Web/sites "app" is skipped: cannot evaluate properties: key: unsupported template function listKeys()`,
+			},
+		},
+		"Cycle": {
+			template: `{
+				"parameters": {"name": {"type": "string", "defaultValue": "[parameters('name')]"}},
+				"resources": [{"type": "Microsoft.Storage/storageAccounts", "apiVersion": "2023-05-01", "name": "[parameters('name')]"}]
+			}`,
+			wantWarnings: []string{
+				`resource Microsoft.Storage/storageAccounts "[parameters('name')]" is skipped: cannot evaluate name: the default value of the parameter "name" references itself`,
+			},
+		},
+		"KeyVaultReferenceAndSecure": {
+			template: `{
+				"parameters": {
+					"password": {"type": "securestring"},
+					"key": {"type": "securestring"}
+				},
+				"resources": [
+					{"type": "Microsoft.Sql/servers", "apiVersion": "2023-05-01-preview", "name": "sql", "properties": {"administratorLoginPassword": "[parameters('password')]"}},
+					{"type": "Microsoft.Sql/servers", "apiVersion": "2023-05-01-preview", "name": "kv", "properties": {"administratorLoginPassword": "[parameters('key')]"}}
+				]
+			}`,
+			parameters: `{
+				"parameters": {
+					"password": {"value": "secret"},
+					"key": {"reference": {"keyVault": {"id": "id"}, "secretName": "s"}}
+				}
+			}`,
+			wantResources: []string{"sql"},
+			wantWarnings: []string{
+				`resource Microsoft.Sql/servers "kv" is skipped: cannot evaluate properties: administratorLoginPassword: the parameter "key" references a Key Vault secret`,
+				`the secure parameter "password" is written in plain text into the manifests, consider moving it to the sensitiveBody of the Resources`,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := Convert([]byte(tc.template), []byte(tc.parameters), Options{SubscriptionID: "sub", ResourceGroup: "rg", Location: "westeurope"})
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			var names []string
+			for _, u := range r.Resources {
+				names = append(names, u.GetName())
+			}
+			if !reflect.DeepEqual(tc.wantResources, names) {
+				t.Errorf("want resources %v, got %v", tc.wantResources, names)
+			}
+			if !reflect.DeepEqual(tc.wantWarnings, r.Warnings) {
+				t.Errorf("want warnings\n%q\ngot\n%q", tc.wantWarnings, r.Warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package armtemplate
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// An evaluator evaluates the expressions of a template.
+type evaluator struct {
+	o     Options
+	scope string
+	// definitions of the parameters and the raw variables by lower case
+	// name
+	definitions map[string]parameterDefinition
+	variables   map[string]any
+	// values of the parameters and the variables by lower case name, an
+	// error if a parameter cannot be evaluated
+	values    map[string]any
+	varValues map[string]any
+	// evaluating are the variables being evaluated, evaluatingParams the
+	// parameters whose default values are being evaluated
+	evaluating       map[string]bool
+	evaluatingParams map[string]bool
+	// secure are the secure parameters used by the expressions
+	secure map[string]bool
+}
+
+func newEvaluator(t *template, o Options) *evaluator {
+	e := &evaluator{
+		o:                o,
+		scope:            templateScope(t.Schema),
+		definitions:      make(map[string]parameterDefinition, len(t.Parameters)),
+		variables:        make(map[string]any, len(t.Variables)),
+		values:           map[string]any{},
+		varValues:        map[string]any{},
+		evaluating:       map[string]bool{},
+		evaluatingParams: map[string]bool{},
+		secure:           map[string]bool{},
+	}
+	for n, d := range t.Parameters {
+		e.definitions[strings.ToLower(n)] = d
+	}
+	for n, v := range t.Variables {
+		e.variables[strings.ToLower(n)] = v
+	}
+	return e
+}
+
+// templateScope returns the deployment scope of the template with the
+// supplied schema.
+func templateScope(schema string) string {
+	s := strings.ToLower(schema)
+	switch {
+	case strings.Contains(s, "subscriptiondeploymenttemplate"):
+		return scopeSubscription
+	case strings.Contains(s, "managementgroupdeploymenttemplate"):
+		return scopeManagementGroup
+	case strings.Contains(s, "tenantdeploymenttemplate"):
+		return scopeTenant
+	}
+	return scopeResourceGroup
+}
+
+// scopeID returns the ID of the deployment scope, the parent of the top
+// level resources.
+func (e *evaluator) scopeID() (string, error) {
+	switch e.scope {
+	case scopeTenant:
+		return "/", nil
+	case scopeManagementGroup:
+		if e.o.ManagementGroupID == "" {
+			return "", errors.Errorf(errNeedsOptionFmt, "a management group deployment", "management group ID")
+		}
+		return "/providers/Microsoft.Management/managementGroups/" + e.o.ManagementGroupID, nil
+	}
+	sub, err := e.subscriptionID("a " + e.scope + " deployment")
+	if err != nil {
+		return "", err
+	}
+	if e.scope == scopeSubscription {
+		return "/subscriptions/" + sub, nil
+	}
+	rg, err := e.resourceGroupName("a resource group deployment")
+	if err != nil {
+		return "", err
+	}
+	return "/subscriptions/" + sub + "/resourceGroups/" + rg, nil
+}
+
+func (e *evaluator) subscriptionID(user string) (string, error) {
+	if e.o.SubscriptionID == "" {
+		return "", errors.Errorf(errNeedsOptionFmt, user, "subscription ID")
+	}
+	return e.o.SubscriptionID, nil
+}
+
+func (e *evaluator) resourceGroupName(user string) (string, error) {
+	if e.o.ResourceGroup == "" {
+		return "", errors.Errorf(errNeedsOptionFmt, user, "resource group")
+	}
+	return e.o.ResourceGroup, nil
+}
+
+// parameter returns the value of the parameter with the supplied name: the
+// one of the parameters file, or its default value.
+func (e *evaluator) parameter(name string) (any, error) {
+	key := strings.ToLower(name)
+	d, ok := e.definitions[key]
+	if !ok {
+		return nil, errors.Errorf(errUndefinedParamFmt, name)
+	}
+	if v, ok := e.values[key]; ok {
+		if err, ok := v.(error); ok {
+			return nil, err
+		}
+		e.use(name, d)
+		return v, nil
+	}
+	if d.DefaultValue == nil {
+		return nil, errors.Errorf(errNoValueFmt, name)
+	}
+	if e.evaluatingParams[key] {
+		return nil, errors.Errorf(errParamCycleFmt, name)
+	}
+	e.evaluatingParams[key] = true
+	defer delete(e.evaluatingParams, key)
+	v, err := e.evaluate(d.DefaultValue)
+	if err != nil {
+		return nil, err
+	}
+	e.values[key] = v
+	e.use(name, d)
+	return v, nil
+}
+
+// use records that the value of the parameter with the supplied name and
+// definition is used by an expression.
+func (e *evaluator) use(name string, d parameterDefinition) {
+	if t := strings.ToLower(d.Type); t == "securestring" || t == "secureobject" {
+		e.secure[name] = true
+	}
+}
+
+// variable returns the evaluated value of the variable with the supplied
+// name.
+func (e *evaluator) variable(name string) (any, error) {
+	key := strings.ToLower(name)
+	if v, ok := e.varValues[key]; ok {
+		return v, nil
+	}
+	raw, ok := e.variables[key]
+	if !ok {
+		return nil, errors.Errorf(errUndefinedVarFmt, name)
+	}
+	if e.evaluating[key] {
+		return nil, errors.Errorf(errVarCycleFmt, name)
+	}
+	e.evaluating[key] = true
+	defer delete(e.evaluating, key)
+	v, err := e.evaluate(raw)
+	if err != nil {
+		return nil, err
+	}
+	e.varValues[key] = v
+	return v, nil
+}
+
+// evaluate returns a copy of the supplied template value with its
+// expressions evaluated.
+func (e *evaluator) evaluate(v any) (any, error) {
+	switch t := v.(type) {
+	case string:
+		if !isExpression(t) {
+			return unescape(t), nil
+		}
+		x, err := parseExpression(t[1 : len(t)-1])
+		if err != nil {
+			return nil, err
+		}
+		return x.eval(e)
+	case map[string]any:
+		if isPropertyCopy(t) {
+			return nil, errors.Errorf(errUnsupportedFieldFmt, "the property copy loop")
+		}
+		result := make(map[string]any, len(t))
+		for k, ev := range t {
+			rv, err := e.evaluate(ev)
+			if err != nil {
+				return nil, errors.Wrap(err, k)
+			}
+			// the keys may be expressions too, e.g. the user assigned
+			// identity IDs
+			rk, err := e.evaluate(k)
+			if err != nil {
+				return nil, errors.Wrap(err, k)
+			}
+			ks, ok := rk.(string)
+			if !ok {
+				return nil, errors.Errorf(errNotStringFmt, k)
+			}
+			result[ks] = rv
+		}
+		return result, nil
+	case []any:
+		result := make([]any, len(t))
+		for i, ev := range t {
+			rv, err := e.evaluate(ev)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = rv
+		}
+		return result, nil
+	}
+	return v, nil
+}
+
+// isPropertyCopy returns true if the supplied template object holds a
+// property copy loop, i.e. a copy list of name, count and input objects.
+func isPropertyCopy(m map[string]any) bool {
+	l, ok := m["copy"].([]any)
+	if !ok || len(l) == 0 {
+		return false
+	}
+	c, _ := l[0].(map[string]any)
+	_, ok = c["input"]
+	return ok
+}
+
+// secureUsed returns the names of the secure parameters used by the
+// expressions, sorted.
+func (e *evaluator) secureUsed() []string {
+	names := make([]string, 0, len(e.secure))
+	for n := range e.secure {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,564 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package armtemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errUnexpectedEndFmt     = "unexpected end of the expression %q"
+	errUnexpectedTokenFmt   = "unexpected %q at %d in the expression %q"
+	errUnsupportedFuncFmt   = "unsupported template function %s()"
+	errArgCountFmt          = "%s() expects %s arguments, got %d"
+	errArgTypeFmt           = "argument %d of %s() must be a %s"
+	errNoPropertyFmt        = "the value has no property %q"
+	errIndexFmt             = "cannot index the value with %v"
+	errUndefinedParamFmt    = "undefined parameter %q"
+	errUndefinedVarFmt      = "undefined variable %q"
+	errVarCycleFmt          = "the variable %q references itself"
+	errParamCycleFmt        = "the default value of the parameter %q references itself"
+	errResourceIDSegmentFmt = "the resource type %q has %d segments but %d names were given"
+	errNeedsOptionFmt       = "%s needs the %s to be set"
+)
+
+// isExpression returns true if the supplied template string is an
+// expression, i.e. enclosed in brackets and not escaped with [[.
+func isExpression(s string) bool {
+	return len(s) >= 2 && s[0] == '[' && s[len(s)-1] == ']' && !strings.HasPrefix(s, "[[")
+}
+
+// unescape returns the literal value of a template string that is not an
+// expression.
+func unescape(s string) string {
+	if strings.HasPrefix(s, "[[") {
+		return s[1:]
+	}
+	return s
+}
+
+// An expr is a node of a parsed template expression.
+type expr interface {
+	eval(c *evaluator) (any, error)
+}
+
+type literal struct {
+	v any
+}
+
+func (l literal) eval(*evaluator) (any, error) {
+	return l.v, nil
+}
+
+type call struct {
+	name string
+	args []expr
+}
+
+func (f call) eval(c *evaluator) (any, error) {
+	fn, ok := functions[strings.ToLower(f.name)]
+	if !ok {
+		return nil, errors.Errorf(errUnsupportedFuncFmt, f.name)
+	}
+	args := make([]any, len(f.args))
+	for i, a := range f.args {
+		v, err := a.eval(c)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	return fn(c, f.name, args)
+}
+
+// An access is a property access, e.g. resourceGroup().location, or an index
+// access, e.g. parameters('subnets')[0].
+type access struct {
+	target expr
+	key    expr
+}
+
+func (a access) eval(c *evaluator) (any, error) {
+	t, err := a.target.eval(c)
+	if err != nil {
+		return nil, err
+	}
+	k, err := a.key.eval(c)
+	if err != nil {
+		return nil, err
+	}
+	switch tv := t.(type) {
+	case map[string]any:
+		ks, ok := k.(string)
+		if !ok {
+			return nil, errors.Errorf(errIndexFmt, k)
+		}
+		// the property names of the template values are case-insensitive
+		for name, v := range tv {
+			if strings.EqualFold(name, ks) {
+				return v, nil
+			}
+		}
+		return nil, errors.Errorf(errNoPropertyFmt, ks)
+	case []any:
+		i, ok := toInt(k)
+		if !ok || i < 0 || i >= len(tv) {
+			return nil, errors.Errorf(errIndexFmt, k)
+		}
+		return tv[i], nil
+	}
+	return nil, errors.Errorf(errIndexFmt, k)
+}
+
+// parseExpression parses the supplied expression, without its enclosing
+// brackets.
+func parseExpression(s string) (expr, error) {
+	p := &parser{src: s}
+	e, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.src) {
+		return nil, p.unexpected()
+	}
+	return e, nil
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) unexpected() error {
+	if p.pos >= len(p.src) {
+		return errors.Errorf(errUnexpectedEndFmt, p.src)
+	}
+	return errors.Errorf(errUnexpectedTokenFmt, string(p.src[p.pos]), p.pos, p.src)
+}
+
+func (p *parser) expr() (expr, error) { //nolint:gocyclo // a single recursive descent step
+	p.skipSpaces()
+	if p.pos >= len(p.src) {
+		return nil, p.unexpected()
+	}
+	var e expr
+	switch ch := p.src[p.pos]; {
+	case ch == '\'':
+		s, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+		e = literal{v: s}
+	case ch == '-' || (ch >= '0' && ch <= '9'):
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+			p.pos++
+		}
+		n, err := strconv.ParseInt(p.src[start:p.pos], 10, 64)
+		if err != nil {
+			return nil, errors.Errorf(errUnexpectedTokenFmt, p.src[start:p.pos], start, p.src)
+		}
+		e = literal{v: n}
+	case isIdentStart(ch):
+		name := p.ident()
+		p.skipSpaces()
+		if p.pos >= len(p.src) || p.src[p.pos] != '(' {
+			return nil, p.unexpected()
+		}
+		p.pos++
+		args, err := p.args()
+		if err != nil {
+			return nil, err
+		}
+		e = call{name: name, args: args}
+	default:
+		return nil, p.unexpected()
+	}
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.src) {
+			return e, nil
+		}
+		switch p.src[p.pos] {
+		case '.':
+			p.pos++
+			p.skipSpaces()
+			if p.pos >= len(p.src) || !isIdentStart(p.src[p.pos]) {
+				return nil, p.unexpected()
+			}
+			e = access{target: e, key: literal{v: p.ident()}}
+		case '[':
+			p.pos++
+			k, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			p.skipSpaces()
+			if p.pos >= len(p.src) || p.src[p.pos] != ']' {
+				return nil, p.unexpected()
+			}
+			p.pos++
+			e = access{target: e, key: k}
+		default:
+			return e, nil
+		}
+	}
+}
+
+func (p *parser) args() ([]expr, error) {
+	var args []expr
+	p.skipSpaces()
+	if p.pos < len(p.src) && p.src[p.pos] == ')' {
+		p.pos++
+		return args, nil
+	}
+	for {
+		a, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, a)
+		p.skipSpaces()
+		if p.pos >= len(p.src) {
+			return nil, p.unexpected()
+		}
+		switch p.src[p.pos] {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return args, nil
+		default:
+			return nil, p.unexpected()
+		}
+	}
+}
+
+// str parses a single quoted string, where ” is an escaped quote.
+func (p *parser) str() (string, error) {
+	var sb strings.Builder
+	p.pos++
+	for p.pos < len(p.src) {
+		ch := p.src[p.pos]
+		p.pos++
+		if ch != '\'' {
+			sb.WriteByte(ch)
+			continue
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+			sb.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		return sb.String(), nil
+	}
+	return "", p.unexpected()
+}
+
+func (p *parser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) && (isIdentStart(p.src[p.pos]) || (p.src[p.pos] >= '0' && p.src[p.pos] <= '9')) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+// A function is a template function.
+type function func(c *evaluator, name string, args []any) (any, error)
+
+// functions are the supported template functions by lower case name.
+var functions = map[string]function{
+	"parameters":             parameters,
+	"variables":              variables,
+	"concat":                 concat,
+	"resourceid":             resourceID,
+	"subscriptionresourceid": subscriptionResourceID,
+	"tenantresourceid":       tenantResourceID,
+	"subscription":           subscription,
+	"resourcegroup":          resourceGroup,
+	"format":                 format,
+	"tolower":                stringFunc(strings.ToLower),
+	"toupper":                stringFunc(strings.ToUpper),
+	"string":                 toString,
+	"true":                   constant(true),
+	"false":                  constant(false),
+	"null":                   constant(nil),
+}
+
+func parameters(c *evaluator, name string, args []any) (any, error) {
+	n, err := stringArg(name, args, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	return c.parameter(n)
+}
+
+func variables(c *evaluator, name string, args []any) (any, error) {
+	n, err := stringArg(name, args, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	return c.variable(n)
+}
+
+// concat concatenates either strings or arrays.
+func concat(_ *evaluator, _ string, args []any) (any, error) {
+	if len(args) > 0 {
+		if _, ok := args[0].([]any); ok {
+			var result []any
+			for _, a := range args {
+				l, _ := a.([]any)
+				result = append(result, l...)
+			}
+			return result, nil
+		}
+	}
+	var sb strings.Builder
+	for _, a := range args {
+		sb.WriteString(stringify(a))
+	}
+	return sb.String(), nil
+}
+
+// resourceID returns the ID of a resource in the resource group of the
+// deployment, or the supplied subscription and resource group. At the
+// subscription scope it returns the ID of a subscription level resource.
+func resourceID(c *evaluator, name string, args []any) (any, error) {
+	strs, err := stringArgs(name, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	i := typeArgIndex(strs)
+	var sub, rg string
+	switch i {
+	case 0:
+	case 1:
+		rg = strs[0]
+	case 2:
+		sub, rg = strs[0], strs[1]
+	default:
+		return nil, errors.Errorf(errArgCountFmt, name, "at least 2", len(args))
+	}
+	if sub == "" {
+		if sub, err = c.subscriptionID(name); err != nil {
+			return nil, err
+		}
+	}
+	scope := "/subscriptions/" + sub
+	if rg == "" && c.scope == scopeResourceGroup {
+		if rg, err = c.resourceGroupName(name); err != nil {
+			return nil, err
+		}
+	}
+	if rg != "" {
+		scope += "/resourceGroups/" + rg
+	}
+	return buildID(scope, strs[i], strs[i+1:])
+}
+
+func subscriptionResourceID(c *evaluator, name string, args []any) (any, error) {
+	strs, err := stringArgs(name, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	i := typeArgIndex(strs)
+	var sub string
+	switch i {
+	case 0:
+		if sub, err = c.subscriptionID(name); err != nil {
+			return nil, err
+		}
+	case 1:
+		sub = strs[0]
+	default:
+		return nil, errors.Errorf(errArgCountFmt, name, "at least 2", len(args))
+	}
+	return buildID("/subscriptions/"+sub, strs[i], strs[i+1:])
+}
+
+func tenantResourceID(_ *evaluator, name string, args []any) (any, error) {
+	strs, err := stringArgs(name, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return buildID("", strs[0], strs[1:])
+}
+
+// typeArgIndex returns the index of the resource type argument of the
+// resource ID functions, i.e. the first one with a namespace.
+func typeArgIndex(args []string) int {
+	for i, a := range args {
+		if strings.Contains(a, "/") && strings.Contains(strings.SplitN(a, "/", 2)[0], ".") {
+			return i
+		}
+	}
+	return 0
+}
+
+// buildID returns the ID of the resource of the supplied type with the
+// supplied names, one per type segment, in the supplied scope.
+func buildID(scope, resourceType string, names []string) (string, error) {
+	segments := strings.Split(resourceType, "/")
+	if len(segments)-1 != len(names) {
+		return "", errors.Errorf(errResourceIDSegmentFmt, resourceType, len(segments)-1, len(names))
+	}
+	id := scope + "/providers/" + segments[0]
+	for i, n := range names {
+		id += "/" + segments[i+1] + "/" + n
+	}
+	return id, nil
+}
+
+func subscription(c *evaluator, name string, _ []any) (any, error) {
+	sub, err := c.subscriptionID(name)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{
+		"id":             "/subscriptions/" + sub,
+		"subscriptionId": sub,
+		"tenantId":       c.o.TenantID,
+	}, nil
+}
+
+func resourceGroup(c *evaluator, name string, _ []any) (any, error) {
+	sub, err := c.subscriptionID(name)
+	if err != nil {
+		return nil, err
+	}
+	rg, err := c.resourceGroupName(name)
+	if err != nil {
+		return nil, err
+	}
+	if c.o.Location == "" {
+		return nil, errors.Errorf(errNeedsOptionFmt, name+"()", "location")
+	}
+	return map[string]any{
+		"id":       "/subscriptions/" + sub + "/resourceGroups/" + rg,
+		"name":     rg,
+		"location": c.o.Location,
+	}, nil
+}
+
+// format replaces the {0} style placeholders of its first argument.
+func format(_ *evaluator, name string, args []any) (any, error) {
+	f, err := stringArg(name, args, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	for i, a := range args[1:] {
+		f = strings.ReplaceAll(f, "{"+strconv.Itoa(i)+"}", stringify(a))
+	}
+	return f, nil
+}
+
+func stringFunc(fn func(string) string) function {
+	return func(_ *evaluator, name string, args []any) (any, error) {
+		s, err := stringArg(name, args, 0, 1)
+		if err != nil {
+			return nil, err
+		}
+		return fn(s), nil
+	}
+}
+
+func toString(_ *evaluator, name string, args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.Errorf(errArgCountFmt, name, "1", len(args))
+	}
+	return stringify(args[0]), nil
+}
+
+func constant(v any) function {
+	return func(_ *evaluator, name string, args []any) (any, error) {
+		if len(args) != 0 {
+			return nil, errors.Errorf(errArgCountFmt, name, "no", len(args))
+		}
+		return v, nil
+	}
+}
+
+// stringArg returns the string argument at the supplied index, checking that
+// the function got the supplied number of arguments unless it's negative.
+func stringArg(name string, args []any, i, count int) (string, error) {
+	if count >= 0 && len(args) != count {
+		return "", errors.Errorf(errArgCountFmt, name, strconv.Itoa(count), len(args))
+	}
+	if i >= len(args) {
+		return "", errors.Errorf(errArgCountFmt, name, "at least "+strconv.Itoa(i+1), len(args))
+	}
+	s, ok := args[i].(string)
+	if !ok {
+		return "", errors.Errorf(errArgTypeFmt, i+1, name, "string")
+	}
+	return s, nil
+}
+
+// stringArgs returns the arguments, which must be at least min strings.
+func stringArgs(name string, args []any, minCount int) ([]string, error) {
+	if len(args) < minCount {
+		return nil, errors.Errorf(errArgCountFmt, name, "at least "+strconv.Itoa(minCount), len(args))
+	}
+	result := make([]string, len(args))
+	for i := range args {
+		s, err := stringArg(name, args, i, -1)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = s
+	}
+	return result, nil
+}
+
+// stringify returns the template string representation of the supplied
+// value: strings as they are, the other values JSON encoded.
+func stringify(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case int64:
+		return strconv.FormatInt(t, 10)
+	case nil:
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func toInt(v any) (int, bool) {
+	switch t := v.(type) {
+	case int64:
+		return int(t), true
+	case float64:
+		if t == float64(int(t)) {
+			return int(t), true
+		}
+	case json.Number:
+		i, err := t.Int64()
+		return int(i), err == nil
+	}
+	return 0, false
+}
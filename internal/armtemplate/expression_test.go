@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package armtemplate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExpression(t *testing.T) {
+	cases := map[string]struct {
+		src     string
+		want    expr
+		wantErr string
+	}{
+		"String": {
+			src:  "'it''s'",
+			want: literal{v: "it's"},
+		},
+		"Number": {
+			src:  "-42",
+			want: literal{v: int64(-42)},
+		},
+		"Call": {
+			src: "concat('a', parameters( 'b' ))",
+			want: call{name: "concat", args: []expr{
+				literal{v: "a"},
+				call{name: "parameters", args: []expr{literal{v: "b"}}},
+			}},
+		},
+		"NoArgs": {
+			src:  "resourceGroup()",
+			want: call{name: "resourceGroup"},
+		},
+		"Accesses": {
+			src: "parameters('subnets')[0].name",
+			want: access{
+				target: access{
+					target: call{name: "parameters", args: []expr{literal{v: "subnets"}}},
+					key:    literal{v: int64(0)},
+				},
+				key: literal{v: "name"},
+			},
+		},
+		"UnterminatedString": {
+			src:     "concat('a",
+			wantErr: `unexpected end of the expression "concat('a"`,
+		},
+		"MissingParenthesis": {
+			src:     "concat('a', 'b'",
+			wantErr: `unexpected end of the expression "concat('a', 'b'"`,
+		},
+		"NoCall": {
+			src:     "parameters",
+			wantErr: `unexpected end of the expression "parameters"`,
+		},
+		"TrailingToken": {
+			src:     "true() false()",
+			wantErr: `unexpected "f" at 7 in the expression "true() false()"`,
+		},
+		"UnterminatedIndex": {
+			src:     "variables('a')[0",
+			wantErr: `unexpected end of the expression "variables('a')[0"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseExpression(tc.src)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tmpl := &template{
+		Parameters: map[string]parameterDefinition{
+			"name":    {Type: "string", DefaultValue: "vnet"},
+			"derived": {Type: "string", DefaultValue: "[concat(parameters('name'), '-subnet')]"},
+			"self":    {Type: "string", DefaultValue: "[parameters('self')]"},
+			"ping":    {Type: "string", DefaultValue: "[parameters('pong')]"},
+			"pong":    {Type: "string", DefaultValue: "[toUpper(parameters('Ping'))]"},
+			"viaVar":  {Type: "string", DefaultValue: "[variables('fromParam')]"},
+			"noValue": {Type: "string"},
+			"list":    {Type: "array", DefaultValue: []any{"a"}},
+		},
+		Variables: map[string]any{
+			"prefix":    "[format('{0}-{1}', parameters('name'), 1)]",
+			"self":      "[variables('self')]",
+			"ping":      "[variables('pong')]",
+			"pong":      "[variables('PING')]",
+			"fromParam": "[parameters('viaVar')]",
+		},
+	}
+	cases := map[string]struct {
+		scope   string
+		value   any
+		want    any
+		wantErr string
+	}{
+		"Literal": {
+			value: "[[not an expression]",
+			want:  "[not an expression]",
+		},
+		"ConcatStrings": {
+			value: "[concat('a', 1, true())]",
+			want:  "a1true",
+		},
+		"ConcatArrays": {
+			value: "[concat(parameters('list'), parameters('list'))]",
+			want:  []any{"a", "a"},
+		},
+		"UnsupportedFunction": {
+			value:   map[string]any{"list": "[createArray(1)]"},
+			wantErr: "list: unsupported template function createArray()",
+		},
+		"Format": {
+			value: "[format('{0}/{1}/{0}', 'a', 2)]",
+			want:  "a/2/a",
+		},
+		"FormatNoArgs": {
+			value:   "[format()]",
+			wantErr: "format() expects at least 1 arguments, got 0",
+		},
+		"ResourceID": {
+			value: "[resourceId('Microsoft.Network/virtualNetworks/subnets', 'vnet', 'default')]",
+			want:  "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default",
+		},
+		"ResourceIDOtherResourceGroup": {
+			value: "[resourceId('other', 'Microsoft.Network/virtualNetworks', 'vnet')]",
+			want:  "/subscriptions/sub/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/vnet",
+		},
+		"ResourceIDOtherSubscription": {
+			value: "[resourceId('sub2', 'other', 'Microsoft.Network/virtualNetworks', 'vnet')]",
+			want:  "/subscriptions/sub2/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/vnet",
+		},
+		"ResourceIDSubscriptionScope": {
+			scope: scopeSubscription,
+			value: "[resourceId('Microsoft.Resources/resourceGroups', 'rg2')]",
+			want:  "/subscriptions/sub/providers/Microsoft.Resources/resourceGroups/rg2",
+		},
+		"ResourceIDSegments": {
+			value:   "[resourceId('Microsoft.Network/virtualNetworks/subnets', 'vnet')]",
+			wantErr: `the resource type "Microsoft.Network/virtualNetworks/subnets" has 2 segments but 1 names were given`,
+		},
+		"ResourceIDNotString": {
+			value:   "[resourceId('Microsoft.Network/virtualNetworks', 1)]",
+			wantErr: "argument 2 of resourceId() must be a string",
+		},
+		"Accesses": {
+			value: "[resourceGroup().location]",
+			want:  "westeurope",
+		},
+		"NoProperty": {
+			value:   "[subscription().unknown]",
+			wantErr: `the value has no property "unknown"`,
+		},
+		"ParameterDefault": {
+			value: "[parameters('derived')]",
+			want:  "vnet-subnet",
+		},
+		"ParameterNoValue": {
+			value:   "[parameters('noValue')]",
+			wantErr: `the parameter "noValue" has no value`,
+		},
+		"UndefinedParameter": {
+			value:   "[parameters('unknown')]",
+			wantErr: `undefined parameter "unknown"`,
+		},
+		"Variable": {
+			value: "[variables('prefix')]",
+			want:  "vnet-1",
+		},
+		"VariableCycle": {
+			value:   "[variables('self')]",
+			wantErr: `the variable "self" references itself`,
+		},
+		"MutualVariableCycle": {
+			value:   "[variables('ping')]",
+			wantErr: `the variable "PING" references itself`,
+		},
+		"ParameterCycle": {
+			value:   "[parameters('self')]",
+			wantErr: `the default value of the parameter "self" references itself`,
+		},
+		"MutualParameterCycle": {
+			value:   "[parameters('ping')]",
+			wantErr: `the default value of the parameter "Ping" references itself`,
+		},
+		"ParameterVariableCycle": {
+			value:   "[parameters('viaVar')]",
+			wantErr: `the default value of the parameter "viaVar" references itself`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := newEvaluator(tmpl, Options{SubscriptionID: "sub", ResourceGroup: "rg", Location: "westeurope"})
+			if tc.scope != "" {
+				e.scope = tc.scope
+			}
+			got, err := e.evaluate(tc.value)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	keyHistoryLimit = "history_limit"
+	keyHistory      = "history"
+	keyOutputStore  = "output_store"
+
+	defaultHistoryLimit = 10
+	maxHistoryLimit     = 50
+
+	// maxHistoryOutput and maxHistoryError bound the sizes of the output
+	// and the error of a run kept in the status.
+	maxHistoryOutput = 1024
+	maxHistoryError  = 512
+	// maxStoredOutput bounds the size of the output of a run kept in the
+	// output store, so that a few large outputs cannot exceed the size
+	// limit of the object.
+	maxStoredOutput = 256 << 10
+
+	outputStoreConfigMap = "ConfigMap"
+	outputStoreSecret    = "Secret"
+
+	// redactedValue replaces the values that look like credentials in the
+	// outputs of the runs that are not stored in Secrets.
+	redactedValue = "REDACTED"
+
+	outputRefTimeFormat = "20060102T150405Z"
+
+	errInvalidHistoryLimit = "historyLimit must be between 0 and 50"
+	errInvalidOutputStore  = "outputStore kind must be one of ConfigMap or Secret"
+	errGetOutputStore      = "cannot get the output store"
+	errApplyOutputStore    = "cannot apply the output store"
+	errOutputStoreOwner    = "cannot determine the owner of the output store"
+)
+
+var (
+	// sensitiveKey matches the keys of the output values that look like
+	// credentials.
+	sensitiveKey = regexp.MustCompile(`(?i)(key|secret|password|token|connectionstring|credential|sas)`)
+	// sensitiveValue matches the string values that look like credentials
+	// regardless of their keys: connection strings, SAS tokens and JWTs.
+	sensitiveValue = regexp.MustCompile(`(?i)((accountkey|sharedaccesskey|password|pwd)=[^;]|[?&]sig=|^eyJ[\w-]+\.[\w-]+\.[\w-]*$)`)
+)
+
+// outputStore is the object the full outputs of the runs of a resource
+// action are stored in.
+type outputStore struct {
+	kind      string
+	name      string
+	namespace string
+}
+
+// publishActionHistory prepends the supplied runs to the history of the
+// resource action, trims it to the history limit and sets it in the
+// supplied status, along with the last run time if a run succeeded. The full
+// outputs of the runs are stored in the output store, if any, which is
+// pruned of the outputs of the runs no longer in the history.
+func publishActionHistory(ctx context.Context, kube client.Client, mg resource.Managed, params, obs map[string]any, runs []actionRun, status map[string]any) error { //nolint:gocyclo // easier to follow as a unit
+	limit := defaultHistoryLimit
+	if v, ok := params[keyHistoryLimit].(float64); ok {
+		limit = int(v)
+	}
+	if limit < 0 || limit > maxHistoryLimit {
+		return errors.New(errInvalidHistoryLimit)
+	}
+	store, err := resolveOutputStore(mg, params)
+	if err != nil {
+		return err
+	}
+	history, _ := obs[keyHistory].([]any)
+	if len(runs) == 0 && len(history) <= limit {
+		return nil
+	}
+
+	outputs := map[string]string{}
+	entries := make([]any, 0, len(runs)+len(history))
+	for i := len(runs) - 1; i >= 0; i-- {
+		r := runs[i]
+		e := map[string]any{
+			"time":        r.time.Format(time.RFC3339),
+			"method":      r.method,
+			"status_code": int64(r.statusCode),
+		}
+		if !r.succeeded() {
+			e["error"] = truncate(r.err, maxHistoryError)
+			entries = append(entries, e)
+			continue
+		}
+		if t, ok := status[keyLastRunTime].(string); !ok || t < e["time"].(string) { //nolint:forcetypeassert // set above
+			status[keyLastRunTime] = e["time"]
+		}
+		full, _ := json.Marshal(r.output)
+		digest := sha256.Sum256(full)
+		e["output_sha256"] = hex.EncodeToString(digest[:])
+		redacted, _ := json.Marshal(redact(r.output))
+		e["output"] = truncate(string(redacted), maxHistoryOutput)
+		e["output_truncated"] = len(redacted) > maxHistoryOutput
+		if store != nil {
+			stored := redacted
+			if store.kind == outputStoreSecret {
+				stored, _ = json.Marshal(map[string]any{"output": r.output, "sensitive_output": r.sensitiveOutput})
+			}
+			if len(stored) <= maxStoredOutput {
+				ref := outputRef(mg, r.time, outputs)
+				outputs[ref] = string(stored)
+				e["output_ref"] = ref
+			}
+		}
+		entries = append(entries, e)
+	}
+	entries = append(entries, history...)
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	status[keyHistory] = entries
+	if store == nil {
+		return nil
+	}
+	keep := map[string]bool{}
+	for _, e := range entries {
+		if m, ok := e.(map[string]any); ok {
+			if ref, ok := m["output_ref"].(string); ok {
+				keep[ref] = true
+			}
+		}
+	}
+	return applyOutputStore(ctx, kube, mg, *store, outputs, keep)
+}
+
+func resolveOutputStore(mg resource.Managed, params map[string]any) (*outputStore, error) {
+	p, ok := params[keyOutputStore].(map[string]any)
+	if l, isList := params[keyOutputStore].([]any); isList && len(l) == 1 {
+		// singleton lists are converted to embedded objects in the CRDs
+		// but may still be supplied in the Terraform shape
+		p, ok = l[0].(map[string]any)
+	}
+	if !ok {
+		return nil, nil
+	}
+	s := &outputStore{namespace: mg.GetNamespace()}
+	s.kind, _ = p["kind"].(string)
+	s.name, _ = p["name"].(string)
+	if ns, _ := p["namespace"].(string); ns != "" && s.namespace == "" {
+		s.namespace = ns
+	}
+	if s.kind != outputStoreConfigMap && s.kind != outputStoreSecret {
+		return nil, errors.New(errInvalidOutputStore)
+	}
+	return s, nil
+}
+
+// outputRef returns the key of the output of a run of the supplied managed
+// resource completed at the supplied time, which is not in use by the
+// supplied outputs. The keys are prefixed with the name of the managed
+// resource so that several resource actions can share an output store.
+func outputRef(mg resource.Managed, t time.Time, outputs map[string]string) string {
+	base := mg.GetName() + "." + t.Format(outputRefTimeFormat)
+	ref := base + ".json"
+	for i := 1; ; i++ {
+		if _, ok := outputs[ref]; !ok {
+			return ref
+		}
+		ref = base + "-" + strconv.Itoa(i) + ".json"
+	}
+}
+
+// applyOutputStore adds the supplied outputs to the output store, creating
+// it if it does not exist, and removes the outputs of the supplied managed
+// resource that are not to be kept.
+func applyOutputStore(ctx context.Context, kube client.Client, mg resource.Managed, s outputStore, outputs map[string]string, keep map[string]bool) error {
+	owner, err := outputStoreOwner(kube, mg)
+	if err != nil {
+		return err
+	}
+	var obj client.Object = &corev1.ConfigMap{}
+	if s.kind == outputStoreSecret {
+		obj = &corev1.Secret{}
+	}
+	nn := client.ObjectKey{Namespace: s.namespace, Name: s.name}
+	err = kube.Get(ctx, nn, obj)
+	if resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errGetOutputStore)
+	}
+	create := kerrors.IsNotFound(err)
+	if create {
+		obj.SetNamespace(s.namespace)
+		obj.SetName(s.name)
+	}
+	if !hasOwner(obj, owner.UID) {
+		obj.SetOwnerReferences(append(obj.GetOwnerReferences(), owner))
+	}
+	ownRef := regexp.MustCompile(`^` + regexp.QuoteMeta(mg.GetName()) + `\.\d{8}T\d{6}Z(-\d+)?\.json$`)
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		if o.Data == nil {
+			o.Data = map[string]string{}
+		}
+		for k := range o.Data {
+			if ownRef.MatchString(k) && !keep[k] {
+				delete(o.Data, k)
+			}
+		}
+		for k, v := range outputs {
+			if keep[k] {
+				o.Data[k] = v
+			}
+		}
+	case *corev1.Secret:
+		if o.Data == nil {
+			o.Data = map[string][]byte{}
+		}
+		for k := range o.Data {
+			if ownRef.MatchString(k) && !keep[k] {
+				delete(o.Data, k)
+			}
+		}
+		for k, v := range outputs {
+			if keep[k] {
+				o.Data[k] = []byte(v)
+			}
+		}
+	}
+	if create {
+		return errors.Wrap(kube.Create(ctx, obj), errApplyOutputStore)
+	}
+	return errors.Wrap(kube.Update(ctx, obj), errApplyOutputStore)
+}
+
+// outputStoreOwner returns the owner reference of the output store of the
+// supplied managed resource.
+func outputStoreOwner(kube client.Client, mg resource.Managed) (metav1.OwnerReference, error) {
+	gvk, err := apiutil.GVKForObject(mg, kube.Scheme())
+	if err != nil {
+		return metav1.OwnerReference{}, errors.Wrap(err, errOutputStoreOwner)
+	}
+	return metav1.OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       mg.GetName(),
+		UID:        mg.GetUID(),
+	}, nil
+}
+
+func hasOwner(obj client.Object, uid ktypes.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// redact returns a copy of the supplied output with the values of the keys
+// that look like credentials and the string values that look like
+// credentials replaced. The detection is heuristic, outputs that may hold
+// other credentials are to be stored in Secrets.
+func redact(v any) any {
+	switch t := v.(type) {
+	case string:
+		if sensitiveValue.MatchString(t) {
+			return redactedValue
+		}
+	case map[string]any:
+		result := make(map[string]any, len(t))
+		for k, e := range t {
+			if sensitiveKey.MatchString(k) {
+				result[k] = redactedValue
+				continue
+			}
+			result[k] = redact(e)
+		}
+		return result
+	case []any:
+		result := make([]any, len(t))
+		for i, e := range t {
+			result[i] = redact(e)
+		}
+		return result
+	}
+	return v
+}
+
+// truncate returns the first n bytes of the supplied string, without
+// splitting a UTF-8 encoded rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
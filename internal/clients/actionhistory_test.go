@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+)
+
+func TestRedact(t *testing.T) {
+	cases := map[string]struct {
+		in   any
+		want any
+	}{
+		"SensitiveKey": {
+			in:   map[string]any{"primaryKey": "abc", "name": "a"},
+			want: map[string]any{"primaryKey": redactedValue, "name": "a"},
+		},
+		"Nested": {
+			in:   map[string]any{"keys": []any{map[string]any{"value": "abc"}}, "items": []any{map[string]any{"password": "p"}}},
+			want: map[string]any{"keys": redactedValue, "items": []any{map[string]any{"password": redactedValue}}},
+		},
+		"ConnectionString": {
+			in:   map[string]any{"value": "DefaultEndpointsProtocol=https;AccountName=a;AccountKey=abc;EndpointSuffix=core.windows.net"},
+			want: map[string]any{"value": redactedValue},
+		},
+		"SASURL": {
+			in:   []any{"https://a.blob.core.windows.net/c?sv=2022-11-02&sig=abc"},
+			want: []any{redactedValue},
+		},
+		"JWT": {
+			in:   map[string]any{"value": "eyJhbGciOiJSUzI1NiJ9.eyJzdWIiOiJhIn0.c2ln"},
+			want: map[string]any{"value": redactedValue},
+		},
+		"Plain": {
+			in:   map[string]any{"status": "Succeeded", "count": int64(1)},
+			want: map[string]any{"status": "Succeeded", "count": int64(1)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := redact(tc.in); !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPersistActionRun(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1beta2.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	mg := &v1beta2.ResourceAction{ObjectMeta: metav1.ObjectMeta{Name: "a", UID: "uid"}}
+	mg.Spec.ForProvider.OutputStore = &v1beta2.OutputStoreParameters{Kind: ptr("ConfigMap"), Name: ptr("outputs"), Namespace: ptr("ns")}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(mg.DeepCopy()).WithStatusSubresource(mg).Build()
+
+	run := actionRun{time: time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC), method: "POST", statusCode: 200, output: map[string]any{"primaryKey": "abc"}}
+	if err := persistActionRun(context.Background(), kube, mg, run); err != nil {
+		t.Fatalf("persistActionRun: %v", err)
+	}
+
+	got := &v1beta2.ResourceAction{}
+	if err := kube.Get(context.Background(), client.ObjectKeyFromObject(mg), got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	h := got.Status.AtProvider.History
+	if len(h) != 1 || h[0].Time == nil || *h[0].Time != "2025-01-01T11:00:00Z" || h[0].Output == nil || *h[0].Output != `{"primaryKey":"REDACTED"}` {
+		t.Errorf("want the run persisted into the history, got %+v", h)
+	}
+	if lr := got.Status.AtProvider.LastRunTime; lr == nil || *lr != "2025-01-01T11:00:00Z" {
+		t.Errorf("want the last run time persisted, got %v", lr)
+	}
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "outputs"}, cm); err != nil {
+		t.Fatalf("want the output store created, got %v", err)
+	}
+	if want := map[string]string{"a.20250101T110000Z.json": `{"primaryKey":"REDACTED"}`}; !reflect.DeepEqual(want, cm.Data) {
+		t.Errorf("want the output stored %v, got %v", want, cm.Data)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
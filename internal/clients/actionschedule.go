@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// RunPolicyOnce runs a resource action only when it's created.
+	RunPolicyOnce = "Once"
+	// RunPolicyOnChange runs a resource action when it's created and
+	// whenever its arguments change.
+	RunPolicyOnChange = "OnChange"
+	// RunPolicyScheduled runs a resource action when it's created, whenever
+	// its arguments change and according to its schedule.
+	RunPolicyScheduled = "Scheduled"
+
+	keyRunPolicy   = "run_policy"
+	keySchedule    = "schedule"
+	keyLastRunTime = "last_run_time"
+	keyNextRunTime = "next_run_time"
+
+	errInvalidRunPolicy = "runPolicy must be one of Once, OnChange or Scheduled"
+	errScheduleRequired = "schedule is required with the Scheduled run policy"
+	errParseSchedule    = "cannot parse the schedule"
+)
+
+// actionSchedule is the schedule of a resource action resolved from the
+// managed resource.
+type actionSchedule struct {
+	policy string
+	next   *time.Time
+}
+
+// resolveActionSchedule reads the run policy and the schedule of a resource
+// action from its parameters and sets its next run time, which follows the
+// supplied last run, in the supplied status.
+func resolveActionSchedule(params map[string]any, lastRun time.Time, status map[string]any) (actionSchedule, error) {
+	spec, _ := params[keySchedule].(string)
+	as := actionSchedule{policy: RunPolicyOnChange}
+	if spec != "" {
+		as.policy = RunPolicyScheduled
+	}
+	if p, _ := params[keyRunPolicy].(string); p != "" {
+		as.policy = p
+	}
+	switch as.policy {
+	case RunPolicyOnce, RunPolicyOnChange:
+	case RunPolicyScheduled:
+		if spec == "" {
+			return actionSchedule{}, errors.New(errScheduleRequired)
+		}
+	default:
+		return actionSchedule{}, errors.New(errInvalidRunPolicy)
+	}
+	status[keyNextRunTime] = nil
+	if as.policy == RunPolicyScheduled {
+		sched, err := cron.ParseStandard(spec)
+		if err != nil {
+			return actionSchedule{}, errors.Wrap(err, errParseSchedule)
+		}
+		next := sched.Next(lastRun).UTC()
+		as.next = &next
+		status[keyNextRunTime] = next.Format(time.RFC3339)
+	}
+	return as, nil
+}
+
+// due returns true if a scheduled run is due at the supplied time.
+func (as actionSchedule) due(now time.Time) bool {
+	return as.next != nil && !now.Before(*as.next)
+}
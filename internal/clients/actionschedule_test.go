@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+)
+
+var testLastRun = time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestResolveActionSchedule(t *testing.T) {
+	cases := map[string]struct {
+		params     map[string]any
+		want       actionSchedule
+		wantStatus map[string]any
+		wantErr    bool
+	}{
+		"DefaultOnChange": {
+			params:     map[string]any{},
+			want:       actionSchedule{policy: RunPolicyOnChange},
+			wantStatus: map[string]any{keyNextRunTime: nil},
+		},
+		"Once": {
+			params:     map[string]any{keyRunPolicy: RunPolicyOnce},
+			want:       actionSchedule{policy: RunPolicyOnce},
+			wantStatus: map[string]any{keyNextRunTime: nil},
+		},
+		"ScheduledByDefaultWithSchedule": {
+			params:     map[string]any{keySchedule: "0 * * * *"},
+			want:       actionSchedule{policy: RunPolicyScheduled, next: timePtr(time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC))},
+			wantStatus: map[string]any{keyNextRunTime: "2025-01-01T11:00:00Z"},
+		},
+		"Descriptor": {
+			params:     map[string]any{keyRunPolicy: RunPolicyScheduled, keySchedule: "@daily"},
+			want:       actionSchedule{policy: RunPolicyScheduled, next: timePtr(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))},
+			wantStatus: map[string]any{keyNextRunTime: "2025-01-02T00:00:00Z"},
+		},
+		"ScheduleIgnoredWithOnChange": {
+			params:     map[string]any{keyRunPolicy: RunPolicyOnChange, keySchedule: "0 * * * *"},
+			want:       actionSchedule{policy: RunPolicyOnChange},
+			wantStatus: map[string]any{keyNextRunTime: nil},
+		},
+		"ScheduleRequired": {
+			params:  map[string]any{keyRunPolicy: RunPolicyScheduled},
+			wantErr: true,
+		},
+		"InvalidPolicy": {
+			params:  map[string]any{keyRunPolicy: "Always"},
+			wantErr: true,
+		},
+		"InvalidSchedule": {
+			params:  map[string]any{keySchedule: "every hour"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status := map[string]any{}
+			got, err := resolveActionSchedule(tc.params, testLastRun, status)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("resolveActionSchedule: want error %t, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %+v, got %+v", tc.want, got)
+			}
+			if !reflect.DeepEqual(tc.wantStatus, status) {
+				t.Errorf("want the status %v, got %v", tc.wantStatus, status)
+			}
+		})
+	}
+}
+
+func TestActionScheduleDue(t *testing.T) {
+	next := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		as   actionSchedule
+		now  time.Time
+		want bool
+	}{
+		"NotScheduled": {
+			as:  actionSchedule{policy: RunPolicyOnChange},
+			now: next,
+		},
+		"Before": {
+			as:  actionSchedule{policy: RunPolicyScheduled, next: &next},
+			now: next.Add(-time.Second),
+		},
+		"At": {
+			as:   actionSchedule{policy: RunPolicyScheduled, next: &next},
+			now:  next,
+			want: true,
+		},
+		"After": {
+			as:   actionSchedule{policy: RunPolicyScheduled, next: &next},
+			now:  next.Add(time.Hour),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.as.due(tc.now); got != tc.want {
+				t.Errorf("want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+var (
+	testActionSchema = schema.Schema{Attributes: map[string]schema.Attribute{
+		"method": schema.StringAttribute{Optional: true},
+		"body":   schema.StringAttribute{Optional: true},
+		"when":   schema.StringAttribute{Optional: true},
+		"output": schema.DynamicAttribute{Computed: true},
+	}}
+	testActionType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"method": tftypes.String,
+		"body":   tftypes.String,
+		"when":   tftypes.String,
+		"output": tftypes.DynamicPseudoType,
+	}}
+)
+
+// testActionValue returns the Terraform value of a resource action with the
+// supplied body, run on the supplied lifecycle event and with a string
+// output, or a null value if body is empty.
+func testActionValue(body, when, output string) tftypes.Value {
+	if body == "" {
+		return tftypes.NewValue(testActionType, nil)
+	}
+	return tftypes.NewValue(testActionType, map[string]tftypes.Value{
+		"method": tftypes.NewValue(tftypes.String, "POST"),
+		"body":   tftypes.NewValue(tftypes.String, body),
+		"when":   tftypes.NewValue(tftypes.String, when),
+		"output": tftypes.NewValue(tftypes.String, output),
+	})
+}
+
+func TestResourceActionInterceptorModifyPlan(t *testing.T) {
+	now := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+	due := actionSchedule{policy: RunPolicyScheduled, next: timePtr(now)}
+	notDue := actionSchedule{policy: RunPolicyScheduled, next: timePtr(now.Add(time.Minute))}
+	cases := map[string]struct {
+		as    actionSchedule
+		state tftypes.Value
+		plan  tftypes.Value
+		want  tftypes.Value
+	}{
+		"Create": {
+			as:   actionSchedule{policy: RunPolicyOnce},
+			plan: testActionValue("a", "apply", ""),
+			want: testActionValue("a", "apply", ""),
+		},
+		"Delete": {
+			as:    due,
+			state: testActionValue("a", "apply", "out"),
+			want:  testActionValue("", "", ""),
+		},
+		"OnceIgnoresChanges": {
+			as:    actionSchedule{policy: RunPolicyOnce},
+			state: testActionValue("a", "apply", "out"),
+			plan:  testActionValue("b", "apply", "out"),
+			want:  testActionValue("a", "apply", "out"),
+		},
+		"OnChangeRunsOnChanges": {
+			as:    actionSchedule{policy: RunPolicyOnChange},
+			state: testActionValue("a", "apply", "out"),
+			plan:  testActionValue("b", "apply", "out"),
+			want:  testActionValue("b", "apply", "out"),
+		},
+		"ScheduledDue": {
+			as:    due,
+			state: testActionValue("a", "apply", "out"),
+			plan:  testActionValue("a", "apply", "out"),
+			want:  testActionValue("a", "apply", scheduledRunMarker),
+		},
+		"ScheduledNotDue": {
+			as:    notDue,
+			state: testActionValue("a", "apply", "out"),
+			plan:  testActionValue("a", "apply", "out"),
+			want:  testActionValue("a", "apply", "out"),
+		},
+		"ScheduledDueOnDestroy": {
+			as:    due,
+			state: testActionValue("a", "destroy", "out"),
+			plan:  testActionValue("a", "destroy", "out"),
+			want:  testActionValue("a", "destroy", "out"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.state.Type() == nil {
+				tc.state = testActionValue("", "", "")
+			}
+			if tc.plan.Type() == nil {
+				tc.plan = testActionValue("", "", "")
+			}
+			runs := newActionRuns()
+			runs.now = func() time.Time { return now }
+			ic := resourceActionInterceptor(nil, &v1beta2.ResourceAction{}, tc.as, runs)
+			req := fwresource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: testActionSchema, Raw: tc.state},
+				Plan:  tfsdk.Plan{Schema: testActionSchema, Raw: tc.plan},
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			ic.ModifyPlan(context.Background(), req, resp, func(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse) {})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan: %v", resp.Diagnostics)
+			}
+			if !resp.Plan.Raw.Equal(tc.want) {
+				t.Errorf("want the plan %s, got %s", tc.want, resp.Plan.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package arm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+
+	asyncStatusSucceeded = "Succeeded"
+	asyncStatusFailed    = "Failed"
+	asyncStatusCanceled  = "Canceled"
+
+	errRunAction       = "cannot run the resource action"
+	errBuildActionURL  = "cannot build the resource action URL"
+	errPollAction      = "cannot poll the resource action"
+	errActionFailedFmt = "the resource action completed with status %s"
+)
+
+// ActionOptions configures a resource action.
+type ActionOptions struct {
+	// Method is the HTTP method of the action, POST if empty.
+	Method string
+	// Query holds the query parameters of the request. The api-version is
+	// always set.
+	Query url.Values
+	// Header holds the headers of the request.
+	Header http.Header
+	// Body is sent JSON encoded if not nil.
+	Body any
+}
+
+// Action runs the supplied action of the resource with the supplied ID,
+// e.g. listAccountSas, and returns the decoded response body, nil if the
+// response has none. An empty action targets the resource itself. Long
+// running actions are polled through their Azure-AsyncOperation or
+// Location headers until they complete.
+func (c *Client) Action(ctx context.Context, id, action, apiVersion string, o ActionOptions) (any, error) {
+	p := strings.TrimSuffix(id, "/")
+	if action != "" {
+		p += "/" + action
+	}
+	u, err := url.Parse(c.endpoint + "/" + strings.TrimPrefix(p, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, errBuildActionURL)
+	}
+	q := url.Values{}
+	for k, v := range o.Query {
+		q[k] = v
+	}
+	q.Set("api-version", apiVersion)
+	u.RawQuery = q.Encode()
+	method := o.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	resp, err := c.do(ctx, method, u.String(), o.Header, o.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, errRunAction)
+	}
+	if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
+		if resp, err = c.poll(ctx, resp, o.Header); err != nil {
+			return nil, errors.Wrap(err, errPollAction)
+		}
+	}
+	if resp == nil || len(resp.Body) == 0 {
+		return nil, nil
+	}
+	var v any
+	if err := resp.Unmarshal(&v); err != nil {
+		return nil, errors.Wrap(err, errRunAction)
+	}
+	return v, nil
+}
+
+// poll polls the long running operation started with the supplied response
+// and returns the response holding its result, nil if there's none.
+func (c *Client) poll(ctx context.Context, resp *Response, header http.Header) (*Response, error) {
+	location := resp.Header.Get("Location")
+	if op := resp.Header.Get("Azure-AsyncOperation"); op != "" {
+		for {
+			if err := wait(ctx, resp); err != nil {
+				return nil, err
+			}
+			var err error
+			if resp, err = c.do(ctx, http.MethodGet, op, header, nil); err != nil {
+				return nil, err
+			}
+			var status struct {
+				Status string `json:"status"`
+			}
+			if err := resp.Unmarshal(&status); err != nil {
+				return nil, err
+			}
+			switch status.Status {
+			case asyncStatusSucceeded:
+				if location == "" {
+					return nil, nil
+				}
+				return c.do(ctx, http.MethodGet, location, header, nil)
+			case asyncStatusFailed, asyncStatusCanceled:
+				return nil, errors.Errorf(errActionFailedFmt, status.Status)
+			}
+		}
+	}
+	if location == "" {
+		return resp, nil
+	}
+	for {
+		if err := wait(ctx, resp); err != nil {
+			return nil, err
+		}
+		var err error
+		if resp, err = c.do(ctx, http.MethodGet, location, header, nil); err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusAccepted {
+			return resp, nil
+		}
+	}
+}
+
+// wait waits for the interval given by the Retry-After header of the
+// supplied response, or the default poll interval.
+func wait(ctx context.Context, resp *Response) error {
+	d := defaultPollInterval
+	if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s >= 0 {
+		d = time.Duration(s) * time.Second
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
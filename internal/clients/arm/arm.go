@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package arm contains a minimal Azure Resource Manager REST client used by
+// the provider for the calls that are not made through the AzAPI Terraform
+// provider.
+package arm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultEndpoint is the Azure public cloud Resource Manager endpoint.
+	DefaultEndpoint = "https://management.azure.com"
+
+	scopeSuffix    = "/.default"
+	defaultTimeout = 2 * time.Minute
+
+	errNewCredential = "cannot initialize the Azure credential"
+	errGetToken      = "cannot get an access token for the Resource Manager endpoint"
+	errBuildRequest  = "cannot build the Resource Manager request"
+	errDoRequest     = "cannot perform the Resource Manager request"
+	errReadBody      = "cannot read the Resource Manager response body"
+
+	errParseCredentials      = "cannot unmarshal the credentials as JSON"
+	errUnknownEnvironmentFmt = "unknown environment %q: must be public, usgovernment or china"
+)
+
+// an environment is an Azure cloud the azapi Terraform provider can be
+// configured for.
+type environment struct {
+	endpoint string
+	cloud    cloud.Configuration
+}
+
+// environments are the Azure clouds by the names of the environment argument
+// of the azapi Terraform provider.
+var environments = map[string]environment{
+	"public":       {endpoint: DefaultEndpoint, cloud: cloud.AzurePublic},
+	"usgovernment": {endpoint: "https://management.usgovcloudapi.net", cloud: cloud.AzureGovernment},
+	"china":        {endpoint: "https://management.chinacloudapi.cn", cloud: cloud.AzureChina},
+}
+
+// Credentials holds the service principal credentials extracted from a
+// ProviderConfig. Empty fields are resolved from the environment, e.g.
+// through a workload or managed identity.
+type Credentials struct {
+	SubscriptionID string `json:"subscriptionId"`
+	TenantID       string `json:"tenantId"`
+	ClientID       string `json:"clientId"`
+	ClientSecret   string `json:"clientSecret"`
+	// Environment is the Azure cloud of the credentials, either public,
+	// usgovernment or china. Defaults to public.
+	Environment string `json:"environment"`
+}
+
+// environment returns the Azure cloud of the Credentials.
+func (c Credentials) environment() (environment, error) {
+	if c.Environment == "" {
+		return environments["public"], nil
+	}
+	e, ok := environments[strings.ToLower(c.Environment)]
+	if !ok {
+		return environment{}, errors.Errorf(errUnknownEnvironmentFmt, c.Environment)
+	}
+	return e, nil
+}
+
+// Endpoint returns the Resource Manager endpoint of the Azure cloud of the
+// Credentials.
+func (c Credentials) Endpoint() (string, error) {
+	e, err := c.environment()
+	return e.endpoint, err
+}
+
+// ParseCredentials returns the Credentials of the supplied JSON document,
+// which has the format of the credentials of a ProviderConfig.
+func ParseCredentials(data []byte) (Credentials, error) {
+	c := Credentials{}
+	return c, errors.Wrap(json.Unmarshal(data, &c), errParseCredentials)
+}
+
+// NewTokenCredential returns an azcore.TokenCredential for the supplied
+// Credentials. A client secret credential is used when a client secret
+// is configured and the default Azure credential chain otherwise.
+func NewTokenCredential(c Credentials) (azcore.TokenCredential, error) {
+	e, err := c.environment()
+	if err != nil {
+		return nil, err
+	}
+	// the tokens are requested from the authority of the Azure cloud
+	co := azcore.ClientOptions{Cloud: e.cloud}
+	if c.ClientSecret != "" {
+		cred, err := azidentity.NewClientSecretCredential(c.TenantID, c.ClientID, c.ClientSecret, &azidentity.ClientSecretCredentialOptions{ClientOptions: co})
+		return cred, errors.Wrap(err, errNewCredential)
+	}
+	opts := &azidentity.DefaultAzureCredentialOptions{ClientOptions: co, TenantID: c.TenantID}
+	cred, err := azidentity.NewDefaultAzureCredential(opts)
+	return cred, errors.Wrap(err, errNewCredential)
+}
+
+// An Option configures a Client.
+type Option func(*Client)
+
+// WithEndpoint overrides the Resource Manager endpoint of a Client, e.g.
+// for sovereign clouds or local fakes. The access tokens are requested for
+// the scope of the endpoint.
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) {
+		c.endpoint = strings.TrimSuffix(endpoint, "/")
+		c.scope = c.endpoint + scopeSuffix
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used by a Client.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.http = hc
+	}
+}
+
+// Client issues authenticated requests against Azure Resource Manager.
+type Client struct {
+	endpoint string
+	scope    string
+	cred     azcore.TokenCredential
+	http     *http.Client
+}
+
+// NewClient returns a new Client authenticating with the supplied credential.
+// A nil credential results in unauthenticated requests, which is only
+// meaningful against local fakes.
+func NewClient(cred azcore.TokenCredential, opts ...Option) *Client {
+	c := &Client{
+		endpoint: DefaultEndpoint,
+		scope:    DefaultEndpoint + scopeSuffix,
+		cred:     cred,
+		http:     &http.Client{Timeout: defaultTimeout},
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// Response is a Resource Manager response with a fully read body.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Unmarshal decodes the response body into v.
+func (r *Response) Unmarshal(v any) error {
+	return errors.Wrap(json.Unmarshal(r.Body, v), "cannot unmarshal the Resource Manager response body")
+}
+
+// An Error is returned for non-successful Resource Manager responses.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return "Resource Manager request failed with status " + http.StatusText(e.StatusCode) + ": " + e.Code + ": " + e.Message
+}
+
+// IsNotFound returns true if the supplied error reports a missing resource.
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// Do performs a request against the supplied Resource Manager path, e.g. an
+// ARM resource ID, with the given API version. A non-nil body is sent JSON
+// encoded. Responses with a status code of 400 or above are returned as
+// *Error.
+func (c *Client) Do(ctx context.Context, method, path, apiVersion string, body any) (*Response, error) {
+	u, err := url.Parse(c.endpoint + "/" + strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, errBuildRequest)
+	}
+	if apiVersion != "" {
+		q := u.Query()
+		q.Set("api-version", apiVersion)
+		u.RawQuery = q.Encode()
+	}
+	return c.DoURL(ctx, method, u.String(), body)
+}
+
+// DoURL performs a request against an absolute URL, e.g. a nextLink returned
+// by a paginated list operation.
+func (c *Client) DoURL(ctx context.Context, method, u string, body any) (*Response, error) {
+	return c.do(ctx, method, u, nil, body)
+}
+
+func (c *Client) do(ctx context.Context, method, u string, header http.Header, body any) (*Response, error) {
+	var rb io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, errBuildRequest)
+		}
+		rb = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, rb)
+	if err != nil {
+		return nil, errors.Wrap(err, errBuildRequest)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.cred != nil {
+		tok, err := c.cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{c.scope}})
+		if err != nil {
+			return nil, errors.Wrap(err, errGetToken)
+		}
+		req.Header.Set("Authorization", "Bearer "+tok.Token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, errDoRequest)
+	}
+	defer resp.Body.Close() //nolint:errcheck // nothing to do with the error
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, errReadBody)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, newError(resp.StatusCode, b)
+	}
+	return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: b}, nil
+}
+
+func newError(status int, body []byte) *Error {
+	e := &Error{StatusCode: status}
+	var eb struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &eb) == nil && eb.Error.Code != "" {
+		e.Code = eb.Error.Code
+		e.Message = eb.Error.Message
+		return e
+	}
+	e.Message = string(body)
+	return e
+}
+
+// SubscriptionID returns the subscription ID segment of the supplied ARM
+// resource ID or an empty string if the ID is not subscription scoped.
+func SubscriptionID(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return ""
+	}
+	return segments[1]
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package arm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// scopeRecorder is a token credential recording the requested scopes.
+type scopeRecorder struct {
+	scopes []string
+}
+
+func (r *scopeRecorder) GetToken(_ context.Context, o policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	r.scopes = append(r.scopes, o.Scopes...)
+	return azcore.AccessToken{Token: "token"}, nil
+}
+
+func TestScope(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer srv.Close()
+
+	cases := map[string]struct {
+		opts []Option
+		want string
+	}{
+		"Default": {
+			want: "https://management.azure.com/.default",
+		},
+		"Endpoint": {
+			opts: []Option{WithEndpoint("https://management.chinacloudapi.cn/")},
+			want: "https://management.chinacloudapi.cn/.default",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cred := &scopeRecorder{}
+			c := NewClient(cred, tc.opts...)
+			// the requests are sent to the test server regardless of the
+			// endpoint the scope is derived from
+			if _, err := c.DoURL(context.Background(), http.MethodGet, srv.URL, nil); err != nil {
+				t.Fatalf("DoURL: %v", err)
+			}
+			if len(cred.scopes) != 1 || cred.scopes[0] != tc.want {
+				t.Errorf("want the scopes [%s], got %v", tc.want, cred.scopes)
+			}
+		})
+	}
+}
+
+func TestUnauthenticated(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+	if _, err := NewClient(nil, WithEndpoint(srv.URL)).Do(context.Background(), http.MethodGet, "/", "", nil); err != nil {
+		t.Errorf("want no error without a credential, got %v", err)
+	}
+}
+
+func TestCredentialsEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		environment string
+		want        string
+		wantErr     string
+	}{
+		"Default": {
+			want: DefaultEndpoint,
+		},
+		"Public": {
+			environment: "public",
+			want:        DefaultEndpoint,
+		},
+		"USGovernment": {
+			environment: "USGovernment",
+			want:        "https://management.usgovcloudapi.net",
+		},
+		"China": {
+			environment: "china",
+			want:        "https://management.chinacloudapi.cn",
+		},
+		"Unknown": {
+			environment: "germany",
+			wantErr:     `unknown environment "germany": must be public, usgovernment or china`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := Credentials{TenantID: "tenant", ClientID: "client", ClientSecret: "secret", Environment: tc.environment}
+			got, err := c.Endpoint()
+			_, credErr := NewTokenCredential(c)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Errorf("want error %q, got %v", tc.wantErr, err)
+				}
+				if credErr == nil || credErr.Error() != tc.wantErr {
+					t.Errorf("want the credential error %q, got %v", tc.wantErr, credErr)
+				}
+				return
+			}
+			if err != nil || credErr != nil {
+				t.Fatalf("want no error, got %v and %v", err, credErr)
+			}
+			if got != tc.want {
+				t.Errorf("want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package arm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errListResources = "cannot list the resources"
+	errBuildListURL  = "cannot build the list URL"
+)
+
+// ListOptions configures a paginated list operation.
+type ListOptions struct {
+	// Query holds the query parameters of the first request. The api-version
+	// is always set.
+	Query url.Values
+	// Header holds the headers of every request.
+	Header http.Header
+	// MaxItems bounds the number of returned items, unbounded if zero.
+	MaxItems int
+}
+
+// List returns the items in the value of the pages of the paginated list
+// operation at the supplied Resource Manager path, following the nextLinks.
+// It stops once the MaxItems of the options are collected and reports
+// whether there were more items.
+func (c *Client) List(ctx context.Context, path, apiVersion string, o ListOptions) ([]any, bool, error) {
+	u, err := url.Parse(c.endpoint + "/" + strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, false, errors.Wrap(err, errBuildListURL)
+	}
+	q := url.Values{}
+	for k, v := range o.Query {
+		q[k] = v
+	}
+	q.Set("api-version", apiVersion)
+	u.RawQuery = q.Encode()
+
+	var items []any
+	next := u.String()
+	for next != "" {
+		resp, err := c.do(ctx, http.MethodGet, next, o.Header, nil)
+		if err != nil {
+			return nil, false, errors.Wrap(err, errListResources)
+		}
+		var page struct {
+			Value    []json.RawMessage `json:"value"`
+			NextLink string            `json:"nextLink"`
+		}
+		if err := resp.Unmarshal(&page); err != nil {
+			return nil, false, errors.Wrap(err, errListResources)
+		}
+		for _, raw := range page.Value {
+			if o.MaxItems > 0 && len(items) == o.MaxItems {
+				return items, true, nil
+			}
+			var item any
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, false, errors.Wrap(err, errListResources)
+			}
+			items = append(items, item)
+		}
+		next = page.NextLink
+		if o.MaxItems > 0 && len(items) == o.MaxItems && next != "" {
+			return items, true, nil
+		}
+	}
+	return items, false, nil
+}
+
+// ListPath returns the Resource Manager path listing the resources of the
+// supplied type, e.g. Microsoft.Network/virtualNetworks/subnets, under the
+// supplied parent ID.
+func ListPath(parentID, resourceType string) string {
+	parentID = strings.TrimSuffix(parentID, "/")
+	segments := strings.Split(resourceType, "/")
+	if len(segments) > 2 {
+		// child resources are listed under their parents
+		return parentID + "/" + segments[len(segments)-1]
+	}
+	if strings.EqualFold(segments[0], "Microsoft.Resources") && len(segments) == 2 {
+		switch strings.ToLower(segments[1]) {
+		case "resourcegroups", "subscriptions", "tenants":
+			return parentID + "/" + segments[1]
+		}
+	}
+	return parentID + "/providers/" + resourceType
+}
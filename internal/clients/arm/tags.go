@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package arm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	tagsAPIVersion  = "2021-04-01"
+	locksAPIVersion = "2016-09-01"
+
+	errGetTags     = "cannot get the tags"
+	errUpdateTags  = "cannot update the tags"
+	errListLocks   = "cannot list the management locks"
+	errDeleteLock  = "cannot delete the management lock"
+	errPutLock     = "cannot create or update the management lock"
+	errUnmarshalTL = "cannot unmarshal the Resource Manager response"
+)
+
+// Tags returns the tags of the resource with the supplied ID.
+func (c *Client) Tags(ctx context.Context, id string) (map[string]string, error) {
+	resp, err := c.Do(ctx, http.MethodGet, tagsPath(id), tagsAPIVersion, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetTags)
+	}
+	var body struct {
+		Properties struct {
+			Tags map[string]string `json:"tags"`
+		} `json:"properties"`
+	}
+	if err := resp.Unmarshal(&body); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalTL)
+	}
+	return body.Properties.Tags, nil
+}
+
+// MergeTags adds the supplied tags to the resource with the supplied ID,
+// replacing the values of the existing tags with the same keys.
+func (c *Client) MergeTags(ctx context.Context, id string, tags map[string]string) error {
+	return c.patchTags(ctx, id, "Merge", tags)
+}
+
+// DeleteTags removes the supplied tags from the resource with the supplied
+// ID. Only the keys of the supplied tags are considered.
+func (c *Client) DeleteTags(ctx context.Context, id string, tags map[string]string) error {
+	return c.patchTags(ctx, id, "Delete", tags)
+}
+
+func (c *Client) patchTags(ctx context.Context, id, operation string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	body := map[string]any{
+		"operation":  operation,
+		"properties": map[string]any{"tags": tags},
+	}
+	_, err := c.Do(ctx, http.MethodPatch, tagsPath(id), tagsAPIVersion, body)
+	return errors.Wrap(err, errUpdateTags)
+}
+
+func tagsPath(id string) string {
+	return strings.TrimSuffix(id, "/") + "/providers/Microsoft.Resources/tags/default"
+}
+
+// A Lock is a management lock.
+type Lock struct {
+	ID    string
+	Name  string
+	Level string
+	Notes string
+}
+
+// Locks returns the management locks applied at the scope of the resource
+// with the supplied ID, excluding the locks inherited from the parent scopes.
+func (c *Client) Locks(ctx context.Context, id string) ([]Lock, error) {
+	prefix := strings.ToLower(strings.TrimSuffix(id, "/")) + "/providers/microsoft.authorization/locks/"
+	var locks []Lock
+	resp, err := c.Do(ctx, http.MethodGet, strings.TrimSuffix(id, "/")+"/providers/Microsoft.Authorization/locks", locksAPIVersion, nil)
+	for {
+		if err != nil {
+			return nil, errors.Wrap(err, errListLocks)
+		}
+		var page struct {
+			Value []struct {
+				ID         string `json:"id"`
+				Name       string `json:"name"`
+				Properties struct {
+					Level string `json:"level"`
+					Notes string `json:"notes"`
+				} `json:"properties"`
+			} `json:"value"`
+			NextLink string `json:"nextLink"`
+		}
+		if err := resp.Unmarshal(&page); err != nil {
+			return nil, errors.Wrap(err, errUnmarshalTL)
+		}
+		for _, l := range page.Value {
+			if !strings.HasPrefix(strings.ToLower(l.ID), prefix) {
+				continue
+			}
+			locks = append(locks, Lock{ID: l.ID, Name: l.Name, Level: l.Properties.Level, Notes: l.Properties.Notes})
+		}
+		if page.NextLink == "" {
+			return locks, nil
+		}
+		resp, err = c.DoURL(ctx, http.MethodGet, page.NextLink, nil)
+	}
+}
+
+// PutLock creates or updates the management lock with the supplied name,
+// level and notes at the scope of the resource with the supplied ID, and
+// returns it.
+func (c *Client) PutLock(ctx context.Context, id, name, level, notes string) (Lock, error) {
+	lockID := strings.TrimSuffix(id, "/") + "/providers/Microsoft.Authorization/locks/" + name
+	body := map[string]any{"properties": map[string]any{"level": level, "notes": notes}}
+	if _, err := c.Do(ctx, http.MethodPut, lockID, locksAPIVersion, body); err != nil {
+		return Lock{}, errors.Wrap(err, errPutLock)
+	}
+	return Lock{ID: lockID, Name: name, Level: level, Notes: notes}, nil
+}
+
+// DeleteLock deletes the management lock with the supplied ID. Missing
+// locks are ignored.
+func (c *Client) DeleteLock(ctx context.Context, lockID string) error {
+	_, err := c.Do(ctx, http.MethodDelete, lockID, locksAPIVersion, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteLock)
+}
@@ -6,10 +6,13 @@ package clients
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/Azure/terraform-provider-azapi/xpprovider"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
 	"github.com/crossplane/upjet/v2/pkg/terraform"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +20,10 @@ import (
 
 	clusterv1beta1 "github.com/upbound/provider-azapi/v2/apis/cluster/v1beta1"
 	namespacedv1beta1 "github.com/upbound/provider-azapi/v2/apis/namespaced/v1beta1"
+	"github.com/upbound/provider-azapi/v2/config/common"
+	"github.com/upbound/provider-azapi/v2/config/typed"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+	"github.com/upbound/provider-azapi/v2/internal/clients/resourcegraph"
 )
 
 const (
@@ -30,18 +37,61 @@ const (
 	keyClientID                = "clientId"
 	keyClientSecret            = "clientSecret"
 	keyTenantID                = "tenantId"
+	keyEnvironment             = "environment"
 	keyTerraformSubscriptionID = "subscription_id"
 	keyTerraformClientID       = "client_id"
 	keyTerraformClientSecret   = "client_secret"
 	keyTerraformTenantID       = "tenant_id"
+	keyTerraformEnvironment    = "environment"
+
+	resourceTypeAzAPIResource = "azapi_resource"
 )
 
+type setupConfig struct {
+	resourceGraphCache *resourcegraph.Cache
+	actionRuns         *actionRuns
+	clusterID          string
+}
+
+// A SetupOption configures the terraform.SetupFn built by
+// TerraformSetupBuilder.
+type SetupOption func(*setupConfig)
+
+// WithResourceGraphCache enables the batch observation of azapi_resource
+// instances through the supplied Azure Resource Graph cache. The
+// subscriptions of the observed resources are tracked by the cache as they
+// are connected to.
+func WithResourceGraphCache(c *resourcegraph.Cache) SetupOption {
+	return func(sc *setupConfig) {
+		sc.resourceGraphCache = c
+	}
+}
+
+// WithOwnership enables the ownership tagging of the azapi_resource
+// instances with the supplied cluster ID and the UIDs of their managed
+// resources. The managed resources refuse to manage the Azure resources
+// owned by other managed resources unless they have the takeover
+// annotation.
+func WithOwnership(clusterID string) SetupOption {
+	return func(sc *setupConfig) {
+		sc.clusterID = clusterID
+	}
+}
+
 // TerraformSetupBuilder builds Terraform a terraform.SetupFn function which
 // returns Terraform provider setup configuration
-func TerraformSetupBuilder() terraform.SetupFn {
+func TerraformSetupBuilder(opts ...SetupOption) terraform.SetupFn {
+	cfg := &setupConfig{actionRuns: newActionRuns()}
+	for _, o := range opts {
+		o(cfg)
+	}
 	return func(ctx context.Context, client client.Client, mgx resource.Managed) (terraform.Setup, error) {
 		ps := terraform.Setup{}
 
+		if err := checkConvertedJSON(mgx); err != nil {
+			return terraform.Setup{}, err
+		}
+
 		pcSpec, err := resolveProviderConfig(ctx, client, mgx)
 		if err != nil {
 			return terraform.Setup{}, err
@@ -70,15 +120,194 @@ func TerraformSetupBuilder() terraform.SetupFn {
 		if v, ok := creds[keyTenantID]; ok {
 			ps.Configuration[keyTerraformTenantID] = v
 		}
-		ps.FrameworkProvider, err = xpprovider.FrameworkProvider(ctx)
+		if v, ok := creds[keyEnvironment]; ok {
+			ps.Configuration[keyTerraformEnvironment] = v
+		}
+		fwProvider, err := xpprovider.FrameworkProvider(ctx)
 		if err != nil {
 			return terraform.Setup{}, errors.Wrap(err, "error initializing the framework provider")
 		}
+		kinds, err := typed.Kinds()
+		if err != nil {
+			return terraform.Setup{}, err
+		}
+		// the typed kinds are reconciled like the azapi_resources and the
+		// key actions like the azapi_resource_actions
+		resourceType := terraformResourceType(mgx)
+		if _, ok := typedKind(resourceType); ok {
+			resourceType = resourceTypeAzAPIResource
+		}
+		if t, ok := common.KeyActions[resourceType]; ok {
+			resourceType = t
+		}
+		newARMClient := func() (*arm.Client, error) {
+			return armClient(armCredentials(creds))
+		}
+		interceptors := map[string][]Interceptor{}
+		if templatedResourceTypes[terraformResourceType(mgx)] {
+			ic, err := bodyTemplatingInterceptor(mgx, pcSpec)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			if ic != nil {
+				interceptors[resourceType] = append(interceptors[resourceType], *ic)
+			}
+		}
+		if resourceType == resourceTypeAzAPIResource || resourceType == resourceTypeAzAPIUpdateResource {
+			ic, err := tagPropagationInterceptor(client, mgx, pcSpec)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			if ic != nil {
+				interceptors[resourceType] = append(interceptors[resourceType], *ic)
+			}
+		}
+		if cfg.clusterID != "" && resourceType == resourceTypeAzAPIResource {
+			// added after the tag propagation, which keeps the tags set
+			// outside of Crossplane only if no tags are configured
+			ic, err := ownershipInterceptor(ctx, mgx, cfg.clusterID, newARMClient)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			if ic != nil {
+				interceptors[resourceType] = append(interceptors[resourceType], *ic)
+			}
+		}
+		if resourceType == resourceTypeAzAPIResource {
+			ic, err := azureLockInterceptor(ctx, mgx, newARMClient)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			if ic != nil {
+				interceptors[resourceType] = append(interceptors[resourceType], *ic)
+			}
+		}
+		if cfg.resourceGraphCache != nil {
+			if err := trackSubscription(cfg.resourceGraphCache, armCredentials(creds)); err != nil {
+				return terraform.Setup{}, err
+			}
+			interceptors[resourceTypeAzAPIResource] = append(interceptors[resourceTypeAzAPIResource], batchObserveInterceptor(cfg.resourceGraphCache))
+		}
+		if resourceType == resourceTypeAzAPIResourceAction {
+			as, err := observeResourceAction(ctx, client, mgx, cfg.actionRuns)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			interceptors[resourceTypeAzAPIResourceAction] = append(interceptors[resourceTypeAzAPIResourceAction], resourceActionInterceptor(client, mgx, as, cfg.actionRuns))
+		}
+		// the Azure resources owned by other managed resources are left as
+		// they are
+		ownedByOther := cfg.clusterID != "" && mgx.GetCondition(TypeOwned).Reason == ReasonOwnedByOther
+		if t := resourceType; (t == resourceTypeAzAPIResource || t == resourceTypeAzAPIDataPlaneResource) && mgx.GetDeletionTimestamp() != nil && !ownedByOther {
+			ic, err := deletionInterceptor(ctx, mgx, newARMClient)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			if ic != nil {
+				// takes precedence over the other interceptors, e.g. the
+				// batch observation, which may skip the reads
+				interceptors[t] = append([]Interceptor{*ic}, interceptors[t]...)
+			}
+		}
+		if resourceType == common.ResourceListDataTypeName {
+			ic, err := resourceListInterceptor(client, mgx, newARMClient)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			interceptors[resourceType] = append(interceptors[resourceType], ic)
+		}
+		if resourceType == common.EphemeralResourceActionTypeName {
+			ic, err := ephemeralInterceptor(client, mgx, newARMClient)
+			if err != nil {
+				return terraform.Setup{}, err
+			}
+			interceptors[resourceType] = append(interceptors[resourceType], ic)
+		}
+		if t := terraformResourceType(mgx); t != resourceType {
+			interceptors[t] = interceptors[resourceType]
+		}
+		// the data kinds are served by the data sources they read
+		fp := common.DataSourceProvider(common.AliasProvider(typed.Provider(fwProvider, kinds), common.Aliases()), common.DataSources)
+		ps.FrameworkProvider = interceptProvider(fp, interceptors)
 		return ps, nil
 	}
 }
 
-func legacyToModernProviderConfigSpec(pc *clusterv1beta1.ProviderConfig) (*namespacedv1beta1.ProviderConfigSpec, error) {
+func terraformResourceType(mg resource.Managed) string {
+	if tr, ok := mg.(ujresource.Terraformed); ok {
+		return tr.GetTerraformResourceType()
+	}
+	return ""
+}
+
+// typedKind returns the typed kind reconciled with the supplied Terraform
+// resource type, if any.
+func typedKind(t string) (typed.Kind, bool) {
+	kinds, err := typed.Kinds()
+	if err != nil {
+		return typed.Kind{}, false
+	}
+	for _, k := range kinds {
+		if k.TerraformName == t {
+			return k, true
+		}
+	}
+	return typed.Kind{}, false
+}
+
+func armCredentials(creds map[string]string) arm.Credentials {
+	return arm.Credentials{
+		SubscriptionID: creds[keySubscriptionID],
+		TenantID:       creds[keyTenantID],
+		ClientID:       creds[keyClientID],
+		ClientSecret:   creds[keyClientSecret],
+		Environment:    creds[keyEnvironment],
+	}
+}
+
+// armClient returns a Resource Manager client for the Azure cloud of the
+// supplied credentials.
+func armClient(creds arm.Credentials) (*arm.Client, error) {
+	cred, err := arm.NewTokenCredential(creds)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := creds.Endpoint()
+	return arm.NewClient(cred, arm.WithEndpoint(endpoint)), err
+}
+
+// trackSubscription registers the subscription of the supplied credentials
+// with the Resource Graph cache, at the Resource Manager endpoint of their
+// Azure cloud, if it's not already tracked with them.
+func trackSubscription(c *resourcegraph.Cache, creds arm.Credentials) error {
+	identity := credentialIdentity(creds)
+	if creds.SubscriptionID == "" || c.Tracked(creds.SubscriptionID, identity) {
+		return nil
+	}
+	cred, err := arm.NewTokenCredential(creds)
+	if err != nil {
+		return err
+	}
+	endpoint, err := creds.Endpoint()
+	if err != nil {
+		return err
+	}
+	c.Track(creds.SubscriptionID, identity, endpoint, cred)
+	return nil
+}
+
+// credentialIdentity returns a digest identifying the supplied credentials,
+// which changes when the secret or the Azure cloud of the credentials
+// changes.
+func credentialIdentity(creds arm.Credentials) string {
+	sum := sha256.Sum256([]byte(creds.TenantID + "\x00" + creds.ClientID + "\x00" + creds.ClientSecret + "\x00" + creds.Environment))
+	return hex.EncodeToString(sum[:])
+}
+
+// LegacyToModernProviderConfigSpec converts the spec of the supplied legacy
+// ProviderConfig into the spec of the ProviderConfigs and
+// ClusterProviderConfigs of the namespaced API group.
+func LegacyToModernProviderConfigSpec(pc *clusterv1beta1.ProviderConfig) (*namespacedv1beta1.ProviderConfigSpec, error) {
 	if pc == nil {
 		return nil, nil
 	}
@@ -124,7 +353,7 @@ func resolveProviderConfigLegacy(ctx context.Context, client client.Client, mg r
 		return nil, errors.Wrap(err, errTrackUsage)
 	}
 
-	return legacyToModernProviderConfigSpec(pc)
+	return LegacyToModernProviderConfigSpec(pc)
 }
 
 func resolveProviderConfigModern(ctx context.Context, crClient client.Client, mg resource.ModernManaged) (*namespacedv1beta1.ProviderConfigSpec, error) {
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/upbound/provider-azapi/v2/internal/clients/resourcegraph"
+)
+
+// batchObserveInterceptor short-circuits the reads of the resources which the
+// supplied Resource Graph cache reports as unchanged since their last
+// individual read. The prior Terraform state is kept as the observed state
+// in that case. Writes drop the recorded observation so that the next read
+// after a write is always an individual one.
+func batchObserveInterceptor(c *resourcegraph.Cache) Interceptor {
+	return Interceptor{
+		Read: func(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse, next func(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse)) {
+			id := stateID(ctx, req.State)
+			if id != "" && c.Unchanged(id) {
+				return
+			}
+			next(ctx, req, resp)
+			if id != "" && !resp.Diagnostics.HasError() {
+				c.Observed(id)
+			}
+		},
+		Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, next func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+			next(ctx, req, resp)
+			c.Forget(stateID(ctx, resp.State))
+		},
+		Update: func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, next func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse)) {
+			next(ctx, req, resp)
+			c.Forget(stateID(ctx, req.State))
+		},
+		Delete: func(ctx context.Context, req fwresource.DeleteRequest, resp *fwresource.DeleteResponse, next func(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse)) {
+			next(ctx, req, resp)
+			c.Forget(stateID(ctx, req.State))
+		},
+	}
+}
+
+// stateID returns the ARM ID stored in the supplied Terraform state or an
+// empty string if it's not available.
+func stateID(ctx context.Context, s tfsdk.State) string {
+	if s.Raw.IsNull() {
+		return ""
+	}
+	var id types.String
+	if diags := s.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		return ""
+	}
+	return id.ValueString()
+}
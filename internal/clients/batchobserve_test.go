@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+	"github.com/upbound/provider-azapi/v2/internal/clients/resourcegraph"
+)
+
+const (
+	testSubscriptionID = "00000000-0000-0000-0000-000000000000"
+	testID             = "/subscriptions/" + testSubscriptionID + "/resourceGroups/rg"
+)
+
+var testSchema = schema.Schema{Attributes: map[string]schema.Attribute{
+	"id": schema.StringAttribute{Computed: true},
+}}
+
+func testState(id string) tfsdk.State {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	return tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(typ, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, id)})}
+}
+
+// fakeResourceGraph serves a single page with the resource of testID and
+// the current etag.
+type fakeResourceGraph struct {
+	mu   sync.Mutex
+	etag string
+}
+
+func (f *fakeResourceGraph) setETag(etag string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.etag = etag
+}
+
+func (f *fakeResourceGraph) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{{"id": testID, "etag": f.etag}}})
+}
+
+func TestBatchObserveInterceptor(t *testing.T) {
+	f := &fakeResourceGraph{etag: "1"}
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+	c := resourcegraph.NewCache(resourcegraph.WithEndpoint(srv.URL), resourcegraph.WithCredential(nil))
+	c.Track(testSubscriptionID, "identity", "", nil)
+	ic := batchObserveInterceptor(c)
+
+	reads := 0
+	read := func() {
+		req := fwresource.ReadRequest{State: testState(testID)}
+		resp := &fwresource.ReadResponse{State: testState(testID)}
+		ic.Read(context.Background(), req, resp, func(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse) {
+			reads++
+		})
+	}
+	update := func() {
+		req := fwresource.UpdateRequest{State: testState(testID)}
+		ic.Update(context.Background(), req, &fwresource.UpdateResponse{}, func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse) {})
+	}
+
+	steps := []struct {
+		name   string
+		action func()
+		// the number of individual reads expected after the action
+		want int
+	}{
+		{name: "NoSnapshot", action: read, want: 1},
+		{name: "NotObservedInSnapshot", action: func() { c.Refresh(context.Background()); read() }, want: 2},
+		{name: "Unchanged", action: read, want: 2},
+		{name: "UnchangedAfterRefresh", action: func() { c.Refresh(context.Background()); read() }, want: 2},
+		{name: "Changed", action: func() { f.setETag("2"); c.Refresh(context.Background()); read() }, want: 3},
+		{name: "UnchangedAfterChange", action: read, want: 3},
+		{name: "Written", action: func() { update(); read() }, want: 4},
+		{name: "UnchangedAfterWrite", action: read, want: 4},
+	}
+	for _, s := range steps {
+		s.action()
+		if reads != s.want {
+			t.Fatalf("%s: want %d individual reads, got %d", s.name, s.want, reads)
+		}
+	}
+}
+
+func TestTrackSubscription(t *testing.T) {
+	c := resourcegraph.NewCache()
+	creds := arm.Credentials{SubscriptionID: testSubscriptionID, TenantID: "tenant", ClientID: "client", ClientSecret: "secret"}
+	if err := trackSubscription(c, creds); err != nil {
+		t.Fatalf("trackSubscription: %v", err)
+	}
+	if !c.Tracked(testSubscriptionID, credentialIdentity(creds)) {
+		t.Fatal("want the subscription tracked")
+	}
+	rotated := creds
+	rotated.ClientSecret = "rotated"
+	if c.Tracked(testSubscriptionID, credentialIdentity(rotated)) {
+		t.Fatal("want the subscription not tracked with the rotated secret")
+	}
+	if err := trackSubscription(c, rotated); err != nil {
+		t.Fatalf("trackSubscription: %v", err)
+	}
+	if !c.Tracked(testSubscriptionID, credentialIdentity(rotated)) {
+		t.Error("want the subscription tracked with the rotated secret")
+	}
+	sovereign := rotated
+	sovereign.Environment = "usgovernment"
+	if c.Tracked(testSubscriptionID, credentialIdentity(sovereign)) {
+		t.Fatal("want the subscription not tracked in the other environment")
+	}
+	if err := trackSubscription(c, sovereign); err != nil {
+		t.Fatalf("trackSubscription: %v", err)
+	}
+	if !c.Tracked(testSubscriptionID, credentialIdentity(sovereign)) {
+		t.Error("want the subscription tracked in the other environment")
+	}
+	unknown := sovereign
+	unknown.Environment = "germany"
+	if err := trackSubscription(c, unknown); err == nil {
+		t.Error("want an error for an unknown environment, got none")
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/upbound/provider-azapi/v2/config/cluster/resources"
+)
+
+const (
+	errUnconvertedJSONFmt = "the v1beta1 fields %s are not valid JSON and are only preserved in the %s<field> annotations: set them before the resource is reconciled"
+	errConvertToMap       = "cannot convert the managed resource to check its converted fields"
+)
+
+// checkConvertedJSON returns an error if a spec field of the supplied managed
+// resource could not be converted from v1beta1 because it was not valid
+// JSON, and has not been set since, so that the resource is never observed
+// or updated with the field missing. The resources being deleted are not
+// checked, as their deletion doesn't depend on the field.
+func checkConvertedJSON(mg resource.Managed) error {
+	if mg.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	var paths []string
+	for k := range mg.GetAnnotations() {
+		if p, ok := strings.CutPrefix(k, resources.AnnotationKeyOriginalPrefix); ok && strings.HasPrefix(p, "spec.") {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return errors.Wrap(err, errConvertToMap)
+	}
+	p := fieldpath.Pave(u)
+	var missing []string
+	for _, path := range paths {
+		if v, err := p.GetValue(path); err != nil || v == nil {
+			missing = append(missing, path)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return errors.Errorf(errUnconvertedJSONFmt, strings.Join(missing, ", "), resources.AnnotationKeyOriginalPrefix)
+}
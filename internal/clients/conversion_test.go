@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"testing"
+	"time"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	"github.com/upbound/provider-azapi/v2/config/cluster/resources"
+)
+
+func TestCheckConvertedJSON(t *testing.T) {
+	const body = "spec.forProvider.body"
+	cases := map[string]struct {
+		annotations map[string]string
+		body        *apiextv1.JSON
+		deleting    bool
+		wantErr     string
+	}{
+		"NoAnnotation": {},
+		"Missing": {
+			annotations: map[string]string{resources.AnnotationKeyOriginalPrefix + body: "{", resources.AnnotationKeyOriginalPrefix + "status.atProvider.output": "{"},
+			wantErr:     "the v1beta1 fields spec.forProvider.body are not valid JSON and are only preserved in the azapi.upbound.io/original-<field> annotations: set them before the resource is reconciled",
+		},
+		"SetSince": {
+			annotations: map[string]string{resources.AnnotationKeyOriginalPrefix + body: "{"},
+			body:        &apiextv1.JSON{Raw: []byte(`{"properties": {}}`)},
+		},
+		"Deleting": {
+			annotations: map[string]string{resources.AnnotationKeyOriginalPrefix + body: "{"},
+			deleting:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &v1beta2.Resource{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			mg.Spec.ForProvider.Body = tc.body
+			if tc.deleting {
+				mg.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			}
+			err := checkConvertedJSON(mg)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("want no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("want error %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
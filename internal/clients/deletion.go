@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+const (
+	resourceTypeAzAPIDataPlaneResource = "azapi_data_plane_resource"
+
+	// DeletionModeDelete deletes the external resource.
+	DeletionModeDelete = "Delete"
+	// DeletionModeNoPurge enables the purge protection of the external
+	// resource, if its type supports it, before deleting it.
+	DeletionModeNoPurge = "NoPurge"
+	// DeletionModeDetachOnly leaves the external resource in place and
+	// removes the tags and the locks managed by the provider.
+	DeletionModeDetachOnly = "DetachOnly"
+	// DeletionModeDeleteWithRetention deletes the external resource after
+	// the retention period.
+	DeletionModeDeleteWithRetention = "DeleteWithRetention"
+
+	// DeletionStatePendingDelete is the deletion state of a resource whose
+	// deletion is held back until the end of its retention period.
+	DeletionStatePendingDelete = "PendingDelete"
+
+	// ManagedTagPrefix prefixes the keys of the Azure tags managed by the
+	// provider.
+	ManagedTagPrefix = "crossplane-"
+	// ManagedLockPrefix prefixes the names of the management locks managed
+	// by the provider.
+	ManagedLockPrefix = "crossplane-"
+
+	keyDeletionMode          = "deletion_mode"
+	keyDeletionRetentionDays = "deletion_retention_days"
+	keyDeletionState         = "deletion_state"
+	keyDeletionScheduledTime = "deletion_scheduled_time"
+
+	defaultDeletionRetentionDays = 7
+
+	errInvalidDeletionMode  = "deletionMode must be one of Delete, NoPurge, DetachOnly or DeleteWithRetention"
+	errInvalidRetentionDays = "deletionRetentionDays must not be negative"
+	errGetDeletionParams    = "cannot get the deletion policy parameters"
+	errSetDeletionStatus    = "cannot set the deletion state in status"
+	errEnablePurgeProtect   = "cannot enable the purge protection before deletion"
+	errDetach               = "cannot detach the resource"
+)
+
+// purgeProtected lists the lowercase resource types whose purge protection
+// can be enabled with the properties.enablePurgeProtection property.
+var purgeProtected = map[string]bool{
+	"microsoft.keyvault/vaults":                      true,
+	"microsoft.keyvault/managedhsms":                 true,
+	"microsoft.appconfiguration/configurationstores": true,
+}
+
+// deletionPolicy is the deletion policy of a resource resolved from the
+// managed resource.
+type deletionPolicy struct {
+	mode      string
+	retention time.Duration
+	// resource type and API version of an azapi_resource
+	resourceType string
+	apiVersion   string
+	id           string
+}
+
+func resolveDeletionPolicy(mg resource.Managed) (deletionPolicy, error) {
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return deletionPolicy{}, errors.New(errNotTerraformedKind)
+	}
+	params, err := tr.GetParameters()
+	if err != nil {
+		return deletionPolicy{}, errors.Wrap(err, errGetDeletionParams)
+	}
+	dp := deletionPolicy{mode: DeletionModeDelete, retention: defaultDeletionRetentionDays * 24 * time.Hour}
+	if m, _ := params[keyDeletionMode].(string); m != "" {
+		dp.mode = m
+	}
+	switch dp.mode {
+	case DeletionModeDelete, DeletionModeNoPurge, DeletionModeDetachOnly, DeletionModeDeleteWithRetention:
+	default:
+		return deletionPolicy{}, errors.New(errInvalidDeletionMode)
+	}
+	if d, ok := params[keyDeletionRetentionDays].(float64); ok {
+		if d < 0 {
+			return deletionPolicy{}, errors.New(errInvalidRetentionDays)
+		}
+		dp.retention = time.Duration(d) * 24 * time.Hour
+	}
+	if t, _ := params["type"].(string); t != "" {
+		dp.resourceType, dp.apiVersion, _ = strings.Cut(t, "@")
+	}
+	if k, ok := typedKind(tr.GetTerraformResourceType()); ok {
+		dp.resourceType, dp.apiVersion = k.Type, k.APIVersion
+	}
+	obs, err := tr.GetObservation()
+	if err != nil {
+		return deletionPolicy{}, errors.Wrap(err, errGetDeletionParams)
+	}
+	dp.id, _ = obs["id"].(string)
+	return dp, nil
+}
+
+// deletionInterceptor applies the deletion policy of the supplied managed
+// resource, which is being deleted, and returns the interceptor holding back
+// or replacing its deletion, if any. The ARM client is only used for
+// azapi_resource instances, which are ARM resources, and only if the managed
+// resource manages its external resource, i.e. it doesn't orphan it and its
+// management policies allow creating or updating it. An external resource
+// that is already gone is left as it is.
+func deletionInterceptor(ctx context.Context, mg resource.Managed, newARMClient func() (*arm.Client, error)) (*Interceptor, error) {
+	dp, err := resolveDeletionPolicy(mg)
+	if err != nil {
+		return nil, err
+	}
+	_, typedResource := typedKind(terraformResourceType(mg))
+	armResource := (terraformResourceType(mg) == resourceTypeAzAPIResource || typedResource) && dp.id != ""
+	writable := createsOrUpdates(mg) && !orphaned(mg)
+	switch dp.mode {
+	case DeletionModeNoPurge:
+		if !armResource || !writable || !purgeProtected[strings.ToLower(dp.resourceType)] {
+			return nil, nil
+		}
+		c, err := newARMClient()
+		if err != nil {
+			return nil, err
+		}
+		body := map[string]any{"properties": map[string]any{"enablePurgeProtection": true}}
+		if _, err := c.Do(ctx, http.MethodPatch, dp.id, dp.apiVersion, body); err != nil && !arm.IsNotFound(err) {
+			return nil, errors.Wrap(err, errEnablePurgeProtect)
+		}
+		return nil, nil
+	case DeletionModeDetachOnly:
+		if armResource && writable {
+			c, err := newARMClient()
+			if err != nil {
+				return nil, err
+			}
+			if err := detach(ctx, c, dp.id); err != nil && !arm.IsNotFound(err) {
+				return nil, errors.Wrap(err, errDetach)
+			}
+		}
+		// report the resource as gone so that the managed resource is
+		// deleted without deleting the external resource
+		return &Interceptor{
+			Read: func(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse, _ func(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse)) {
+				resp.State.RemoveResource(ctx)
+			},
+		}, nil
+	case DeletionModeDeleteWithRetention:
+		scheduled := mg.GetDeletionTimestamp().Add(dp.retention).UTC()
+		pending := time.Now().Before(scheduled)
+		status := map[string]any{
+			keyDeletionScheduledTime: scheduled.Format(time.RFC3339),
+			keyDeletionState:         nil,
+		}
+		if pending {
+			status[keyDeletionState] = DeletionStatePendingDelete
+		}
+		if err := mg.(ujresource.Terraformed).SetObservation(status); err != nil { //nolint:forcetypeassert // checked while resolving the policy
+			return nil, errors.Wrap(err, errSetDeletionStatus)
+		}
+		if !pending {
+			return nil, nil
+		}
+		return &Interceptor{
+			Delete: func(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse, func(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse)) {
+				// the deletion is requested again at each reconciliation
+				// until the end of the retention period
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+// orphaned returns whether the deletion policy of the supplied managed
+// resource, if it has one, orphans its external resource.
+func orphaned(mg resource.Managed) bool {
+	o, ok := mg.(resource.Orphanable)
+	return ok && o.GetDeletionPolicy() == xpv1.DeletionOrphan
+}
+
+// detach removes the tags and the locks managed by the provider from the
+// ARM resource with the supplied ID.
+func detach(ctx context.Context, c *arm.Client, id string) error {
+	locks, err := c.Locks(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, l := range locks {
+		if !strings.HasPrefix(l.Name, ManagedLockPrefix) {
+			continue
+		}
+		if err := c.DeleteLock(ctx, l.ID); err != nil {
+			return err
+		}
+	}
+	tags, err := c.Tags(ctx, id)
+	if err != nil {
+		return err
+	}
+	managed := map[string]string{}
+	for k, v := range tags {
+		if strings.HasPrefix(k, ManagedTagPrefix) {
+			managed[k] = v
+		}
+	}
+	return c.DeleteTags(ctx, id, managed)
+}
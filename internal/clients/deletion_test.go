@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+func TestDeletionInterceptor(t *testing.T) {
+	const (
+		vault  = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv"
+		locks  = vault + "/providers/Microsoft.Authorization/locks"
+		tags   = vault + "/providers/Microsoft.Resources/tags/default"
+		lockID = locks + "/crossplane-lock"
+	)
+	cases := map[string]struct {
+		mode           string
+		retentionDays  int64
+		deletedAgo     time.Duration
+		policies       xpv1.ManagementPolicies
+		deletionPolicy xpv1.DeletionPolicy
+		gone           bool
+		wantCalls      []string
+		wantRead       bool
+		wantDelete     bool
+		wantState      *string
+	}{
+		"Delete": {
+			mode: DeletionModeDelete,
+		},
+		"NoPurge": {
+			mode:      DeletionModeNoPurge,
+			wantCalls: []string{"PATCH " + vault},
+		},
+		"NoPurgeGone": {
+			mode:      DeletionModeNoPurge,
+			gone:      true,
+			wantCalls: []string{"PATCH " + vault},
+		},
+		"NoPurgeOrphan": {
+			mode:           DeletionModeNoPurge,
+			deletionPolicy: xpv1.DeletionOrphan,
+		},
+		"NoPurgeObserveOnly": {
+			mode:     DeletionModeNoPurge,
+			policies: xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionDelete},
+		},
+		"DetachOnly": {
+			mode:      DeletionModeDetachOnly,
+			wantCalls: []string{"GET " + locks, "DELETE " + lockID, "GET " + tags, "PATCH " + tags},
+			wantRead:  true,
+		},
+		"DetachOnlyGone": {
+			mode:      DeletionModeDetachOnly,
+			gone:      true,
+			wantCalls: []string{"GET " + locks},
+			wantRead:  true,
+		},
+		"DetachOnlyOrphan": {
+			mode:           DeletionModeDetachOnly,
+			deletionPolicy: xpv1.DeletionOrphan,
+			wantRead:       true,
+		},
+		"DetachOnlyObserveOnly": {
+			mode:     DeletionModeDetachOnly,
+			policies: xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+			wantRead: true,
+		},
+		"RetentionPending": {
+			mode:          DeletionModeDeleteWithRetention,
+			retentionDays: 1,
+			deletedAgo:    time.Hour,
+			wantDelete:    true,
+			wantState:     ptr(DeletionStatePendingDelete),
+		},
+		"RetentionExpired": {
+			mode:          DeletionModeDeleteWithRetention,
+			retentionDays: 1,
+			deletedAgo:    25 * time.Hour,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				switch {
+				case tc.gone:
+					w.WriteHeader(http.StatusNotFound)
+				case r.Method == http.MethodGet && r.URL.Path == locks:
+					_, _ = w.Write([]byte(`{"value": [
+						{"id": "` + lockID + `", "name": "crossplane-lock", "properties": {"level": "CanNotDelete"}},
+						{"id": "` + locks + `/user", "name": "user", "properties": {"level": "CanNotDelete"}}
+					]}`))
+				case r.Method == http.MethodGet && r.URL.Path == tags:
+					_, _ = w.Write([]byte(`{"properties": {"tags": {"crossplane-name": "kv", "env": "dev"}}}`))
+				}
+			}))
+			defer srv.Close()
+
+			mg := &v1beta2.Resource{}
+			mg.Spec.ForProvider.Type = ptr("Microsoft.KeyVault/vaults@2023-07-01")
+			mg.Spec.ForProvider.DeletionMode = ptr(tc.mode)
+			if tc.retentionDays != 0 {
+				mg.Spec.ForProvider.DeletionRetentionDays = ptr(tc.retentionDays)
+			}
+			mg.Status.AtProvider.ID = ptr(vault)
+			mg.SetManagementPolicies(tc.policies)
+			mg.SetDeletionPolicy(tc.deletionPolicy)
+			mg.SetDeletionTimestamp(&metav1.Time{Time: time.Now().Add(-tc.deletedAgo)})
+			newARMClient := func() (*arm.Client, error) {
+				return arm.NewClient(nil, arm.WithEndpoint(srv.URL)), nil
+			}
+			ic, err := deletionInterceptor(context.Background(), mg, newARMClient)
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.wantCalls, calls) {
+				t.Errorf("want the calls %q, got %q", tc.wantCalls, calls)
+			}
+			if gotRead := ic != nil && ic.Read != nil; gotRead != tc.wantRead {
+				t.Errorf("want a Read interceptor %t, got %t", tc.wantRead, gotRead)
+			}
+			if gotDelete := ic != nil && ic.Delete != nil; gotDelete != tc.wantDelete {
+				t.Errorf("want a Delete interceptor %t, got %t", tc.wantDelete, gotDelete)
+			}
+			if !reflect.DeepEqual(tc.wantState, mg.Status.AtProvider.DeletionState) {
+				t.Errorf("want the deletion state %v, got %v", tc.wantState, mg.Status.AtProvider.DeletionState)
+			}
+		})
+	}
+}
@@ -0,0 +1,459 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/provider-azapi/v2/config/common"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+const (
+	// AnnotationKeyIssuedAt is the annotation of the Secrets of the
+	// ephemeral kinds holding the time their values were issued.
+	AnnotationKeyIssuedAt = "azapi.upbound.io/issued-at"
+	// AnnotationKeyExpiresAt is the annotation of the Secrets of the
+	// ephemeral kinds holding the time their values expire.
+	AnnotationKeyExpiresAt = "azapi.upbound.io/expires-at"
+	// AnnotationKeyEphemeralKeys is the annotation of the Secrets of the
+	// ephemeral kinds holding the comma separated keys of their values.
+	AnnotationKeyEphemeralKeys = "azapi.upbound.io/ephemeral-keys"
+
+	keySecretRef     = "secret_ref"
+	keyTTL           = "ttl"
+	keyRefreshBefore = "refresh_before"
+	keyIssuedAt      = "issued_at"
+	keyExpiresAt     = "expires_at"
+	keyRefreshTime   = "refresh_time"
+	keySecretKeys    = "secret_keys"
+
+	defaultEphemeralTTL  = time.Hour
+	defaultRefreshBefore = 15 * time.Minute
+	minEphemeralTTL      = 5 * time.Minute
+
+	placeholderIssuedAt  = "${issuedAt}"
+	placeholderExpiresAt = "${expiresAt}"
+
+	// ephemeralOutputKey is the Secret key of an exported value that is not
+	// an object.
+	ephemeralOutputKey = "output"
+
+	errInvalidSecretRef     = "secretRef must have a name and a namespace"
+	errInvalidTTL           = "ttl must be a duration of at least 5m"
+	errInvalidRefreshBefore = "refreshBefore must be a non-negative duration shorter than the ttl"
+	errGetEphemeralParams   = "cannot get the ephemeral resource parameters"
+	errSetEphemeralStatus   = "cannot set the ephemeral resource status"
+	errGetEphemeralSecret   = "cannot get the Secret of the ephemeral resource"
+	errApplyEphemeralSecret = "cannot write the values into the Secret of the ephemeral resource"
+	errEphemeralSecretOwner = "the Secret of the ephemeral resource is controlled by another object"
+	errEphemeralState       = "cannot set the ephemeral resource state"
+	errNoEphemeralValues    = "the response of the action has no values to write into the Secret"
+)
+
+// ephemeral is the configuration of an ephemeral resource resolved from the
+// managed resource.
+type ephemeral struct {
+	ttl           time.Duration
+	refreshBefore time.Duration
+	secret        client.ObjectKey
+}
+
+func resolveEphemeral(mg resource.Managed) (ephemeral, error) { //nolint:gocyclo // a flat list of validations
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return ephemeral{}, errors.New(errNotTerraformedKind)
+	}
+	params, err := tr.GetParameters()
+	if err != nil {
+		return ephemeral{}, errors.Wrap(err, errGetEphemeralParams)
+	}
+	e := ephemeral{ttl: defaultEphemeralTTL, refreshBefore: defaultRefreshBefore}
+	if s, _ := params[keyTTL].(string); s != "" {
+		if e.ttl, err = time.ParseDuration(s); err != nil {
+			return ephemeral{}, errors.Wrap(err, errInvalidTTL)
+		}
+	}
+	if e.ttl < minEphemeralTTL {
+		return ephemeral{}, errors.New(errInvalidTTL)
+	}
+	if s, _ := params[keyRefreshBefore].(string); s != "" {
+		if e.refreshBefore, err = time.ParseDuration(s); err != nil {
+			return ephemeral{}, errors.Wrap(err, errInvalidRefreshBefore)
+		}
+	}
+	if e.refreshBefore < 0 || e.refreshBefore >= e.ttl {
+		return ephemeral{}, errors.New(errInvalidRefreshBefore)
+	}
+
+	p, _ := params[keySecretRef].(map[string]any)
+	if l, isList := params[keySecretRef].([]any); isList && len(l) == 1 {
+		// singleton lists are converted to embedded objects in the CRDs
+		// but may still be supplied in the Terraform shape
+		p, _ = l[0].(map[string]any)
+	}
+	e.secret.Namespace = mg.GetNamespace()
+	e.secret.Name, _ = p["name"].(string)
+	if ns, _ := p["namespace"].(string); ns != "" && e.secret.Namespace == "" {
+		e.secret.Namespace = ns
+	}
+	if e.secret.Name == "" || e.secret.Namespace == "" {
+		return ephemeral{}, errors.New(errInvalidSecretRef)
+	}
+	return e, nil
+}
+
+// ephemeralInterceptor replaces the CRUD operations of the
+// azapi_resource_action for the supplied ephemeral resource. The action is
+// run through the Resource Manager client when the resource is created or
+// updated and at the first observation after the refresh time of its values,
+// or when its values are missing from the Secret. The response is written
+// only into the Secret, neither the output nor the sensitive output are set
+// in the Terraform state. The values are removed from the Secret when they
+// expire without being refreshed and when the resource is deleted. Only the
+// reads of the observations publish the times of the values into the status,
+// the creations and updates are asynchronous.
+func ephemeralInterceptor(kube client.Client, mg resource.Managed, newARMClient func() (*arm.Client, error)) (Interceptor, error) {
+	e, err := resolveEphemeral(mg)
+	if err != nil {
+		return Interceptor{}, err
+	}
+	run := func(ctx context.Context, raw tftypes.Value, state *tfsdk.State, diags *diag.Diagnostics) {
+		if _, err := e.run(ctx, kube, mg, raw, newARMClient); err != nil {
+			diags.AddError("Failed to run the ephemeral resource action", err.Error())
+			return
+		}
+		if err := ephemeralState(ctx, raw, state); err != nil {
+			diags.AddError(errEphemeralState, err.Error())
+		}
+	}
+	return Interceptor{
+		Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, _ func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+			run(ctx, req.Plan.Raw, &resp.State, &resp.Diagnostics)
+		},
+		Read: func(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse, _ func(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse)) {
+			status, err := e.observe(ctx, kube, mg, req.State.Raw, newARMClient)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to refresh the ephemeral resource values", err.Error())
+				return
+			}
+			if err := ephemeralState(ctx, req.State.Raw, &resp.State); err != nil {
+				resp.Diagnostics.AddError(errEphemeralState, err.Error())
+				return
+			}
+			if err := mg.(ujresource.Terraformed).SetObservation(status); err != nil { //nolint:forcetypeassert // checked while resolving the ephemeral resource
+				resp.Diagnostics.AddError(errSetEphemeralStatus, err.Error())
+			}
+		},
+		Update: func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, _ func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse)) {
+			run(ctx, req.Plan.Raw, &resp.State, &resp.Diagnostics)
+		},
+		Delete: func(ctx context.Context, _ fwresource.DeleteRequest, resp *fwresource.DeleteResponse, _ func(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse)) {
+			if err := e.apply(ctx, kube, mg, nil, time.Time{}, time.Time{}); err != nil {
+				resp.Diagnostics.AddError("Failed to remove the ephemeral resource values", err.Error())
+			}
+		},
+	}, nil
+}
+
+// observe refreshes the values of the ephemeral resource with the arguments
+// in the supplied state value if they are due, and returns its status
+// attributes. The values are removed if they expired and cannot be
+// refreshed. They are not refreshed while the resource is being deleted.
+func (e ephemeral) observe(ctx context.Context, kube client.Client, mg resource.Managed, raw tftypes.Value, newARMClient func() (*arm.Client, error)) (map[string]any, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, e.secret, s); resource.IgnoreNotFound(err) != nil {
+		return nil, errors.Wrap(err, errGetEphemeralSecret)
+	}
+	issued, expires, keys, ok := ephemeralValues(s, mg)
+	now := time.Now()
+	switch {
+	case ok && (now.Before(expires.Add(-e.refreshBefore)) || mg.GetDeletionTimestamp() != nil):
+		return e.status(issued, expires, keys), nil
+	case mg.GetDeletionTimestamp() != nil:
+		return map[string]any{}, nil
+	}
+	status, err := e.run(ctx, kube, mg, raw, newARMClient)
+	if err != nil && ok && !now.Before(expires) {
+		// the values are no longer valid
+		if rErr := e.apply(ctx, kube, mg, nil, time.Time{}, time.Time{}); rErr != nil {
+			return nil, rErr
+		}
+	}
+	return status, err
+}
+
+// run runs the action of the ephemeral resource with the arguments in the
+// supplied plan or state value, writes the exported values of the response
+// into the Secret and returns the status attributes of the resource.
+func (e ephemeral) run(ctx context.Context, kube client.Client, mg resource.Managed, raw tftypes.Value, newARMClient func() (*arm.Client, error)) (map[string]any, error) {
+	params, _ := tfValueToAny(raw).(map[string]any)
+	issued := time.Now().UTC().Truncate(time.Second)
+	expires := issued.Add(e.ttl)
+
+	t, _ := params["type"].(string)
+	_, apiVersion, _ := strings.Cut(t, "@")
+	id, _ := params["resource_id"].(string)
+	action, _ := params["action"].(string)
+	o := arm.ActionOptions{Query: url.Values{}, Header: http.Header{}}
+	o.Method, _ = params["method"].(string)
+	qp, _ := params["query_parameters"].(map[string]any)
+	for k, v := range qp {
+		l, _ := v.([]any)
+		for _, q := range l {
+			if s, ok := q.(string); ok {
+				o.Query.Add(k, s)
+			}
+		}
+	}
+	h, _ := params["headers"].(map[string]any)
+	for k, v := range h {
+		if s, ok := v.(string); ok {
+			o.Header.Set(k, s)
+		}
+	}
+	if b := params["body"]; b != nil {
+		o.Body = replacePlaceholders(b, strings.NewReplacer(placeholderIssuedAt, issued.Format(time.RFC3339), placeholderExpiresAt, expires.Format(time.RFC3339)))
+	}
+
+	c, err := newARMClient()
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.Action(ctx, id, action, apiVersion, o)
+	if err != nil {
+		return nil, err
+	}
+	exports := params["response_export_values"]
+	if exports == nil {
+		exports = []any{"*"}
+	}
+	output, err := exportValues(body, exports)
+	if err != nil {
+		return nil, err
+	}
+	data := ephemeralSecretData(output)
+	if len(data) == 0 {
+		return nil, errors.New(errNoEphemeralValues)
+	}
+	if err := e.apply(ctx, kube, mg, data, issued, expires); err != nil {
+		return nil, err
+	}
+	return e.status(issued, expires, sortedKeys(data)), nil
+}
+
+func (e ephemeral) status(issued, expires time.Time, keys []string) map[string]any {
+	l := make([]any, len(keys))
+	for i, k := range keys {
+		l[i] = k
+	}
+	return map[string]any{
+		keyIssuedAt:    issued.Format(time.RFC3339),
+		keyExpiresAt:   expires.Format(time.RFC3339),
+		keyRefreshTime: expires.Add(-e.refreshBefore).Format(time.RFC3339),
+		keySecretKeys:  l,
+	}
+}
+
+// apply replaces the values of the ephemeral resource in its Secret with
+// the supplied ones, creating the Secret if it does not exist. No values
+// remove them along with the annotations and the Secret itself if it's left
+// empty.
+func (e ephemeral) apply(ctx context.Context, kube client.Client, mg resource.Managed, data map[string][]byte, issued, expires time.Time) error { //nolint:gocyclo // easier to follow as a unit
+	owner, err := outputStoreOwner(kube, mg)
+	if err != nil {
+		return err
+	}
+	controller := true
+	owner.Controller = &controller
+	s := &corev1.Secret{}
+	err = kube.Get(ctx, e.secret, s)
+	if resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errGetEphemeralSecret)
+	}
+	create := kerrors.IsNotFound(err)
+	if create && len(data) == 0 {
+		return nil
+	}
+	if c := metav1.GetControllerOf(s); c != nil && c.UID != owner.UID {
+		if len(data) == 0 {
+			// none of the values are ours to remove
+			return nil
+		}
+		return errors.New(errEphemeralSecretOwner)
+	}
+	if create {
+		s.SetNamespace(e.secret.Namespace)
+		s.SetName(e.secret.Name)
+	}
+	if metav1.GetControllerOf(s) == nil {
+		s.SetOwnerReferences(append(s.GetOwnerReferences(), owner))
+	}
+	if s.Data == nil {
+		s.Data = map[string][]byte{}
+	}
+	annotations := s.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for _, k := range strings.Split(annotations[AnnotationKeyEphemeralKeys], ",") {
+		delete(s.Data, k)
+	}
+	if len(data) == 0 {
+		delete(annotations, AnnotationKeyIssuedAt)
+		delete(annotations, AnnotationKeyExpiresAt)
+		delete(annotations, AnnotationKeyEphemeralKeys)
+		s.SetAnnotations(annotations)
+		if len(s.Data) == 0 && !create {
+			return errors.Wrap(resource.IgnoreNotFound(kube.Delete(ctx, s)), errApplyEphemeralSecret)
+		}
+		return errors.Wrap(kube.Update(ctx, s), errApplyEphemeralSecret)
+	}
+	for k, v := range data {
+		s.Data[k] = v
+	}
+	annotations[AnnotationKeyIssuedAt] = issued.Format(time.RFC3339)
+	annotations[AnnotationKeyExpiresAt] = expires.Format(time.RFC3339)
+	annotations[AnnotationKeyEphemeralKeys] = strings.Join(sortedKeys(data), ",")
+	s.SetAnnotations(annotations)
+	if create {
+		return errors.Wrap(kube.Create(ctx, s), errApplyEphemeralSecret)
+	}
+	return errors.Wrap(kube.Update(ctx, s), errApplyEphemeralSecret)
+}
+
+// ephemeralValues returns the issue and expiry times and the keys of the
+// values of the supplied ephemeral resource in the supplied Secret, and
+// whether they are all present.
+func ephemeralValues(s *corev1.Secret, mg resource.Managed) (time.Time, time.Time, []string, bool) {
+	if c := metav1.GetControllerOf(s); c == nil || c.UID != mg.GetUID() {
+		return time.Time{}, time.Time{}, nil, false
+	}
+	annotations := s.GetAnnotations()
+	issued, err := time.Parse(time.RFC3339, annotations[AnnotationKeyIssuedAt])
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, false
+	}
+	expires, err := time.Parse(time.RFC3339, annotations[AnnotationKeyExpiresAt])
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, false
+	}
+	keys := strings.Split(annotations[AnnotationKeyEphemeralKeys], ",")
+	for _, k := range keys {
+		if _, ok := s.Data[k]; !ok {
+			return time.Time{}, time.Time{}, nil, false
+		}
+	}
+	return issued, expires, keys, true
+}
+
+// ephemeralSecretData returns the Secret data of the supplied exported
+// values. The top level string values of an object are written under their
+// names, the other values JSON encoded. Values that are not objects are
+// written under the output key.
+func ephemeralSecretData(output any) map[string][]byte {
+	m, ok := output.(map[string]any)
+	if !ok {
+		m = map[string]any{ephemeralOutputKey: output}
+	}
+	data := make(map[string][]byte, len(m))
+	for k, v := range m {
+		if k = common.SecretKey(k); k == "" || v == nil {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			data[k] = []byte(s)
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		data[k] = b
+	}
+	return data
+}
+
+// ephemeralState sets the supplied state from the supplied plan or state
+// value of an ephemeral resource, without the unknown values and the
+// outputs.
+func ephemeralState(ctx context.Context, raw tftypes.Value, state *tfsdk.State) error {
+	v, err := tftypes.Transform(raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		return err
+	}
+	state.Raw = v
+	params, _ := tfValueToAny(raw).(map[string]any)
+	id, _ := params["resource_id"].(string)
+	method, _ := params["method"].(string)
+	if method == "" {
+		method = http.MethodPost
+	}
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), id)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("method"), method)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("output"), types.DynamicNull())...)
+	diags.Append(state.SetAttribute(ctx, path.Root("sensitive_output"), types.DynamicNull())...)
+	if diags.HasError() {
+		return errors.Errorf("%s: %s", diags.Errors()[0].Summary(), diags.Errors()[0].Detail())
+	}
+	return nil
+}
+
+// replacePlaceholders returns a copy of the supplied value with the
+// replacements of the supplied replacer applied to its string values.
+func replacePlaceholders(v any, r *strings.Replacer) any {
+	switch t := v.(type) {
+	case string:
+		return r.Replace(t)
+	case map[string]any:
+		result := make(map[string]any, len(t))
+		for k, e := range t {
+			result[k] = replacePlaceholders(e, r)
+		}
+		return result
+	case []any:
+		result := make([]any, len(t))
+		for i, e := range t {
+			result[i] = replacePlaceholders(e, r)
+		}
+		return result
+	}
+	return v
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1alpha1"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+func TestResolveEphemeral(t *testing.T) {
+	secretRef := map[string]any{"name": "keys"}
+	cases := map[string]struct {
+		params  map[string]any
+		want    ephemeral
+		wantErr string
+	}{
+		"Defaults": {
+			params: map[string]any{keySecretRef: secretRef},
+			want:   ephemeral{ttl: defaultEphemeralTTL, refreshBefore: defaultRefreshBefore, secret: client.ObjectKey{Namespace: "ns", Name: "keys"}},
+		},
+		"Durations": {
+			params: map[string]any{keySecretRef: secretRef, keyTTL: "24h", keyRefreshBefore: "0s"},
+			want:   ephemeral{ttl: 24 * time.Hour, secret: client.ObjectKey{Namespace: "ns", Name: "keys"}},
+		},
+		"TTLTooShort": {
+			params:  map[string]any{keySecretRef: secretRef, keyTTL: "1m"},
+			wantErr: errInvalidTTL,
+		},
+		"InvalidTTL": {
+			params:  map[string]any{keySecretRef: secretRef, keyTTL: "1 hour"},
+			wantErr: errInvalidTTL,
+		},
+		"RefreshBeforeTTL": {
+			params:  map[string]any{keySecretRef: secretRef, keyTTL: "10m", keyRefreshBefore: "10m"},
+			wantErr: errInvalidRefreshBefore,
+		},
+		"NegativeRefreshBefore": {
+			params:  map[string]any{keySecretRef: secretRef, keyRefreshBefore: "-1m"},
+			wantErr: errInvalidRefreshBefore,
+		},
+		"NoSecretName": {
+			params:  map[string]any{keySecretRef: map[string]any{}},
+			wantErr: errInvalidSecretRef,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &v1alpha1.EphemeralResourceAction{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}}
+			if err := mg.SetParameters(tc.params); err != nil {
+				t.Fatal(err)
+			}
+			got, err := resolveEphemeral(mg)
+			if tc.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+// testEphemeralSecret returns the Secret of the keys of an ephemeral
+// resource controlled by the supplied owner, expiring at the supplied time.
+func testEphemeralSecret(owner types.UID, expires time.Time) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "keys",
+			Annotations: map[string]string{
+				AnnotationKeyIssuedAt:      expires.Add(-time.Hour).Format(time.RFC3339),
+				AnnotationKeyExpiresAt:     expires.Format(time.RFC3339),
+				AnnotationKeyEphemeralKeys: "accountSasToken",
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: v1alpha1.CRDGroupVersion.String(),
+				Kind:       v1alpha1.EphemeralResourceAction_Kind,
+				Name:       "sas",
+				UID:        owner,
+				Controller: ptr(true),
+			}},
+		},
+		Data: map[string][]byte{"accountSasToken": []byte("old"), "user": []byte("kept")},
+	}
+}
+
+func TestEphemeralObserve(t *testing.T) {
+	const id = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa"
+	now := time.Now().UTC().Truncate(time.Second)
+	e := ephemeral{ttl: time.Hour, refreshBefore: 15 * time.Minute, secret: client.ObjectKey{Namespace: "ns", Name: "keys"}}
+	cases := map[string]struct {
+		secret     *corev1.Secret
+		deleting   bool
+		fail       bool
+		wantCalls  int
+		wantData   map[string]string
+		wantStatus bool
+		wantErr    bool
+	}{
+		"Fresh": {
+			secret:     testEphemeralSecret("uid", now.Add(20*time.Minute)),
+			wantData:   map[string]string{"accountSasToken": "old", "user": "kept"},
+			wantStatus: true,
+		},
+		"Due": {
+			secret:     testEphemeralSecret("uid", now.Add(10*time.Minute)),
+			wantCalls:  1,
+			wantData:   map[string]string{"accountSasToken": "new", "user": "kept"},
+			wantStatus: true,
+		},
+		"DueWhileDeleting": {
+			secret:     testEphemeralSecret("uid", now.Add(10*time.Minute)),
+			deleting:   true,
+			wantData:   map[string]string{"accountSasToken": "old", "user": "kept"},
+			wantStatus: true,
+		},
+		"Missing": {
+			wantCalls:  1,
+			wantData:   map[string]string{"accountSasToken": "new"},
+			wantStatus: true,
+		},
+		"ControlledByOther": {
+			secret:    testEphemeralSecret("other", now.Add(20*time.Minute)),
+			wantCalls: 1,
+			wantData:  map[string]string{"accountSasToken": "old", "user": "kept"},
+			wantErr:   true,
+		},
+		"DueFailure": {
+			secret:    testEphemeralSecret("uid", now.Add(10*time.Minute)),
+			fail:      true,
+			wantCalls: 1,
+			wantData:  map[string]string{"accountSasToken": "old", "user": "kept"},
+			wantErr:   true,
+		},
+		"ExpiredFailure": {
+			secret:    testEphemeralSecret("uid", now.Add(-time.Minute)),
+			fail:      true,
+			wantCalls: 1,
+			wantData:  map[string]string{"user": "kept"},
+			wantErr:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			var body map[string]any
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				if tc.fail {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`{"accountSasToken": "new"}`))
+			}))
+			defer srv.Close()
+
+			s := runtime.NewScheme()
+			if err := v1alpha1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			b := fake.NewClientBuilder().WithScheme(s)
+			if tc.secret != nil {
+				b = b.WithObjects(tc.secret)
+			}
+			kube := b.Build()
+			mg := &v1alpha1.EphemeralResourceAction{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "sas", UID: "uid"}}
+			if tc.deleting {
+				mg.SetDeletionTimestamp(&metav1.Time{Time: now})
+			}
+			newARMClient := func() (*arm.Client, error) {
+				return arm.NewClient(nil, arm.WithEndpoint(srv.URL)), nil
+			}
+			raw := tfValueOf(map[string]any{
+				"type":        "Microsoft.Storage/storageAccounts@2023-05-01",
+				"resource_id": id,
+				"action":      "listAccountSas",
+				"body":        map[string]any{"signedExpiry": placeholderExpiresAt},
+			})
+
+			status, err := e.observe(context.Background(), kube, mg, raw, newARMClient)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("want error %t, got %v", tc.wantErr, err)
+			}
+			if calls != tc.wantCalls {
+				t.Errorf("want %d action calls, got %d", tc.wantCalls, calls)
+			}
+			if gotStatus := status[keyExpiresAt] != nil; gotStatus != tc.wantStatus {
+				t.Errorf("want a status %t, got %v", tc.wantStatus, status)
+			}
+			if calls > 0 && !tc.wantErr {
+				// the expiry sent is the one published in the status
+				if got, want := body["signedExpiry"], status[keyExpiresAt]; got != want {
+					t.Errorf("want the signed expiry %v, got %v", want, got)
+				}
+				refresh, _ := time.Parse(time.RFC3339, status[keyRefreshTime].(string))
+				expires, _ := time.Parse(time.RFC3339, status[keyExpiresAt].(string))
+				if got := expires.Sub(refresh); got != e.refreshBefore {
+					t.Errorf("want the refresh time %s before the expiry, got %s", e.refreshBefore, got)
+				}
+			}
+			got := &corev1.Secret{}
+			if err := kube.Get(context.Background(), e.secret, got); err != nil {
+				t.Fatal(err)
+			}
+			data := make(map[string]string, len(got.Data))
+			for k, v := range got.Data {
+				data[k] = string(v)
+			}
+			if !reflect.DeepEqual(tc.wantData, data) {
+				t.Errorf("want the Secret data %v, got %v", tc.wantData, data)
+			}
+		})
+	}
+}
+
+func TestEphemeralValues(t *testing.T) {
+	expires := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		secret   *corev1.Secret
+		wantKeys []string
+		wantOK   bool
+	}{
+		"Owned": {
+			secret:   testEphemeralSecret("uid", expires),
+			wantKeys: []string{"accountSasToken"},
+			wantOK:   true,
+		},
+		"ControlledByOther": {
+			secret: testEphemeralSecret("other", expires),
+		},
+		"NotControlled": {
+			secret: func() *corev1.Secret {
+				s := testEphemeralSecret("uid", expires)
+				s.OwnerReferences[0].Controller = nil
+				return s
+			}(),
+		},
+		"MissingKey": {
+			secret: func() *corev1.Secret {
+				s := testEphemeralSecret("uid", expires)
+				delete(s.Data, "accountSasToken")
+				return s
+			}(),
+		},
+		"NoExpiry": {
+			secret: func() *corev1.Secret {
+				s := testEphemeralSecret("uid", expires)
+				delete(s.Annotations, AnnotationKeyExpiresAt)
+				return s
+			}(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &v1alpha1.EphemeralResourceAction{ObjectMeta: metav1.ObjectMeta{UID: "uid"}}
+			_, gotExpires, keys, ok := ephemeralValues(tc.secret, mg)
+			if ok != tc.wantOK {
+				t.Fatalf("want the values present %t, got %t", tc.wantOK, ok)
+			}
+			if !reflect.DeepEqual(tc.wantKeys, keys) {
+				t.Errorf("want the keys %v, got %v", tc.wantKeys, keys)
+			}
+			if ok && !gotExpires.Equal(expires) {
+				t.Errorf("want the expiry %s, got %s", expires, gotExpires)
+			}
+		})
+	}
+}
+
+func TestReplacePlaceholders(t *testing.T) {
+	r := strings.NewReplacer(placeholderIssuedAt, "2025-01-01T00:00:00Z", placeholderExpiresAt, "2025-01-01T01:00:00Z")
+	in := map[string]any{
+		"signedStart":  placeholderIssuedAt,
+		"signedExpiry": placeholderExpiresAt,
+		"window":       []any{"from " + placeholderIssuedAt + " to " + placeholderExpiresAt, float64(1)},
+		"keyToSign":    "key1",
+	}
+	want := map[string]any{
+		"signedStart":  "2025-01-01T00:00:00Z",
+		"signedExpiry": "2025-01-01T01:00:00Z",
+		"window":       []any{"from 2025-01-01T00:00:00Z to 2025-01-01T01:00:00Z", float64(1)},
+		"keyToSign":    "key1",
+	}
+	if got := replacePlaceholders(in, r); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if got := in["signedExpiry"]; got != placeholderExpiresAt {
+		t.Errorf("want the supplied value unchanged, got %v", got)
+	}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/upbound/provider-azapi/v2/config/common"
+)
+
+// An Interceptor hooks into the CRUD operations of a Terraform plugin
+// framework resource. Each hook receives the next function in the chain and
+// decides whether and how to call it. Nil hooks are skipped.
+type Interceptor struct {
+	Create func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, next func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse))
+	Read   func(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse, next func(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse))
+	Update func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, next func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse))
+	Delete func(ctx context.Context, req fwresource.DeleteRequest, resp *fwresource.DeleteResponse, next func(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse))
+	// ModifyPlan is called with the plan already modified by the
+	// intercepted resource if it implements
+	// resource.ResourceWithModifyPlan.
+	ModifyPlan func(ctx context.Context, req fwresource.ModifyPlanRequest, resp *fwresource.ModifyPlanResponse, next func(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse))
+}
+
+// interceptedProvider wraps a Terraform plugin framework provider so that the
+// resources with the configured type names are intercepted.
+type interceptedProvider struct {
+	fwprovider.Provider
+	interceptors map[string][]Interceptor
+}
+
+// interceptProvider returns a provider whose resources with the type names
+// in the supplied map are wrapped with the associated interceptors. The
+// first interceptor in a chain is the outermost one.
+func interceptProvider(p fwprovider.Provider, interceptors map[string][]Interceptor) fwprovider.Provider {
+	if p == nil || len(interceptors) == 0 {
+		return p
+	}
+	return &interceptedProvider{Provider: p, interceptors: interceptors}
+}
+
+func (p *interceptedProvider) Resources(ctx context.Context) []func() fwresource.Resource {
+	mResp := &fwprovider.MetadataResponse{}
+	p.Metadata(ctx, fwprovider.MetadataRequest{}, mResp)
+
+	fns := p.Provider.Resources(ctx)
+	result := make([]func() fwresource.Resource, len(fns))
+	for i, fn := range fns {
+		r := fn()
+		rResp := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: mResp.TypeName}, rResp)
+		chain, ok := p.interceptors[rResp.TypeName]
+		if !ok {
+			result[i] = fn
+			continue
+		}
+		result[i] = func() fwresource.Resource {
+			return interceptResource(fn(), chain)
+		}
+	}
+	return result
+}
+
+// interceptResource wraps the supplied resource with the interceptor chain.
+func interceptResource(r fwresource.Resource, chain []Interceptor) fwresource.Resource {
+	modifyPlan := func(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse) {}
+	if rm, ok := r.(fwresource.ResourceWithModifyPlan); ok {
+		modifyPlan = rm.ModifyPlan
+	}
+	w := common.ResourceWrapper{
+		Create:     r.Create,
+		Read:       r.Read,
+		Update:     r.Update,
+		Delete:     r.Delete,
+		ModifyPlan: modifyPlan,
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		w.Create = intercept(chain[i].Create, w.Create)
+		w.Read = intercept(chain[i].Read, w.Read)
+		w.Update = intercept(chain[i].Update, w.Update)
+		w.Delete = intercept(chain[i].Delete, w.Delete)
+		w.ModifyPlan = intercept(chain[i].ModifyPlan, w.ModifyPlan)
+	}
+	return w.Wrap(r)
+}
+
+// intercept returns the supplied operation hooked by the supplied hook, or
+// the operation itself if the hook is nil.
+func intercept[Req, Resp any](hook func(context.Context, Req, Resp, func(context.Context, Req, Resp)), next func(context.Context, Req, Resp)) func(context.Context, Req, Resp) {
+	if hook == nil {
+		return next
+	}
+	return func(ctx context.Context, req Req, resp Resp) {
+		hook(ctx, req, resp, next)
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// testResource records the operations it's called with.
+type testResource struct {
+	calls *[]string
+}
+
+func (r testResource) Metadata(_ context.Context, _ fwresource.MetadataRequest, resp *fwresource.MetadataResponse) {
+	resp.TypeName = "azapi_resource"
+}
+
+func (r testResource) Schema(context.Context, fwresource.SchemaRequest, *fwresource.SchemaResponse) {}
+
+func (r testResource) Create(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse) {
+	*r.calls = append(*r.calls, "create")
+}
+
+func (r testResource) Read(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse) {
+	*r.calls = append(*r.calls, "read")
+}
+
+func (r testResource) Update(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse) {
+	*r.calls = append(*r.calls, "update")
+}
+
+func (r testResource) Delete(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse) {
+	*r.calls = append(*r.calls, "delete")
+}
+
+func (r testResource) ModifyPlan(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse) {
+	*r.calls = append(*r.calls, "modifyPlan")
+}
+
+func (r testResource) ImportState(context.Context, fwresource.ImportStateRequest, *fwresource.ImportStateResponse) {
+	*r.calls = append(*r.calls, "importState")
+}
+
+func (r testResource) UpgradeState(context.Context) map[int64]fwresource.StateUpgrader {
+	*r.calls = append(*r.calls, "upgradeState")
+	return nil
+}
+
+func TestInterceptResource(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	hook := func(name string) Interceptor {
+		return Interceptor{
+			Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, next func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+				calls = append(calls, name)
+				next(ctx, req, resp)
+			},
+			ModifyPlan: func(ctx context.Context, req fwresource.ModifyPlanRequest, resp *fwresource.ModifyPlanResponse, next func(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse)) {
+				next(ctx, req, resp)
+				calls = append(calls, name)
+			},
+		}
+	}
+	r := interceptResource(testResource{calls: &calls}, []Interceptor{hook("outer"), {}, hook("inner")})
+
+	r.Create(ctx, fwresource.CreateRequest{}, &fwresource.CreateResponse{})
+	r.Read(ctx, fwresource.ReadRequest{}, &fwresource.ReadResponse{})
+	r.Update(ctx, fwresource.UpdateRequest{}, &fwresource.UpdateResponse{})
+	r.Delete(ctx, fwresource.DeleteRequest{}, &fwresource.DeleteResponse{})
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{}, &fwresource.ModifyPlanResponse{})
+	ri, ok := r.(fwresource.ResourceWithImportState)
+	if !ok {
+		t.Fatal("want the intercepted resource to implement resource.ResourceWithImportState")
+	}
+	ri.ImportState(ctx, fwresource.ImportStateRequest{}, &fwresource.ImportStateResponse{})
+	ru, ok := r.(fwresource.ResourceWithUpgradeState)
+	if !ok {
+		t.Fatal("want the intercepted resource to implement resource.ResourceWithUpgradeState")
+	}
+	ru.UpgradeState(ctx)
+
+	want := []string{"outer", "inner", "create", "read", "update", "delete", "modifyPlan", "inner", "outer", "importState", "upgradeState"}
+	if !reflect.DeepEqual(want, calls) {
+		t.Errorf("want calls %v, got %v", want, calls)
+	}
+}
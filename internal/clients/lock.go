@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+const (
+	// AzureLockName is the name of the Azure management lock of a resource
+	// with an azureLock.
+	AzureLockName = ManagedLockPrefix + "lock"
+
+	// LockLevelCanNotDelete is the level of the locks preventing the
+	// deletion of a resource.
+	LockLevelCanNotDelete = "CanNotDelete"
+	// LockLevelReadOnly is the level of the locks preventing the
+	// modification and the deletion of a resource.
+	LockLevelReadOnly = "ReadOnly"
+
+	// AzureLockStateLocked is the state of an Azure management lock that
+	// is in place.
+	AzureLockStateLocked = "Locked"
+	// AzureLockStateLifted is the state of an Azure management lock that
+	// is lifted for an update or the deletion of its resource.
+	AzureLockStateLifted = "Lifted"
+
+	keyAzureLock      = "azure_lock"
+	keyAzureLockID    = "azure_lock_id"
+	keyAzureLockState = "azure_lock_state"
+
+	maxLockNotesLength = 512
+
+	errInvalidLockLevel = "azureLock.level must be one of CanNotDelete or ReadOnly"
+	errLockNotesTooLong = "azureLock.notes must be at most 512 characters"
+	errGetLockParams    = "cannot get the Azure lock parameters"
+	errSetLockStatus    = "cannot set the Azure lock state in status"
+	errListAzureLocks   = "cannot list the Azure locks of the resource"
+	errPutAzureLock     = "cannot create or update the Azure lock of the resource"
+	errLiftAzureLock    = "cannot lift the Azure lock of the resource"
+	errRemoveAzureLock  = "cannot remove the Azure lock of the resource"
+)
+
+// azureLock is the Azure management lock configured for a resource.
+type azureLock struct {
+	level string
+	notes string
+}
+
+func resolveAzureLock(params map[string]any) (*azureLock, error) {
+	v := params[keyAzureLock]
+	// the singleton list of the Terraform schema
+	if l, ok := v.([]any); ok && len(l) > 0 {
+		v = l[0]
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	l := &azureLock{}
+	l.level, _ = m["level"].(string)
+	l.notes, _ = m["notes"].(string)
+	switch l.level {
+	case LockLevelCanNotDelete, LockLevelReadOnly:
+	default:
+		return nil, errors.New(errInvalidLockLevel)
+	}
+	if len(l.notes) > maxLockNotesLength {
+		return nil, errors.New(errLockNotesTooLong)
+	}
+	return l, nil
+}
+
+// azureLockInterceptor maintains the Azure management lock of the supplied
+// managed resource, which reconciles an azapi_resource, and returns the
+// interceptor lifting the lock while the resource is updated or deleted and
+// locking the resource once it's created. The lock is removed when the
+// azureLock of the managed resource is removed. A lock left lifted, e.g. when
+// the provider is restarted during an update, is put in place again at the
+// next reconciliation. It returns nil if no lock is configured or in place,
+// or if the management policies of the managed resource don't allow creating
+// or updating the resource, whose lock is then left as it is.
+func azureLockInterceptor(ctx context.Context, mg resource.Managed, newARMClient func() (*arm.Client, error)) (*Interceptor, error) { //nolint:gocyclo // easier to follow as a unit
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return nil, errors.New(errNotTerraformedKind)
+	}
+	if !createsOrUpdates(mg) {
+		return nil, nil
+	}
+	params, err := tr.GetParameters()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetLockParams)
+	}
+	lock, err := resolveAzureLock(params)
+	if err != nil {
+		return nil, err
+	}
+	obs, err := tr.GetObservation()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetLockParams)
+	}
+	id, _ := obs["id"].(string)
+	lockID, _ := obs[keyAzureLockID].(string)
+	if lock == nil && lockID == "" {
+		return nil, nil
+	}
+	c, err := newARMClient()
+	if err != nil {
+		return nil, err
+	}
+	l := &azureLocker{client: c, tr: tr, lock: lock}
+	switch {
+	case mg.GetDeletionTimestamp() != nil:
+		return &Interceptor{
+			Delete: func(ctx context.Context, req fwresource.DeleteRequest, resp *fwresource.DeleteResponse, next func(context.Context, fwresource.DeleteRequest, *fwresource.DeleteResponse)) {
+				id := stateID(ctx, req.State)
+				if err := l.lift(ctx, id); err != nil {
+					resp.Diagnostics.AddError(errLiftAzureLock, err.Error())
+					return
+				}
+				next(ctx, req, resp)
+				if !resp.Diagnostics.HasError() || l.lock == nil {
+					return
+				}
+				// the resource is kept locked until it can be deleted
+				if err := l.put(ctx, id); err != nil {
+					resp.Diagnostics.AddError(errPutAzureLock, err.Error())
+				}
+			},
+		}, nil
+	case lock == nil:
+		if err := c.DeleteLock(ctx, lockID); err != nil {
+			return nil, errors.Wrap(err, errRemoveAzureLock)
+		}
+		return nil, errors.Wrap(l.setStatus("", ""), errSetLockStatus)
+	case id != "":
+		if err := l.ensure(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return &Interceptor{
+		Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, next func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+			next(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if err := l.put(ctx, stateID(ctx, resp.State)); err != nil {
+				resp.Diagnostics.AddError(errPutAzureLock, err.Error())
+			}
+		},
+		Update: func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, next func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse)) {
+			id := stateID(ctx, req.State)
+			if err := l.lift(ctx, id); err != nil {
+				resp.Diagnostics.AddError(errLiftAzureLock, err.Error())
+				return
+			}
+			next(ctx, req, resp)
+			// the resource is locked again even if the update has failed
+			if err := l.put(ctx, id); err != nil {
+				resp.Diagnostics.AddError(errPutAzureLock, err.Error())
+			}
+		},
+	}, nil
+}
+
+// azureLocker creates, lifts and removes the Azure management lock of a
+// resource, and records its state in the status of the managed resource.
+type azureLocker struct {
+	client *arm.Client
+	tr     ujresource.Terraformed
+	lock   *azureLock
+}
+
+// ensure creates the lock of the resource with the supplied ID, e.g. if it
+// was lifted and not put back, or updates it if its level or notes have
+// drifted.
+func (l *azureLocker) ensure(ctx context.Context, id string) error {
+	locks, err := l.client.Locks(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, errListAzureLocks)
+	}
+	for _, existing := range locks {
+		if existing.Name == AzureLockName && existing.Level == l.lock.level && existing.Notes == l.lock.notes {
+			return errors.Wrap(l.setStatus(existing.ID, AzureLockStateLocked), errSetLockStatus)
+		}
+	}
+	return l.put(ctx, id)
+}
+
+func (l *azureLocker) put(ctx context.Context, id string) error {
+	if l.lock == nil || id == "" {
+		return nil
+	}
+	lock, err := l.client.PutLock(ctx, id, AzureLockName, l.lock.level, l.lock.notes)
+	if err != nil {
+		return errors.Wrap(err, errPutAzureLock)
+	}
+	return errors.Wrap(l.setStatus(lock.ID, AzureLockStateLocked), errSetLockStatus)
+}
+
+func (l *azureLocker) lift(ctx context.Context, id string) error {
+	if id == "" {
+		return nil
+	}
+	lockID := id + "/providers/Microsoft.Authorization/locks/" + AzureLockName
+	if err := l.client.DeleteLock(ctx, lockID); err != nil {
+		return err
+	}
+	return errors.Wrap(l.setStatus(lockID, AzureLockStateLifted), errSetLockStatus)
+}
+
+// setStatus records the supplied lock ID and state, and the level and notes
+// of the lock, in the status of the managed resource. An empty ID clears
+// them.
+func (l *azureLocker) setStatus(lockID, state string) error {
+	status := map[string]any{keyAzureLockID: nil, keyAzureLockState: nil, keyAzureLock: nil}
+	if lockID != "" {
+		status[keyAzureLockID] = lockID
+		status[keyAzureLockState] = state
+		if l.lock != nil {
+			status[keyAzureLock] = map[string]any{"level": l.lock.level, "notes": l.lock.notes}
+		}
+	}
+	return l.tr.SetObservation(status)
+}
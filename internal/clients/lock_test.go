@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+func TestResolveAzureLock(t *testing.T) {
+	cases := map[string]struct {
+		params  map[string]any
+		want    *azureLock
+		wantErr string
+	}{
+		"None": {
+			params: map[string]any{},
+		},
+		"EmptyList": {
+			params: map[string]any{keyAzureLock: []any{}},
+		},
+		"Object": {
+			params: map[string]any{keyAzureLock: map[string]any{"level": LockLevelReadOnly, "notes": "n"}},
+			want:   &azureLock{level: LockLevelReadOnly, notes: "n"},
+		},
+		"List": {
+			params: map[string]any{keyAzureLock: []any{map[string]any{"level": LockLevelCanNotDelete}}},
+			want:   &azureLock{level: LockLevelCanNotDelete},
+		},
+		"InvalidLevel": {
+			params:  map[string]any{keyAzureLock: map[string]any{"level": "NotSpecified"}},
+			wantErr: errInvalidLockLevel,
+		},
+		"NoLevel": {
+			params:  map[string]any{keyAzureLock: map[string]any{"notes": "n"}},
+			wantErr: errInvalidLockLevel,
+		},
+		"NotesTooLong": {
+			params:  map[string]any{keyAzureLock: map[string]any{"level": LockLevelCanNotDelete, "notes": strings.Repeat("n", maxLockNotesLength+1)}},
+			wantErr: errLockNotesTooLong,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolveAzureLock(tc.params)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAzureLockInterceptor(t *testing.T) {
+	const (
+		id     = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa"
+		lockID = id + "/providers/Microsoft.Authorization/locks/" + AzureLockName
+	)
+	cases := map[string]struct {
+		policies   xpv1.ManagementPolicies
+		deleting   bool
+		failLift   bool
+		failNext   bool
+		wantNil    bool
+		wantCalls  []string
+		wantErrors int
+	}{
+		"ObserveOnly": {
+			policies: xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionLateInitialize},
+			wantNil:  true,
+		},
+		"UpdateFailure": {
+			failNext: true,
+			// the lock left lifted is put back before the update, which
+			// lifts it and puts it back although it has failed
+			wantCalls:  []string{"GET " + id + "/providers/Microsoft.Authorization/locks", "PUT " + lockID, "DELETE " + lockID, "next", "PUT " + lockID},
+			wantErrors: 1,
+		},
+		"UpdateLiftFailure": {
+			failLift:   true,
+			wantCalls:  []string{"GET " + id + "/providers/Microsoft.Authorization/locks", "PUT " + lockID, "DELETE " + lockID},
+			wantErrors: 1,
+		},
+		"Delete": {
+			deleting:  true,
+			wantCalls: []string{"DELETE " + lockID, "next"},
+		},
+		"DeleteFailure": {
+			deleting:   true,
+			failNext:   true,
+			wantCalls:  []string{"DELETE " + lockID, "next", "PUT " + lockID},
+			wantErrors: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				switch {
+				case r.Method == http.MethodDelete && tc.failLift:
+					w.WriteHeader(http.StatusInternalServerError)
+				case r.Method == http.MethodGet:
+					_, _ = w.Write([]byte(`{"value": []}`))
+				}
+			}))
+			defer srv.Close()
+
+			mg := &v1beta2.Resource{}
+			mg.Spec.ForProvider.AzureLock = &v1beta2.AzureLockParameters{Level: ptr(LockLevelCanNotDelete)}
+			mg.Status.AtProvider.ID = ptr(id)
+			mg.Status.AtProvider.AzureLockID = ptr(lockID)
+			mg.Status.AtProvider.AzureLockState = ptr(AzureLockStateLifted)
+			mg.SetManagementPolicies(tc.policies)
+			if tc.deleting {
+				mg.SetDeletionTimestamp(&metav1.Time{})
+			}
+			newARMClient := func() (*arm.Client, error) {
+				return arm.NewClient(nil, arm.WithEndpoint(srv.URL)), nil
+			}
+			ic, err := azureLockInterceptor(context.Background(), mg, newARMClient)
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if (ic == nil) != tc.wantNil {
+				t.Fatalf("want a nil interceptor %t, got %v", tc.wantNil, ic)
+			}
+			var errs int
+			switch {
+			case ic == nil:
+			case tc.deleting:
+				resp := &fwresource.DeleteResponse{}
+				ic.Delete(context.Background(), fwresource.DeleteRequest{State: testState(id)}, resp, func(_ context.Context, _ fwresource.DeleteRequest, resp *fwresource.DeleteResponse) {
+					calls = append(calls, "next")
+					if tc.failNext {
+						resp.Diagnostics.AddError("delete", "failed")
+					}
+				})
+				errs = resp.Diagnostics.ErrorsCount()
+			default:
+				resp := &fwresource.UpdateResponse{}
+				ic.Update(context.Background(), fwresource.UpdateRequest{State: testState(id)}, resp, func(_ context.Context, _ fwresource.UpdateRequest, resp *fwresource.UpdateResponse) {
+					calls = append(calls, "next")
+					if tc.failNext {
+						resp.Diagnostics.AddError("update", "failed")
+					}
+				})
+				errs = resp.Diagnostics.ErrorsCount()
+			}
+			if !reflect.DeepEqual(tc.wantCalls, calls) {
+				t.Errorf("want the calls %q, got %q", tc.wantCalls, calls)
+			}
+			if errs != tc.wantErrors {
+				t.Errorf("want %d errors, got %d", tc.wantErrors, errs)
+			}
+		})
+	}
+}
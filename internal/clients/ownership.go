@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/provider-azapi/v2/config/typed"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+	"github.com/upbound/provider-azapi/v2/internal/migration"
+)
+
+const (
+	// TagOwnerCluster is the tag recording the ID of the cluster whose
+	// managed resource manages an Azure resource.
+	TagOwnerCluster = ManagedTagPrefix + "owner-cluster"
+	// TagOwnerUID is the tag recording the UID of the managed resource
+	// managing an Azure resource.
+	TagOwnerUID = ManagedTagPrefix + "owner-uid"
+
+	// AnnotationKeyTakeover allows a managed resource to take over the
+	// Azure resource its external name points to when set to "true", even if
+	// the Azure resource is owned by another managed resource.
+	AnnotationKeyTakeover = "azapi.upbound.io/takeover"
+
+	// TypeOwned is the type of the condition reporting whether a managed
+	// resource owns the Azure resource its external name points to.
+	TypeOwned xpv1.ConditionType = "Owned"
+
+	// ReasonOwned is the reason of the Owned condition of a managed resource
+	// owning its Azure resource.
+	ReasonOwned xpv1.ConditionReason = "Owned"
+	// ReasonTakenOver is the reason of the Owned condition of a managed
+	// resource that has taken over its Azure resource from another owner.
+	ReasonTakenOver xpv1.ConditionReason = "TakenOver"
+	// ReasonOwnedByOther is the reason of the Owned condition of a managed
+	// resource whose Azure resource is owned by another managed resource.
+	ReasonOwnedByOther xpv1.ConditionReason = "OwnedByOther"
+
+	errGetOwnerTags       = "cannot get the ownership tags of the external resource"
+	errOwnedByOtherFmt    = "the external resource is owned by the managed resource with UID %s of the cluster %s, set the %s annotation to true to take it over"
+	errClaimOwnership     = "cannot write the ownership tags of the external resource"
+	errGetOwnershipParams = "cannot get the parameters to check the ownership"
+)
+
+// ownershipInterceptor checks that the Azure resource the external name of
+// the supplied managed resource points to, if any, is not owned by another
+// managed resource, and returns the interceptor adding the ownership tags of
+// the managed resource to the tags passed to the Terraform provider. The
+// ownership is checked at each reconciliation, so that a managed resource
+// stops managing its Azure resource as soon as another one takes it over,
+// and the ownership tags are written before the ownership is reported. A
+// managed resource with the takeover annotation takes over the Azure
+// resources owned by other managed resources, and a managed resource
+// migrated from a cluster-scoped one takes over the Azure resource owned by
+// the latter. A managed resource being deleted whose Azure resource is owned
+// by another managed resource is deleted without deleting the Azure
+// resource. It returns nil if the management policies of the managed
+// resource don't allow creating or updating the Azure resource, or if the
+// type of the Azure resource has no tags.
+func ownershipInterceptor(ctx context.Context, mg resource.Managed, clusterID string, newARMClient func() (*arm.Client, error)) (*Interceptor, error) { //nolint:gocyclo // easier to follow as a unit
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return nil, errors.New(errNotTerraformedKind)
+	}
+	if !createsOrUpdates(mg) {
+		return nil, nil
+	}
+	params, err := tr.GetParameters()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetOwnershipParams)
+	}
+	resourceType, _ := params[keyType].(string)
+	if k, ok := typedKind(tr.GetTerraformResourceType()); ok {
+		resourceType = k.Type
+	}
+	if !typed.Taggable(resourceType) {
+		return nil, nil
+	}
+	p := &tagPropagator{tags: map[string]string{
+		TagOwnerCluster: clusterID,
+		TagOwnerUID:     string(mg.GetUID()),
+	}}
+	id := meta.GetExternalName(mg)
+	if id == "" {
+		return p.interceptor(), nil
+	}
+	c, err := newARMClient()
+	if err != nil {
+		return nil, err
+	}
+	tags, err := c.Tags(ctx, id)
+	if arm.IsNotFound(err) {
+		// the resource is (re)created with the ownership tags
+		return p.interceptor(), nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetOwnerTags)
+	}
+	cond, err := ownership(mg, clusterID, tags)
+	if err != nil {
+		mg.SetConditions(cond)
+		if mg.GetDeletionTimestamp() != nil {
+			// the Azure resource is reported as gone so that it's left
+			// to its owner
+			return &Interceptor{
+				Read: func(ctx context.Context, _ fwresource.ReadRequest, resp *fwresource.ReadResponse, _ func(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse)) {
+					resp.State.RemoveResource(ctx)
+				},
+			}, nil
+		}
+		return nil, err
+	}
+	if tags[TagOwnerCluster] != clusterID || tags[TagOwnerUID] != p.tags[TagOwnerUID] {
+		if err := c.MergeTags(ctx, id, p.tags); err != nil {
+			return nil, errors.Wrap(err, errClaimOwnership)
+		}
+	}
+	mg.SetConditions(cond)
+	return p.interceptor(), nil
+}
+
+// ownership returns the Owned condition of the supplied managed resource
+// given the tags of its Azure resource, and an error if the Azure resource
+// is owned by another managed resource.
+func ownership(mg resource.Managed, clusterID string, tags map[string]string) (xpv1.Condition, error) {
+	ownerCluster, ownerUID := tags[TagOwnerCluster], tags[TagOwnerUID]
+	switch {
+	case ownerUID == "" || (ownerUID == string(mg.GetUID()) && ownerCluster == clusterID):
+		return owned(ReasonOwned, "The external resource is owned by this managed resource"), nil
+	case ownerUID == mg.GetAnnotations()[migration.AnnotationKeyMigratedFromUID] && ownerCluster == clusterID:
+		return owned(ReasonTakenOver, fmt.Sprintf("The external resource was taken over from the migrated managed resource with UID %s", ownerUID)), nil
+	case mg.GetAnnotations()[AnnotationKeyTakeover] == "true":
+		return owned(ReasonTakenOver, fmt.Sprintf("The external resource was taken over from the managed resource with UID %s of the cluster %s", ownerUID, ownerCluster)), nil
+	}
+	err := errors.Errorf(errOwnedByOtherFmt, ownerUID, ownerCluster, AnnotationKeyTakeover)
+	return xpv1.Condition{
+		Type:               TypeOwned,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOwnedByOther,
+		Message:            err.Error(),
+	}, err
+}
+
+func owned(reason xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeOwned,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            msg,
+	}
+}
+
+// createsOrUpdates returns whether the management policies of the supplied
+// managed resource allow creating or updating its external resource.
+func createsOrUpdates(mg resource.Managed) bool {
+	policies := mg.GetManagementPolicies()
+	if len(policies) == 0 {
+		return true
+	}
+	for _, p := range policies {
+		switch p { //nolint:exhaustive // the other actions don't write the external resource
+		case xpv1.ManagementActionAll, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate:
+			return true
+		}
+	}
+	return false
+}
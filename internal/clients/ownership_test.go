@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+	"github.com/upbound/provider-azapi/v2/internal/migration"
+)
+
+const (
+	testClusterID = "cluster"
+	testUID       = "uid"
+)
+
+func TestOwnership(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		tags        map[string]string
+		wantReason  xpv1.ConditionReason
+		wantErr     bool
+	}{
+		"Untagged": {
+			wantReason: ReasonOwned,
+		},
+		"Owned": {
+			tags:       map[string]string{TagOwnerCluster: testClusterID, TagOwnerUID: testUID},
+			wantReason: ReasonOwned,
+		},
+		"SameUIDOtherCluster": {
+			tags:       map[string]string{TagOwnerCluster: "other", TagOwnerUID: testUID},
+			wantReason: ReasonOwnedByOther,
+			wantErr:    true,
+		},
+		"OwnedByOther": {
+			tags:       map[string]string{TagOwnerCluster: testClusterID, TagOwnerUID: "other"},
+			wantReason: ReasonOwnedByOther,
+			wantErr:    true,
+		},
+		"TakenOver": {
+			annotations: map[string]string{AnnotationKeyTakeover: "true"},
+			tags:        map[string]string{TagOwnerCluster: "other", TagOwnerUID: "other"},
+			wantReason:  ReasonTakenOver,
+		},
+		"TakeoverNotTrue": {
+			annotations: map[string]string{AnnotationKeyTakeover: "yes"},
+			tags:        map[string]string{TagOwnerCluster: testClusterID, TagOwnerUID: "other"},
+			wantReason:  ReasonOwnedByOther,
+			wantErr:     true,
+		},
+		"Migrated": {
+			annotations: map[string]string{migration.AnnotationKeyMigratedFromUID: "legacy"},
+			tags:        map[string]string{TagOwnerCluster: testClusterID, TagOwnerUID: "legacy"},
+			wantReason:  ReasonTakenOver,
+		},
+		"MigratedOtherCluster": {
+			annotations: map[string]string{migration.AnnotationKeyMigratedFromUID: "legacy"},
+			tags:        map[string]string{TagOwnerCluster: "other", TagOwnerUID: "legacy"},
+			wantReason:  ReasonOwnedByOther,
+			wantErr:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &v1beta2.Resource{ObjectMeta: metav1.ObjectMeta{UID: testUID, Annotations: tc.annotations}}
+			got, err := ownership(mg, testClusterID, tc.tags)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("want error %t, got %v", tc.wantErr, err)
+			}
+			if got.Reason != tc.wantReason {
+				t.Errorf("want the reason %s, got %s", tc.wantReason, got.Reason)
+			}
+			wantStatus := corev1.ConditionTrue
+			if tc.wantErr {
+				wantStatus = corev1.ConditionFalse
+			}
+			if got.Status != wantStatus {
+				t.Errorf("want the status %s, got %s", wantStatus, got.Status)
+			}
+		})
+	}
+}
+
+func TestOwnershipInterceptor(t *testing.T) {
+	const (
+		id       = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa"
+		tagsPath = id + "/providers/Microsoft.Resources/tags/default"
+		account  = "Microsoft.Storage/storageAccounts@2023-05-01"
+	)
+	ownedByUs := `{"properties": {"tags": {"` + TagOwnerCluster + `": "` + testClusterID + `", "` + TagOwnerUID + `": "` + testUID + `"}}}`
+	ownedByOther := `{"properties": {"tags": {"` + TagOwnerCluster + `": "` + testClusterID + `", "` + TagOwnerUID + `": "other"}}}`
+
+	cases := map[string]struct {
+		resourceType string
+		policies     xpv1.ManagementPolicies
+		externalName string
+		owned        bool
+		deleting     bool
+		tags         string
+		wantCalls    []string
+		wantNil      bool
+		wantRead     bool
+		wantErr      bool
+		wantReason   xpv1.ConditionReason
+	}{
+		"ObserveOnly": {
+			resourceType: account,
+			policies:     xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionDelete},
+			externalName: id,
+			wantNil:      true,
+		},
+		"Untaggable": {
+			resourceType: "Microsoft.Network/virtualNetworks/subnets@2023-04-01",
+			externalName: id,
+			wantNil:      true,
+		},
+		"NoExternalName": {
+			resourceType: account,
+		},
+		"NotFound": {
+			resourceType: account,
+			externalName: id,
+			wantCalls:    []string{"GET " + tagsPath},
+		},
+		"Untagged": {
+			resourceType: account,
+			externalName: id,
+			tags:         `{"properties": {"tags": {"env": "dev"}}}`,
+			wantCalls:    []string{"GET " + tagsPath, "PATCH " + tagsPath},
+			wantReason:   ReasonOwned,
+		},
+		"Owned": {
+			resourceType: account,
+			externalName: id,
+			tags:         ownedByUs,
+			wantCalls:    []string{"GET " + tagsPath},
+			wantReason:   ReasonOwned,
+		},
+		"OwnedByOther": {
+			resourceType: account,
+			policies:     xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionUpdate},
+			externalName: id,
+			tags:         ownedByOther,
+			wantCalls:    []string{"GET " + tagsPath},
+			wantNil:      true,
+			wantErr:      true,
+			wantReason:   ReasonOwnedByOther,
+		},
+		"TakenOverSinceOwned": {
+			resourceType: account,
+			externalName: id,
+			owned:        true,
+			tags:         ownedByOther,
+			wantCalls:    []string{"GET " + tagsPath},
+			wantNil:      true,
+			wantErr:      true,
+			wantReason:   ReasonOwnedByOther,
+		},
+		"DeletingOwnedByOther": {
+			resourceType: account,
+			externalName: id,
+			deleting:     true,
+			tags:         ownedByOther,
+			wantCalls:    []string{"GET " + tagsPath},
+			wantRead:     true,
+			wantReason:   ReasonOwnedByOther,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				if tc.tags == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if r.Method == http.MethodGet {
+					_, _ = w.Write([]byte(tc.tags))
+				}
+			}))
+			defer srv.Close()
+
+			mg := &v1beta2.Resource{ObjectMeta: metav1.ObjectMeta{UID: testUID}}
+			mg.Spec.ForProvider.Type = ptr(tc.resourceType)
+			mg.SetManagementPolicies(tc.policies)
+			if tc.externalName != "" {
+				meta.SetExternalName(mg, tc.externalName)
+				mg.Status.AtProvider.ID = ptr(tc.externalName)
+			}
+			if tc.owned {
+				mg.SetConditions(owned(ReasonOwned, "owned"))
+			}
+			if tc.deleting {
+				mg.SetDeletionTimestamp(&metav1.Time{})
+			}
+			newARMClient := func() (*arm.Client, error) {
+				return arm.NewClient(nil, arm.WithEndpoint(srv.URL)), nil
+			}
+			ic, err := ownershipInterceptor(context.Background(), mg, testClusterID, newARMClient)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("want error %t, got %v", tc.wantErr, err)
+			}
+			if (ic == nil) != tc.wantNil {
+				t.Errorf("want a nil interceptor %t, got %v", tc.wantNil, ic)
+			}
+			if gotRead := ic != nil && ic.Read != nil; gotRead != tc.wantRead {
+				t.Errorf("want a Read interceptor %t, got %t", tc.wantRead, gotRead)
+			}
+			if !reflect.DeepEqual(tc.wantCalls, calls) {
+				t.Errorf("want the calls %q, got %q", tc.wantCalls, calls)
+			}
+			if got := mg.GetCondition(TypeOwned).Reason; got != tc.wantReason {
+				t.Errorf("want the reason %q, got %q", tc.wantReason, got)
+			}
+		})
+	}
+}
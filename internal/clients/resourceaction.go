@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	resourceTypeAzAPIResourceAction = "azapi_resource_action"
+
+	// scheduledRunMarker is planned as the output of a resource action
+	// whose scheduled run is due, so that the plan differs from the state
+	// and the action is run through an update.
+	scheduledRunMarker = "scheduled run pending"
+
+	errGetActionParams    = "cannot get the resource action parameters"
+	errGetActionObs       = "cannot get the resource action observation"
+	errSetActionStatus    = "cannot set the resource action status"
+	errNotTerraformedKind = "managed resource is not a Terraformed resource"
+	errGetActionLatest    = "cannot get the latest revision of the resource action"
+	errPersistActionRun   = "cannot persist the resource action run into the status"
+)
+
+// actionRun is a completed run of a resource action.
+type actionRun struct {
+	time       time.Time
+	method     string
+	statusCode int
+	err        string
+	// output and sensitiveOutput are the decoded outputs of a successful
+	// run.
+	output          any
+	sensitiveOutput any
+}
+
+func (r actionRun) succeeded() bool {
+	return r.err == ""
+}
+
+// actionRuns records the runs of resource actions that could not be
+// persisted when they completed, until they are published into the status
+// of the managed resources at their next observation.
+type actionRuns struct {
+	// now is the clock the runs are timed and scheduled with.
+	now func() time.Time
+
+	mu   sync.Mutex
+	runs map[ktypes.UID][]actionRun
+}
+
+func newActionRuns() *actionRuns {
+	return &actionRuns{now: time.Now, runs: make(map[ktypes.UID][]actionRun)}
+}
+
+func (r *actionRuns) record(uid ktypes.UID, run actionRun) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.runs[uid] = append(r.runs[uid], run)
+}
+
+// take returns the recorded runs of the supplied managed resource, the
+// oldest first, and forgets them.
+func (r *actionRuns) take(uid ktypes.UID) []actionRun {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	runs := r.runs[uid]
+	delete(r.runs, uid)
+	return runs
+}
+
+// observeResourceAction publishes the runs of the supplied resource action
+// recorded since its last observation into its status and resolves its run
+// policy and schedule.
+func observeResourceAction(ctx context.Context, kube client.Client, mg resource.Managed, runs *actionRuns) (actionSchedule, error) {
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return actionSchedule{}, errors.New(errNotTerraformedKind)
+	}
+	params, err := tr.GetParameters()
+	if err != nil {
+		return actionSchedule{}, errors.Wrap(err, errGetActionParams)
+	}
+	obs, err := tr.GetObservation()
+	if err != nil {
+		return actionSchedule{}, errors.Wrap(err, errGetActionObs)
+	}
+	status := map[string]any{}
+	if err := publishActionHistory(ctx, kube, mg, params, obs, runs.take(mg.GetUID()), status); err != nil {
+		return actionSchedule{}, err
+	}
+	lastRun := mg.GetCreationTimestamp().UTC()
+	s, ok := status[keyLastRunTime].(string)
+	if !ok {
+		s, _ = obs[keyLastRunTime].(string)
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		lastRun = t
+	}
+	as, err := resolveActionSchedule(params, lastRun, status)
+	if err != nil {
+		return actionSchedule{}, err
+	}
+	return as, errors.Wrap(tr.SetObservation(status), errSetActionStatus)
+}
+
+// persistActionRun publishes the supplied run into the status of the latest
+// revision of the supplied resource action and into its output store, so
+// that the run is not lost if the provider restarts before the next
+// observation. The actions are run asynchronously, after the reconciliation
+// has updated the status.
+func persistActionRun(ctx context.Context, kube client.Client, mg resource.Managed, run actionRun) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, ok := mg.DeepCopyObject().(resource.Managed)
+		if !ok {
+			return errors.New(errNotTerraformedKind)
+		}
+		if err := kube.Get(ctx, client.ObjectKeyFromObject(mg), latest); err != nil {
+			return errors.Wrap(err, errGetActionLatest)
+		}
+		tr, ok := latest.(ujresource.Terraformed)
+		if !ok {
+			return errors.New(errNotTerraformedKind)
+		}
+		params, err := tr.GetParameters()
+		if err != nil {
+			return errors.Wrap(err, errGetActionParams)
+		}
+		obs, err := tr.GetObservation()
+		if err != nil {
+			return errors.Wrap(err, errGetActionObs)
+		}
+		status := map[string]any{}
+		if err := publishActionHistory(ctx, kube, latest, params, obs, []actionRun{run}, status); err != nil {
+			return err
+		}
+		if err := tr.SetObservation(status); err != nil {
+			return errors.Wrap(err, errSetActionStatus)
+		}
+		return errors.Wrap(kube.Status().Update(ctx, latest), errPersistActionRun)
+	})
+}
+
+// resourceActionInterceptor enforces the run policy of the supplied
+// resource action and persists its runs, which are recorded to be published
+// at the next observation if they cannot be persisted. Plans of the Once
+// policy are reset to the prior state so that the action is never run
+// again, and due scheduled runs are planned as updates, which run the action
+// again.
+func resourceActionInterceptor(kube client.Client, mg resource.Managed, as actionSchedule, runs *actionRuns) Interceptor {
+	done := func(ctx context.Context, run actionRun) {
+		if err := persistActionRun(ctx, kube, mg, run); err != nil {
+			runs.record(mg.GetUID(), run)
+		}
+	}
+	return Interceptor{
+		ModifyPlan: func(ctx context.Context, req fwresource.ModifyPlanRequest, resp *fwresource.ModifyPlanResponse, next func(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse)) {
+			next(ctx, req, resp)
+			// nothing to do for creations and deletions
+			if resp.Diagnostics.HasError() || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+				return
+			}
+			switch {
+			case as.policy == RunPolicyOnce:
+				resp.Plan.Raw = req.State.Raw.Copy()
+			case as.due(runs.now()) && runsOnApply(ctx, resp.Plan):
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("output"), types.DynamicValue(types.StringValue(scheduledRunMarker)))...)
+			}
+		},
+		Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, next func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+			if !runsOnApply(ctx, req.Plan) {
+				next(ctx, req, resp)
+				return
+			}
+			var httpResp *http.Response
+			next(policy.WithCaptureResponse(ctx, &httpResp), req, resp)
+			done(ctx, newActionRun(ctx, runs.now(), req.Plan, resp.State, httpResp, resp.Diagnostics.Errors()))
+		},
+		Update: func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, next func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse)) {
+			if !runsOnApply(ctx, req.Plan) {
+				next(ctx, req, resp)
+				return
+			}
+			var httpResp *http.Response
+			next(policy.WithCaptureResponse(ctx, &httpResp), req, resp)
+			done(ctx, newActionRun(ctx, runs.now(), req.Plan, resp.State, httpResp, resp.Diagnostics.Errors()))
+		},
+	}
+}
+
+// newActionRun builds the record of a resource action run completed at the
+// supplied time from its plan, its resulting state and the last HTTP
+// response received.
+func newActionRun(ctx context.Context, t time.Time, plan tfsdk.Plan, state tfsdk.State, httpResp *http.Response, errs diag.Diagnostics) actionRun {
+	run := actionRun{time: t.UTC()}
+	var method types.String
+	_ = plan.GetAttribute(ctx, path.Root("method"), &method)
+	run.method = method.ValueString()
+	if httpResp != nil {
+		run.statusCode = httpResp.StatusCode
+	}
+	if len(errs) > 0 {
+		run.err = errs[0].Summary() + ": " + errs[0].Detail()
+		return run
+	}
+	run.output = stateAttributeValue(state, "output")
+	run.sensitiveOutput = stateAttributeValue(state, "sensitive_output")
+	return run
+}
+
+// runsOnApply returns true if the planned resource action is run on apply
+// rather than on destroy.
+func runsOnApply(ctx context.Context, plan tfsdk.Plan) bool {
+	var when types.String
+	if diags := plan.GetAttribute(ctx, path.Root("when"), &when); diags.HasError() {
+		return false
+	}
+	return when.IsNull() || when.IsUnknown() || when.ValueString() == "apply"
+}
+
+// stateAttributeValue returns the Go value of the supplied top level
+// attribute of a Terraform state, or nil if it's not available.
+func stateAttributeValue(state tfsdk.State, name string) any {
+	if state.Raw.IsNull() {
+		return nil
+	}
+	v, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return nil
+	}
+	tv, ok := v.(tftypes.Value)
+	if !ok {
+		return nil
+	}
+	return tfValueToAny(tv)
+}
+
+// tfValueToAny converts a Terraform value into its JSON compatible Go
+// representation. Unknown values are converted to nil.
+func tfValueToAny(v tftypes.Value) any { //nolint:gocyclo // a flat switch over the value types
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	t := v.Type()
+	switch {
+	case t.Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return s
+	case t.Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return b
+	case t.Is(tftypes.Number):
+		var f big.Float
+		_ = v.As(&f)
+		if i, acc := f.Int64(); acc == big.Exact {
+			return i
+		}
+		r, _ := f.Float64()
+		return r
+	case t.Is(tftypes.Object{}), t.Is(tftypes.Map{}):
+		var m map[string]tftypes.Value
+		_ = v.As(&m)
+		result := make(map[string]any, len(m))
+		for k, e := range m {
+			result[k] = tfValueToAny(e)
+		}
+		return result
+	case t.Is(tftypes.List{}), t.Is(tftypes.Set{}), t.Is(tftypes.Tuple{}):
+		var l []tftypes.Value
+		_ = v.As(&l)
+		result := make([]any, len(l))
+		for i, e := range l {
+			result[i] = tfValueToAny(e)
+		}
+		return result
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resourcegraph implements a batch observation cache backed by Azure
+// Resource Graph. The cache periodically snapshots every tracked
+// subscription and reports whether an ARM resource has changed since it was
+// last read individually, so that per-resource GETs can be skipped for
+// resources that did not move.
+package resourcegraph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/pkg/errors"
+
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+const (
+	apiVersion = "2022-10-01"
+	queryPath  = "/providers/Microsoft.ResourceGraph/resources"
+	pageSize   = 1000
+
+	// query projects the columns whose changes invalidate a cached
+	// observation. Resource groups and subscriptions live in the
+	// resourcecontainers table.
+	query = `resources
+| union resourcecontainers
+| project id = tolower(id), etag = tostring(properties.etag), changedTime = tostring(properties.changedTime), properties, tags, sku, identity, kind, location, managedBy, zones`
+
+	errQuery = "cannot query Azure Resource Graph"
+)
+
+type queryRequest struct {
+	Subscriptions []string     `json:"subscriptions"`
+	Query         string       `json:"query"`
+	Options       queryOptions `json:"options"`
+}
+
+type queryOptions struct {
+	Top          int    `json:"$top"`
+	SkipToken    string `json:"$skipToken,omitempty"`
+	ResultFormat string `json:"resultFormat"`
+}
+
+type queryResponse struct {
+	Data      []map[string]json.RawMessage `json:"data"`
+	SkipToken string                       `json:"$skipToken"`
+}
+
+// Query returns a fingerprint per lower-cased ARM resource ID for all the
+// resources in the supplied subscription. The fingerprint changes whenever
+// the etag, changedTime or any of the projected columns of a resource do.
+func Query(ctx context.Context, c *arm.Client, subscriptionID string) (map[string]string, error) {
+	result := make(map[string]string)
+	req := queryRequest{
+		Subscriptions: []string{subscriptionID},
+		Query:         query,
+		Options: queryOptions{
+			Top:          pageSize,
+			ResultFormat: "objectArray",
+		},
+	}
+	for {
+		resp, err := c.Do(ctx, http.MethodPost, queryPath, apiVersion, req)
+		if err != nil {
+			return nil, errors.Wrap(err, errQuery)
+		}
+		qr := queryResponse{}
+		if err := resp.Unmarshal(&qr); err != nil {
+			return nil, errors.Wrap(err, errQuery)
+		}
+		for _, row := range qr.Data {
+			var id string
+			if err := json.Unmarshal(row["id"], &id); err != nil || id == "" {
+				continue
+			}
+			result[strings.ToLower(id)] = fingerprint(row)
+		}
+		if qr.SkipToken == "" {
+			return result, nil
+		}
+		req.Options.SkipToken = qr.SkipToken
+	}
+}
+
+func fingerprint(row map[string]json.RawMessage) string {
+	// json.Marshal sorts the map keys, which makes the digest stable.
+	b, _ := json.Marshal(row) //nolint:errchkjson // marshaling raw messages cannot fail
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+type subscription struct {
+	client *arm.Client
+	// identity identifies the credential the subscription is queried with.
+	identity string
+	// snapshot is the latest set of fingerprints keyed by ARM ID.
+	snapshot   map[string]string
+	snapshotAt time.Time
+	// observed holds the snapshot fingerprint of each resource at the time
+	// it was last read through an individual GET.
+	observed map[string]string
+}
+
+// An Option configures a Cache.
+type Option func(*Cache)
+
+// WithInterval sets the interval at which the tracked subscriptions are
+// queried.
+func WithInterval(d time.Duration) Option {
+	return func(c *Cache) {
+		c.interval = d
+	}
+}
+
+// WithEndpoint overrides the Resource Manager endpoints the subscriptions
+// are tracked with for the Resource Graph queries, e.g. to point the cache
+// to a local fake.
+func WithEndpoint(endpoint string) Option {
+	return func(c *Cache) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithCredential overrides the credentials the subscriptions are tracked
+// with, e.g. with a static token credential or a nil credential for
+// unauthenticated requests against a local fake.
+func WithCredential(cred azcore.TokenCredential) Option {
+	return func(c *Cache) {
+		c.cred = &cred
+	}
+}
+
+// WithLogger sets the logger of a Cache.
+func WithLogger(l logging.Logger) Option {
+	return func(c *Cache) {
+		c.log = l
+	}
+}
+
+// Cache tracks Azure Resource Graph snapshots of subscriptions.
+type Cache struct {
+	interval time.Duration
+	// endpoint overrides the tracked endpoints if not empty
+	endpoint string
+	// cred overrides the tracked credentials if not nil
+	cred *azcore.TokenCredential
+	log  logging.Logger
+
+	mu            sync.RWMutex
+	subscriptions map[string]*subscription
+}
+
+// NewCache returns a new Cache.
+func NewCache(opts ...Option) *Cache {
+	c := &Cache{
+		interval:      5 * time.Minute,
+		log:           logging.NewNopLogger(),
+		subscriptions: make(map[string]*subscription),
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// Track registers a subscription to be periodically queried at the supplied
+// Resource Manager endpoint, e.g. the endpoint of a sovereign cloud, with
+// the supplied credential, which is identified by the supplied identity.
+// Tracking an already tracked subscription only replaces its endpoint and
+// credential, e.g. after the secret of the credential has been rotated.
+func (c *Cache) Track(subscriptionID, identity, endpoint string, cred azcore.TokenCredential) {
+	subscriptionID = strings.ToLower(subscriptionID)
+	if subscriptionID == "" {
+		return
+	}
+	if c.cred != nil {
+		cred = *c.cred
+	}
+	if c.endpoint != "" {
+		endpoint = c.endpoint
+	}
+	var opts []arm.Option
+	if endpoint != "" {
+		opts = append(opts, arm.WithEndpoint(endpoint))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	client := arm.NewClient(cred, opts...)
+	if s, ok := c.subscriptions[subscriptionID]; ok {
+		s.client = client
+		s.identity = identity
+		return
+	}
+	c.subscriptions[subscriptionID] = &subscription{
+		client:   client,
+		identity: identity,
+		observed: make(map[string]string),
+	}
+}
+
+// Tracked returns true if the supplied subscription is already tracked with
+// the credential of the supplied identity.
+func (c *Cache) Tracked(subscriptionID, identity string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.subscriptions[strings.ToLower(subscriptionID)]
+	return ok && s.identity == identity
+}
+
+// Unchanged returns true if the resource with the supplied ARM ID is present
+// in a fresh snapshot with the same fingerprint it had when it was last
+// read individually.
+func (c *Cache) Unchanged(id string) bool {
+	id = strings.ToLower(id)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.subscriptions[arm.SubscriptionID(id)]
+	if !ok || s.snapshot == nil || time.Since(s.snapshotAt) > 2*c.interval {
+		return false
+	}
+	current, ok := s.snapshot[id]
+	if !ok {
+		return false
+	}
+	return s.observed[id] == current
+}
+
+// Observed records that the resource with the supplied ARM ID has just been
+// read individually, associating it with its current snapshot fingerprint.
+func (c *Cache) Observed(id string) {
+	id = strings.ToLower(id)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.subscriptions[arm.SubscriptionID(id)]
+	if !ok {
+		return
+	}
+	if current, ok := s.snapshot[id]; ok {
+		s.observed[id] = current
+		return
+	}
+	delete(s.observed, id)
+}
+
+// Forget drops the recorded observation of the resource with the supplied
+// ARM ID, forcing the next observation to be an individual read. It is
+// called after the resource is written.
+func (c *Cache) Forget(id string) {
+	id = strings.ToLower(id)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if s, ok := c.subscriptions[arm.SubscriptionID(id)]; ok {
+		delete(s.observed, id)
+	}
+}
+
+// Refresh queries all the tracked subscriptions once.
+func (c *Cache) Refresh(ctx context.Context) {
+	c.mu.RLock()
+	clients := make(map[string]*arm.Client, len(c.subscriptions))
+	for id, s := range c.subscriptions {
+		clients[id] = s.client
+	}
+	c.mu.RUnlock()
+
+	for id, client := range clients {
+		snapshot, err := Query(ctx, client, id)
+		if err != nil {
+			c.log.Info("Cannot refresh the Resource Graph snapshot, falling back to individual reads", "subscription", id, "error", err)
+			c.mu.Lock()
+			c.subscriptions[id].snapshot = nil
+			c.mu.Unlock()
+			continue
+		}
+		c.mu.Lock()
+		s := c.subscriptions[id]
+		s.snapshot = snapshot
+		s.snapshotAt = time.Now()
+		// drop the observations of the resources that are gone
+		for rid := range s.observed {
+			if _, ok := snapshot[rid]; !ok {
+				delete(s.observed, rid)
+			}
+		}
+		c.mu.Unlock()
+		c.log.Debug("Refreshed the Resource Graph snapshot", "subscription", id, "resources", len(snapshot))
+	}
+}
+
+// Start periodically refreshes the snapshots of the tracked subscriptions
+// until the supplied context is done. It implements manager.Runnable.
+func (c *Cache) Start(ctx context.Context) error {
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+	for {
+		c.Refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
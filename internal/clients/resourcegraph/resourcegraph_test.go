@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegraph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+const (
+	subscriptionID = "00000000-0000-0000-0000-000000000000"
+	idA            = "/subscriptions/" + subscriptionID + "/resourceGroups/a"
+	idB            = "/subscriptions/" + subscriptionID + "/resourceGroups/b"
+)
+
+// fakeGraph is a fake Azure Resource Graph serving the configured rows one
+// row per page, so that the pagination is exercised.
+type fakeGraph struct {
+	mu      sync.Mutex
+	rows    []map[string]any
+	fail    bool
+	queries int
+}
+
+func (f *fakeGraph) set(rows ...map[string]any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rows = rows
+}
+
+func (f *fakeGraph) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queries++
+	if f.fail || r.Method != http.MethodPost || r.URL.Path != queryPath || r.URL.Query().Get("api-version") != apiVersion {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	req := queryRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Subscriptions) != 1 || req.Subscriptions[0] != subscriptionID {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	i := 0
+	if req.Options.SkipToken != "" {
+		_ = json.Unmarshal([]byte(req.Options.SkipToken), &i)
+	}
+	resp := map[string]any{"data": []map[string]any{}}
+	if i < len(f.rows) {
+		resp["data"] = []map[string]any{f.rows[i]}
+	}
+	if i+1 < len(f.rows) {
+		b, _ := json.Marshal(i + 1)
+		resp["$skipToken"] = string(b)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func row(id, etag string) map[string]any {
+	return map[string]any{"id": id, "etag": etag}
+}
+
+func newTestCache(t *testing.T) (*Cache, *fakeGraph) {
+	t.Helper()
+	f := &fakeGraph{}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	c := NewCache(WithEndpoint(srv.URL), WithCredential(nil))
+	c.Track(subscriptionID, "identity", "", nil)
+	return c, f
+}
+
+func TestQuery(t *testing.T) {
+	f := &fakeGraph{}
+	f.set(row(idA, "1"), row(idB, "1"), row("", "1"))
+	srv := httptest.NewServer(f)
+	defer srv.Close()
+	got, err := Query(context.Background(), arm.NewClient(nil, arm.WithEndpoint(srv.URL)), subscriptionID)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	a, b := got[strings.ToLower(idA)], got[strings.ToLower(idB)]
+	if len(got) != 2 || a == "" || b == "" || a == b {
+		t.Errorf("want distinct fingerprints for the two resources, got %v", got)
+	}
+	if f.queries != 3 {
+		t.Errorf("want 3 pages queried, got %d", f.queries)
+	}
+}
+
+func TestUnchanged(t *testing.T) {
+	type step struct {
+		// rows set in the fake graph before refreshing, if not nil
+		rows []map[string]any
+		// whether the cache is refreshed
+		refresh bool
+		// the resources read individually
+		observe []string
+		// the resources written
+		forget []string
+		// whether the resources are reported as unchanged afterwards
+		want map[string]bool
+	}
+	cases := map[string]struct {
+		fail  bool
+		steps []step
+	}{
+		"NotObservedYet": {
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1")}, refresh: true, want: map[string]bool{idA: false}},
+			},
+		},
+		"Unchanged": {
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1"), row(idB, "1")}, refresh: true, observe: []string{idA, idB}, want: map[string]bool{idA: true, idB: true}},
+				{refresh: true, want: map[string]bool{idA: true, idB: true}},
+			},
+		},
+		"CaseInsensitive": {
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1")}, refresh: true, observe: []string{idA}, want: map[string]bool{"/SUBSCRIPTIONS/" + subscriptionID + "/RESOURCEGROUPS/A": true}},
+			},
+		},
+		"Changed": {
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1"), row(idB, "1")}, refresh: true, observe: []string{idA, idB}},
+				{rows: []map[string]any{row(idA, "2"), row(idB, "1")}, refresh: true, want: map[string]bool{idA: false, idB: true}},
+				{observe: []string{idA}, want: map[string]bool{idA: true}},
+			},
+		},
+		"Forgotten": {
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1")}, refresh: true, observe: []string{idA}},
+				{forget: []string{idA}, want: map[string]bool{idA: false}},
+			},
+		},
+		"Gone": {
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1")}, refresh: true, observe: []string{idA}},
+				{rows: []map[string]any{}, refresh: true, want: map[string]bool{idA: false}},
+				{rows: []map[string]any{row(idA, "1")}, refresh: true, want: map[string]bool{idA: false}},
+			},
+		},
+		"QueryFailure": {
+			fail: true,
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1")}, refresh: true, observe: []string{idA}, want: map[string]bool{idA: false}},
+			},
+		},
+		"UntrackedSubscription": {
+			steps: []step{
+				{rows: []map[string]any{row(idA, "1")}, refresh: true, want: map[string]bool{"/subscriptions/other/resourceGroups/a": false}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, f := newTestCache(t)
+			f.fail = tc.fail
+			for i, s := range tc.steps {
+				if s.rows != nil {
+					f.set(s.rows...)
+				}
+				if s.refresh {
+					c.Refresh(context.Background())
+				}
+				for _, id := range s.observe {
+					c.Observed(id)
+				}
+				for _, id := range s.forget {
+					c.Forget(id)
+				}
+				for id, want := range s.want {
+					if got := c.Unchanged(id); got != want {
+						t.Errorf("step %d: Unchanged(%s): want %t, got %t", i, id, want, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTrack(t *testing.T) {
+	c := NewCache()
+	if c.Tracked(subscriptionID, "a") {
+		t.Fatal("want the subscription not tracked")
+	}
+	c.Track(subscriptionID, "a", "", nil)
+	if !c.Tracked(subscriptionID, "a") {
+		t.Error("want the subscription tracked with the identity a")
+	}
+	if c.Tracked(subscriptionID, "b") {
+		t.Error("want the subscription not tracked with the identity b")
+	}
+	c.Track(subscriptionID, "b", "", nil)
+	if !c.Tracked(subscriptionID, "b") || c.Tracked(subscriptionID, "a") {
+		t.Error("want the subscription tracked with the rotated identity b only")
+	}
+	if len(c.subscriptions) != 1 {
+		t.Errorf("want a single subscription tracked, got %d", len(c.subscriptions))
+	}
+}
+
+func TestTrackEndpoint(t *testing.T) {
+	tracked, override := &fakeGraph{}, &fakeGraph{}
+	tracked.set(row(idA, "1"))
+	override.set(row(idA, "1"))
+	trackedSrv, overrideSrv := httptest.NewServer(tracked), httptest.NewServer(override)
+	defer trackedSrv.Close()
+	defer overrideSrv.Close()
+
+	cases := map[string]struct {
+		opts        []Option
+		wantTracked int
+	}{
+		"TrackedEndpoint": {
+			opts:        []Option{WithCredential(nil)},
+			wantTracked: 1,
+		},
+		"OverriddenEndpoint": {
+			opts: []Option{WithCredential(nil), WithEndpoint(overrideSrv.URL)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tracked.queries, override.queries = 0, 0
+			c := NewCache(tc.opts...)
+			c.Track(subscriptionID, "identity", trackedSrv.URL, nil)
+			c.Refresh(context.Background())
+			if tracked.queries != tc.wantTracked || override.queries != 1-tc.wantTracked {
+				t.Errorf("want %d queries of the tracked endpoint, got %d and %d of the overriding one", tc.wantTracked, tracked.queries, override.queries)
+			}
+		})
+	}
+}
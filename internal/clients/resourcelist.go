@@ -0,0 +1,401 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+const (
+	keyMaxItems        = "max_items"
+	keyOutputConfigMap = "output_config_map"
+	keyItemCount       = "item_count"
+	keyTruncated       = "truncated"
+	keyOutputRef       = "output_ref"
+
+	defaultMaxItems = 1000
+	maxMaxItems     = 10000
+
+	// maxStatusListOutput bounds the size of the output of a resource list
+	// kept in the status, larger outputs are stored in the ConfigMap.
+	maxStatusListOutput = 32 << 10
+	// maxConfigMapListOutput bounds the size of the output of a resource
+	// list stored in the ConfigMap, below the size limit of the object.
+	maxConfigMapListOutput = 900 << 10
+
+	errInvalidMaxItems          = "maxItems must be between 1 and 10000"
+	errInvalidOutputConfigMap   = "outputConfigMap must have a name and a namespace"
+	errInvalidExportValues      = "responseExportValues must be a list of paths or a map of JMESPath queries"
+	errExportValues             = "cannot export the response values"
+	errListOutputTooLarge       = "the output is too large for the status, set the outputConfigMap or lower the maxItems"
+	errListOutputTooLargeForMap = "the output is too large for the ConfigMap, lower the maxItems"
+	errGetListParams            = "cannot get the resource list parameters"
+	errSetListStatus            = "cannot set the resource list status"
+	errApplyListOutput          = "cannot store the output in the ConfigMap"
+)
+
+// resourceList is the configuration of a resource list resolved from the
+// managed resource.
+type resourceList struct {
+	maxItems int
+	// configMap is the ConfigMap large outputs are stored in, if any.
+	configMap *outputStore
+	// outputRef is the key of the output in the ConfigMap as of the last
+	// observation, if any.
+	outputRef string
+}
+
+func resolveResourceList(mg resource.Managed) (resourceList, error) {
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return resourceList{}, errors.New(errNotTerraformedKind)
+	}
+	params, err := tr.GetParameters()
+	if err != nil {
+		return resourceList{}, errors.Wrap(err, errGetListParams)
+	}
+	obs, err := tr.GetObservation()
+	if err != nil {
+		return resourceList{}, errors.Wrap(err, errGetListParams)
+	}
+	rl := resourceList{maxItems: defaultMaxItems}
+	if v, ok := params[keyMaxItems].(float64); ok {
+		rl.maxItems = int(v)
+	}
+	if rl.maxItems < 1 || rl.maxItems > maxMaxItems {
+		return resourceList{}, errors.New(errInvalidMaxItems)
+	}
+	rl.outputRef, _ = obs[keyOutputRef].(string)
+
+	p, ok := params[keyOutputConfigMap].(map[string]any)
+	if l, isList := params[keyOutputConfigMap].([]any); isList && len(l) == 1 {
+		// singleton lists are converted to embedded objects in the CRDs
+		// but may still be supplied in the Terraform shape
+		p, ok = l[0].(map[string]any)
+	}
+	switch {
+	case ok:
+		rl.configMap = &outputStore{kind: outputStoreConfigMap, namespace: mg.GetNamespace()}
+		rl.configMap.name, _ = p["name"].(string)
+		if ns, _ := p["namespace"].(string); ns != "" && rl.configMap.namespace == "" {
+			rl.configMap.namespace = ns
+		}
+		if rl.configMap.name == "" || rl.configMap.namespace == "" {
+			return resourceList{}, errors.New(errInvalidOutputConfigMap)
+		}
+	case mg.GetNamespace() != "":
+		// namespaced resource lists default to a ConfigMap of their own
+		rl.configMap = &outputStore{kind: outputStoreConfigMap, name: mg.GetName(), namespace: mg.GetNamespace()}
+	}
+	return rl, nil
+}
+
+// resourceListInterceptor replaces the reads of the azapi_resource_list data
+// source for the supplied resource list, so that the pages of the list
+// operation are no longer requested once the maximum number of items is
+// reached. The output is stored in the ConfigMap of the resource list when
+// it's too large for the status. Only the reads of the observations publish
+// the item count into the status, the creations and updates are
+// asynchronous.
+func resourceListInterceptor(kube client.Client, mg resource.Managed, newARMClient func() (*arm.Client, error)) (Interceptor, error) {
+	rl, err := resolveResourceList(mg)
+	if err != nil {
+		return Interceptor{}, err
+	}
+	list := func(ctx context.Context, raw tftypes.Value, state *tfsdk.State, diags *diag.Diagnostics) map[string]any {
+		status, err := rl.list(ctx, kube, mg, raw, state, newARMClient)
+		if err != nil {
+			diags.AddError("Failed to list resources", err.Error())
+			return nil
+		}
+		return status
+	}
+	return Interceptor{
+		Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, _ func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+			list(ctx, req.Plan.Raw, &resp.State, &resp.Diagnostics)
+		},
+		Read: func(ctx context.Context, req fwresource.ReadRequest, resp *fwresource.ReadResponse, _ func(context.Context, fwresource.ReadRequest, *fwresource.ReadResponse)) {
+			status := list(ctx, req.State.Raw, &resp.State, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if err := mg.(ujresource.Terraformed).SetObservation(status); err != nil { //nolint:forcetypeassert // checked while resolving the resource list
+				resp.Diagnostics.AddError(errSetListStatus, err.Error())
+			}
+		},
+		Update: func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, _ func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse)) {
+			list(ctx, req.Plan.Raw, &resp.State, &resp.Diagnostics)
+		},
+	}, nil
+}
+
+// list lists the resources with the arguments in the supplied plan or state
+// value into the supplied state and returns the status attributes of the
+// resource list that are not in the state.
+func (rl resourceList) list(ctx context.Context, kube client.Client, mg resource.Managed, raw tftypes.Value, state *tfsdk.State, newARMClient func() (*arm.Client, error)) (map[string]any, error) { //nolint:gocyclo // easier to follow as a unit
+	params, _ := tfValueToAny(raw).(map[string]any)
+	t, _ := params["type"].(string)
+	parentID, _ := params["parent_id"].(string)
+	resourceType, apiVersion, _ := strings.Cut(t, "@")
+	o := arm.ListOptions{MaxItems: rl.maxItems, Query: url.Values{}, Header: http.Header{}}
+	qp, _ := params["query_parameters"].(map[string]any)
+	for k, v := range qp {
+		l, _ := v.([]any)
+		for _, e := range l {
+			if s, ok := e.(string); ok {
+				o.Query.Add(k, s)
+			}
+		}
+	}
+	h, _ := params["headers"].(map[string]any)
+	for k, v := range h {
+		if s, ok := v.(string); ok {
+			o.Header.Set(k, s)
+		}
+	}
+
+	c, err := newARMClient()
+	if err != nil {
+		return nil, err
+	}
+	listPath := arm.ListPath(parentID, resourceType)
+	items, truncated, err := c.List(ctx, listPath, apiVersion, o)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []any{}
+	}
+	output, err := exportValues(map[string]any{"value": items}, params["response_export_values"])
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(output)
+	if err != nil {
+		return nil, errors.Wrap(err, errExportValues)
+	}
+	status := map[string]any{
+		keyItemCount: int64(len(items)),
+		keyTruncated: truncated,
+		keyOutputRef: nil,
+	}
+	switch {
+	case len(b) > maxStatusListOutput:
+		if rl.configMap == nil {
+			return nil, errors.New(errListOutputTooLarge)
+		}
+		if len(b) > maxConfigMapListOutput {
+			return nil, errors.New(errListOutputTooLargeForMap)
+		}
+		ref := mg.GetName() + ".json"
+		if err := applyListOutput(ctx, kube, mg, *rl.configMap, ref, string(b)); err != nil {
+			return nil, err
+		}
+		status[keyOutputRef] = ref
+		output = nil
+	case rl.outputRef != "" && rl.configMap != nil:
+		// the output fits in the status again
+		if err := applyListOutput(ctx, kube, mg, *rl.configMap, rl.outputRef, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	state.Raw, err = tftypes.Transform(raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), listPath)...)
+	ov := types.DynamicNull()
+	if output != nil {
+		v, d := dynamicValue(output)
+		diags.Append(d...)
+		ov = types.DynamicValue(v)
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("output"), ov)...)
+	if diags.HasError() {
+		return nil, errors.Errorf("%s: %s", diags.Errors()[0].Summary(), diags.Errors()[0].Detail())
+	}
+	return status, nil
+}
+
+// applyListOutput stores the supplied output of a resource list under the
+// supplied key of its ConfigMap, creating the ConfigMap if it does not
+// exist. An empty output removes the key.
+func applyListOutput(ctx context.Context, kube client.Client, mg resource.Managed, s outputStore, ref, output string) error {
+	owner, err := outputStoreOwner(kube, mg)
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{}
+	err = kube.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: s.name}, cm)
+	if resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errApplyListOutput)
+	}
+	create := kerrors.IsNotFound(err)
+	if create && output == "" {
+		return nil
+	}
+	if create {
+		cm.SetNamespace(s.namespace)
+		cm.SetName(s.name)
+	}
+	if !hasOwner(cm, owner.UID) {
+		cm.SetOwnerReferences(append(cm.GetOwnerReferences(), owner))
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	if output == "" {
+		delete(cm.Data, ref)
+	} else {
+		cm.Data[ref] = output
+	}
+	if create {
+		return errors.Wrap(kube.Create(ctx, cm), errApplyListOutput)
+	}
+	return errors.Wrap(kube.Update(ctx, cm), errApplyListOutput)
+}
+
+// exportValues returns the values of the supplied response body exported
+// per the supplied response_export_values, which is either a list of paths,
+// where * exports the full body, or a map of names to JMESPath queries, as
+// with the azapi data sources.
+func exportValues(body any, exports any) (any, error) {
+	switch e := exports.(type) {
+	case nil:
+		return map[string]any{}, nil
+	case []any:
+		output := map[string]any{}
+		for _, p := range e {
+			s, ok := p.(string)
+			if !ok {
+				return nil, errors.New(errInvalidExportValues)
+			}
+			if s == "*" {
+				return body, nil
+			}
+			if part := extractPath(body, s); part != nil {
+				mergeObjects(output, part)
+			}
+		}
+		return output, nil
+	case map[string]any:
+		output := make(map[string]any, len(e))
+		for k, q := range e {
+			s, ok := q.(string)
+			if !ok {
+				return nil, errors.New(errInvalidExportValues)
+			}
+			v, err := jmespath.Search(s, body)
+			if err != nil {
+				return nil, errors.Wrap(err, errExportValues)
+			}
+			output[k] = v
+		}
+		return output, nil
+	}
+	return nil, errors.New(errInvalidExportValues)
+}
+
+// extractPath returns the value at the supplied dot separated path of the
+// supplied object nested in objects along the path, or nil if there's no
+// value at the path.
+func extractPath(v any, p string) map[string]any {
+	segments := strings.Split(p, ".")
+	for _, s := range segments {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if v, ok = m[s]; !ok {
+			return nil
+		}
+	}
+	for i := len(segments) - 1; i > 0; i-- {
+		v = map[string]any{segments[i]: v}
+	}
+	return map[string]any{segments[0]: v}
+}
+
+// mergeObjects merges the supplied src object into the supplied dst object.
+func mergeObjects(dst, src map[string]any) {
+	for k, v := range src {
+		dm, dok := dst[k].(map[string]any)
+		sm, sok := v.(map[string]any)
+		if dok && sok {
+			mergeObjects(dm, sm)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// dynamicValue returns the Terraform value of the supplied JSON compatible
+// Go value, with the types implied by the value.
+func dynamicValue(v any) (attr.Value, diag.Diagnostics) {
+	switch t := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(t), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(t)), nil
+	case string:
+		return types.StringValue(t), nil
+	case []any:
+		elemTypes := make([]attr.Type, len(t))
+		elems := make([]attr.Value, len(t))
+		var diags diag.Diagnostics
+		for i, e := range t {
+			ev, d := dynamicValue(e)
+			diags.Append(d...)
+			elemTypes[i], elems[i] = ev.Type(context.Background()), ev
+		}
+		tv, d := types.TupleValue(elemTypes, elems)
+		return tv, append(diags, d...)
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(t))
+		attrs := make(map[string]attr.Value, len(t))
+		var diags diag.Diagnostics
+		for k, e := range t {
+			ev, d := dynamicValue(e)
+			diags.Append(d...)
+			attrTypes[k], attrs[k] = ev.Type(context.Background()), ev
+		}
+		ov, d := types.ObjectValue(attrTypes, attrs)
+		return ov, append(diags, d...)
+	}
+	var diags diag.Diagnostics
+	diags.AddError(errExportValues, "unsupported value type")
+	return types.StringNull(), diags
+}
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	namespaceddatav1alpha1 "github.com/upbound/provider-azapi/v2/apis/namespaced/data/v1alpha1"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+var testListSchema = schema.Schema{Attributes: map[string]schema.Attribute{
+	"type":                   schema.StringAttribute{Required: true},
+	"parent_id":              schema.StringAttribute{Required: true},
+	"response_export_values": schema.DynamicAttribute{Optional: true},
+	"id":                     schema.StringAttribute{Computed: true},
+	"output":                 schema.DynamicAttribute{Computed: true},
+}}
+
+// testListConfig returns the configuration of a resource list of the
+// virtual networks of a resource group exporting the supplied values.
+func testListConfig(exports any) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":                   tftypes.String,
+		"parent_id":              tftypes.String,
+		"response_export_values": tftypes.DynamicPseudoType,
+		"id":                     tftypes.String,
+		"output":                 tftypes.DynamicPseudoType,
+	}}, map[string]tftypes.Value{
+		"type":                   tftypes.NewValue(tftypes.String, "Microsoft.Network/virtualNetworks@2023-04-01"),
+		"parent_id":              tftypes.NewValue(tftypes.String, "/subscriptions/sub/resourceGroups/rg"),
+		"response_export_values": tfValueOf(exports),
+		"id":                     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"output":                 tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+	})
+}
+
+// testListServer serves the supplied number of pages of two items of
+// roughly the supplied size and counts the pages requested.
+func testListServer(pages, itemSize int, requested *int) *httptest.Server {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		body := map[string]any{"value": []any{
+			map[string]any{"name": "vnet-" + strconv.Itoa(2*page), "etag": strings.Repeat("x", itemSize)},
+			map[string]any{"name": "vnet-" + strconv.Itoa(2*page+1), "etag": strings.Repeat("x", itemSize)},
+		}}
+		if page+1 < pages {
+			body["nextLink"] = srv.URL + r.URL.Path + "?api-version=2023-04-01&page=" + strconv.Itoa(page+1)
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	return srv
+}
+
+func TestResourceListList(t *testing.T) {
+	configMap := &outputStore{kind: outputStoreConfigMap, name: "rl", namespace: "ns"}
+	cases := map[string]struct {
+		rl            resourceList
+		pages         int
+		itemSize      int
+		exports       any
+		wantRequested int
+		wantStatus    map[string]any
+		wantConfigMap bool
+		wantErr       string
+	}{
+		"AllPages": {
+			rl:            resourceList{maxItems: 10},
+			pages:         3,
+			wantRequested: 3,
+			wantStatus:    map[string]any{keyItemCount: int64(6), keyTruncated: false, keyOutputRef: nil},
+		},
+		"MaxItemsWithinPage": {
+			rl:            resourceList{maxItems: 3},
+			pages:         3,
+			wantRequested: 2,
+			wantStatus:    map[string]any{keyItemCount: int64(3), keyTruncated: true, keyOutputRef: nil},
+		},
+		"MaxItemsAtPageEnd": {
+			rl:            resourceList{maxItems: 4},
+			pages:         3,
+			wantRequested: 2,
+			wantStatus:    map[string]any{keyItemCount: int64(4), keyTruncated: true, keyOutputRef: nil},
+		},
+		"MaxItemsAtLastPage": {
+			rl:            resourceList{maxItems: 6},
+			pages:         3,
+			wantRequested: 3,
+			wantStatus:    map[string]any{keyItemCount: int64(6), keyTruncated: false, keyOutputRef: nil},
+		},
+		"TooLargeForStatus": {
+			rl:            resourceList{maxItems: 10},
+			pages:         1,
+			itemSize:      maxStatusListOutput / 2,
+			exports:       []any{"*"},
+			wantRequested: 1,
+			wantErr:       errListOutputTooLarge,
+		},
+		"StoredInConfigMap": {
+			rl:            resourceList{maxItems: 10, configMap: configMap},
+			pages:         1,
+			itemSize:      maxStatusListOutput / 2,
+			exports:       []any{"*"},
+			wantRequested: 1,
+			wantStatus:    map[string]any{keyItemCount: int64(2), keyTruncated: false, keyOutputRef: "rl.json"},
+			wantConfigMap: true,
+		},
+		"TooLargeForConfigMap": {
+			rl:            resourceList{maxItems: 10, configMap: configMap},
+			pages:         1,
+			itemSize:      maxConfigMapListOutput / 2,
+			exports:       []any{"*"},
+			wantRequested: 1,
+			wantErr:       errListOutputTooLargeForMap,
+		},
+		"BackInStatus": {
+			rl:            resourceList{maxItems: 10, configMap: configMap, outputRef: "rl.json"},
+			pages:         1,
+			exports:       []any{"*"},
+			wantRequested: 1,
+			wantStatus:    map[string]any{keyItemCount: int64(2), keyTruncated: false, keyOutputRef: nil},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var requested int
+			srv := testListServer(tc.pages, tc.itemSize, &requested)
+			defer srv.Close()
+
+			s := runtime.NewScheme()
+			if err := namespaceddatav1alpha1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			mg := &namespaceddatav1alpha1.ResourceList{ObjectMeta: metav1.ObjectMeta{Name: "rl", Namespace: "ns", UID: "uid"}}
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "rl", Namespace: "ns"}}
+			if tc.rl.outputRef != "" {
+				cm.Data = map[string]string{tc.rl.outputRef: "{}"}
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(cm).Build()
+			newARMClient := func() (*arm.Client, error) {
+				return arm.NewClient(nil, arm.WithEndpoint(srv.URL)), nil
+			}
+
+			state := &tfsdk.State{Schema: testListSchema}
+			status, err := tc.rl.list(context.Background(), kube, mg, testListConfig(tc.exports), state, newARMClient)
+			if requested != tc.wantRequested {
+				t.Errorf("want %d pages requested, got %d", tc.wantRequested, requested)
+			}
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.wantStatus, status) {
+				t.Errorf("want the status %v, got %v", tc.wantStatus, status)
+			}
+			if err := kube.Get(context.Background(), client.ObjectKeyFromObject(cm), cm); err != nil {
+				t.Fatal(err)
+			}
+			if _, stored := cm.Data["rl.json"]; stored != tc.wantConfigMap {
+				t.Errorf("want the output stored in the ConfigMap %t, got %t", tc.wantConfigMap, stored)
+			}
+			values, _ := goValueOf(state.Raw).(map[string]any)
+			if got, want := values["id"], "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks"; got != want {
+				t.Errorf("want the ID %q, got %v", want, got)
+			}
+			if gotOutput := values["output"] != nil; gotOutput == tc.wantConfigMap {
+				t.Errorf("want the output in the state %t, got %t", !tc.wantConfigMap, gotOutput)
+			}
+		})
+	}
+}
+
+func TestExportValues(t *testing.T) {
+	body := map[string]any{
+		"name": "vnet",
+		"properties": map[string]any{
+			"addressSpace":      map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}},
+			"provisioningState": "Succeeded",
+			"subnets":           []any{map[string]any{"name": "a"}},
+		},
+	}
+	cases := map[string]struct {
+		exports any
+		want    any
+		wantErr bool
+	}{
+		"None": {
+			want: map[string]any{},
+		},
+		"All": {
+			exports: []any{"name", "*"},
+			want:    body,
+		},
+		"Paths": {
+			exports: []any{"name", "properties.addressSpace", "properties.provisioningState", "properties.missing", "name.missing"},
+			want: map[string]any{
+				"name": "vnet",
+				"properties": map[string]any{
+					"addressSpace":      map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}},
+					"provisioningState": "Succeeded",
+				},
+			},
+		},
+		"Queries": {
+			exports: map[string]any{"subnets": "properties.subnets[].name", "state": "properties.provisioningState"},
+			want:    map[string]any{"subnets": []any{"a"}, "state": "Succeeded"},
+		},
+		"InvalidPath": {
+			exports: []any{float64(1)},
+			wantErr: true,
+		},
+		"InvalidQuery": {
+			exports: map[string]any{"q": "properties.["},
+			wantErr: true,
+		},
+		"InvalidType": {
+			exports: "name",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := exportValues(body, tc.exports)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("want error %t, got %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
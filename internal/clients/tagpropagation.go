@@ -0,0 +1,349 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"math/big"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	namespacedv1beta1 "github.com/upbound/provider-azapi/v2/apis/namespaced/v1beta1"
+	"github.com/upbound/provider-azapi/v2/config/typed"
+)
+
+const (
+	keyTags           = "tags"
+	keyType           = "type"
+	keyPropagatedTags = "propagated_tags"
+
+	// TagKind is the tag the kind of the managed resource is propagated
+	// into, e.g. Resource.resources.azapi.m.upbound.io.
+	TagKind = ManagedTagPrefix + "kind"
+	// TagName is the tag the name of the managed resource is propagated
+	// into.
+	TagName = ManagedTagPrefix + "name"
+	// TagNamespace is the tag the namespace of the managed resource is
+	// propagated into.
+	TagNamespace = ManagedTagPrefix + "namespace"
+	// TagComposite is the tag the name of the composite resource of the
+	// managed resource is propagated into.
+	TagComposite = ManagedTagPrefix + "composite"
+
+	labelComposite = "crossplane.io/composite"
+
+	// the maximum lengths of the names and the values of the tags
+	maxTagNameLength  = 512
+	maxTagValueLength = 256
+
+	errInvalidTagPatternFmt = "invalid tag propagation pattern %q"
+	errTagCollisionFmt      = "the %s %q and %q are both propagated into the tag %q, map one of them to another tag or exclude it"
+	errSetPropagatedTags    = "cannot set the propagated tags in status"
+	errGetPropagatedTags    = "cannot get the previously propagated tags"
+	errPropagateTags        = "cannot propagate the tags"
+)
+
+// the characters that are not allowed in the names of the tags
+var invalidTagNameChars = strings.NewReplacer("<", "-", ">", "-", "%", "-", "&", "-", `\`, "-", "?", "-", "/", "-")
+
+// propagatedTags returns the tags propagated from the metadata of the
+// supplied managed resource by the supplied tag propagation. The annotations
+// take precedence over the labels, which take precedence over the
+// Crossplane metadata. It returns an error if two labels or two annotations
+// are propagated into the same tag, e.g. a/b and a-b.
+func propagatedTags(kube client.Client, mg resource.Managed, tp *namespacedv1beta1.TagPropagation) (map[string]string, error) {
+	tags := map[string]string{}
+	if tp.CrossplaneMetadata == nil || *tp.CrossplaneMetadata {
+		if gvk, err := apiutil.GVKForObject(mg, kube.Scheme()); err == nil {
+			tags[TagKind] = gvk.GroupKind().String()
+		}
+		tags[TagName] = mg.GetName()
+		if ns := mg.GetNamespace(); ns != "" {
+			tags[TagNamespace] = ns
+		}
+		if c := mg.GetLabels()[labelComposite]; c != "" {
+			tags[TagComposite] = c
+		}
+	}
+	for _, s := range []struct {
+		kind     string
+		selector *namespacedv1beta1.TagSelector
+		values   map[string]string
+	}{
+		{kind: "labels", selector: tp.Labels, values: mg.GetLabels()},
+		{kind: "annotations", selector: tp.Annotations, values: mg.GetAnnotations()},
+	} {
+		if s.selector == nil {
+			continue
+		}
+		keys := make([]string, 0, len(s.values))
+		for k := range s.values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		// the keys propagated into each tag
+		propagated := make(map[string]string, len(keys))
+		for _, k := range keys {
+			ok, err := selected(s.selector, k)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			name, ok := s.selector.Mappings[k]
+			if !ok {
+				name = s.selector.Prefix + invalidTagNameChars.Replace(k)
+			}
+			name = truncate(name, maxTagNameLength)
+			if other, ok := propagated[name]; ok {
+				return nil, errors.Errorf(errTagCollisionFmt, s.kind, other, k, name)
+			}
+			propagated[name] = k
+			tags[name] = truncate(s.values[k], maxTagValueLength)
+		}
+	}
+	return tags, nil
+}
+
+// selected returns whether the supplied key is selected by the supplied
+// selector.
+func selected(s *namespacedv1beta1.TagSelector, key string) (bool, error) {
+	for _, p := range s.Exclude {
+		ok, err := path.Match(p, key)
+		if err != nil {
+			return false, errors.Wrapf(err, errInvalidTagPatternFmt, p)
+		}
+		if ok {
+			return false, nil
+		}
+	}
+	if len(s.Include) == 0 {
+		return true, nil
+	}
+	for _, p := range s.Include {
+		ok, err := path.Match(p, key)
+		if err != nil {
+			return false, errors.Wrapf(err, errInvalidTagPatternFmt, p)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// tagPropagationInterceptor records the tags propagated from the metadata
+// of the supplied managed resource by the tag propagation of its provider
+// config in its status and returns the interceptor adding them to the tags
+// passed to the Terraform provider. The tags set in the managed resource,
+// either in its tags or in the tags of its body, take precedence over the
+// propagated ones. It returns nil if the tag propagation is disabled.
+func tagPropagationInterceptor(kube client.Client, mg resource.Managed, pcSpec *namespacedv1beta1.ProviderConfigSpec) (*Interceptor, error) {
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return nil, errors.New(errNotTerraformedKind)
+	}
+	if pcSpec == nil || pcSpec.TagPropagation == nil {
+		return nil, errors.Wrap(tr.SetObservation(map[string]any{keyPropagatedTags: nil}), errSetPropagatedTags)
+	}
+	tags, err := propagatedTags(kube, mg, pcSpec.TagPropagation)
+	if err != nil {
+		return nil, errors.Wrap(err, errPropagateTags)
+	}
+	obs, err := tr.GetObservation()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPropagatedTags)
+	}
+	previous, _ := obs[keyPropagatedTags].(map[string]any)
+	if err := tr.SetObservation(map[string]any{keyPropagatedTags: tags}); err != nil {
+		return nil, errors.Wrap(err, errSetPropagatedTags)
+	}
+	p := &tagPropagator{tags: tags, previous: previous, inBody: terraformResourceType(mg) == resourceTypeAzAPIUpdateResource}
+	return p.interceptor(), nil
+}
+
+// tagPropagator adds the propagated tags to the resource objects passed to
+// the Terraform provider.
+type tagPropagator struct {
+	tags map[string]string
+	// the tags propagated at the previous reconciliation
+	previous map[string]any
+	// whether the tags are set in the body instead of the tags argument,
+	// e.g. for the azapi_update_resources, which have no tags argument
+	inBody bool
+}
+
+// apply returns the supplied resource object with the propagated tags. The
+// tags of the prior state are kept if the object has no tags, except the
+// ones previously propagated, so that the tags set outside of Crossplane
+// are not removed. The objects of the resource types without tags, e.g.
+// subnets, are returned as they are.
+func (p *tagPropagator) apply(raw, state tftypes.Value) (tftypes.Value, error) {
+	if raw.IsNull() || !raw.IsKnown() {
+		return raw, nil
+	}
+	attrs := map[string]tftypes.Value{}
+	if err := raw.As(&attrs); err != nil {
+		return raw, err
+	}
+	if t, _ := goValueOf(attrs[keyType]).(string); !typed.Taggable(t) {
+		return raw, nil
+	}
+	body, _ := goValueOf(attrs[keyBody]).(map[string]any)
+	bodyTags, _ := body[keyTags].(map[string]any)
+	// the tags of the body and the tags argument are mutually exclusive
+	if p.inBody || bodyTags != nil {
+		if body == nil {
+			body = map[string]any{}
+		}
+		body[keyTags] = p.merge(nil, bodyTags)
+		return withAttribute(raw, keyBody, tfValueOf(body))
+	}
+	tags, ok := attrs[keyTags]
+	if !ok {
+		return raw, nil
+	}
+	userTags, _ := goValueOf(tags).(map[string]any)
+	var base map[string]any
+	if userTags == nil && !state.IsNull() && state.IsKnown() {
+		stateAttrs := map[string]tftypes.Value{}
+		if err := state.As(&stateAttrs); err != nil {
+			return raw, err
+		}
+		base, _ = goValueOf(stateAttrs[keyTags]).(map[string]any)
+	}
+	merged := p.merge(base, userTags)
+	if len(merged) == 0 {
+		return raw, nil
+	}
+	values := make(map[string]tftypes.Value, len(merged))
+	for k, v := range merged {
+		values[k] = tftypes.NewValue(tftypes.String, v)
+	}
+	typ := tags.Type()
+	if !typ.Is(tftypes.Map{}) {
+		typ = tftypes.Map{ElementType: tftypes.String}
+	}
+	return withAttribute(raw, keyTags, tftypes.NewValue(typ, values))
+}
+
+// merge returns the supplied base tags without the previously propagated
+// ones, with the propagated tags and the supplied user tags, which take
+// precedence.
+func (p *tagPropagator) merge(base map[string]any, user ...map[string]any) map[string]any {
+	result := map[string]any{}
+	for k, v := range base {
+		if _, ok := p.previous[k]; !ok {
+			result[k] = v
+		}
+	}
+	for k, v := range p.tags {
+		result[k] = v
+	}
+	for _, u := range user {
+		for k, v := range u {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// interceptor returns the interceptor adding the tags to the resource
+// objects passed to the Terraform provider.
+func (p *tagPropagator) interceptor() *Interceptor {
+	return &Interceptor{
+		ModifyPlan: func(ctx context.Context, req fwresource.ModifyPlanRequest, resp *fwresource.ModifyPlanResponse, next func(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse)) {
+			var err error
+			if req.Config.Raw, err = p.apply(req.Config.Raw, req.State.Raw); err == nil {
+				if req.Plan.Raw, err = p.apply(req.Plan.Raw, req.State.Raw); err == nil {
+					resp.Plan.Raw, err = p.apply(resp.Plan.Raw, req.State.Raw)
+				}
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(errPropagateTags, err.Error())
+				return
+			}
+			next(ctx, req, resp)
+		},
+		Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, next func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+			var err error
+			if req.Config.Raw, err = p.apply(req.Config.Raw, tftypes.Value{}); err == nil {
+				req.Plan.Raw, err = p.apply(req.Plan.Raw, tftypes.Value{})
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(errPropagateTags, err.Error())
+				return
+			}
+			next(ctx, req, resp)
+		},
+		Update: func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, next func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse)) {
+			var err error
+			if req.Config.Raw, err = p.apply(req.Config.Raw, req.State.Raw); err == nil {
+				req.Plan.Raw, err = p.apply(req.Plan.Raw, req.State.Raw)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(errPropagateTags, err.Error())
+				return
+			}
+			next(ctx, req, resp)
+		},
+	}
+}
+
+// goValueOf returns the JSON value of the supplied Terraform value, nil if
+// it's null or unknown.
+func goValueOf(v tftypes.Value) any { //nolint:gocyclo // a single type switch
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	switch {
+	case v.Type().Is(tftypes.Object{}), v.Type().Is(tftypes.Map{}):
+		m := map[string]tftypes.Value{}
+		if err := v.As(&m); err != nil {
+			return nil
+		}
+		result := make(map[string]any, len(m))
+		for k, e := range m {
+			result[k] = goValueOf(e)
+		}
+		return result
+	case v.Type().Is(tftypes.Tuple{}), v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}):
+		var l []tftypes.Value
+		if err := v.As(&l); err != nil {
+			return nil
+		}
+		result := make([]any, len(l))
+		for i, e := range l {
+			result[i] = goValueOf(e)
+		}
+		return result
+	case v.Type().Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return s
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return b
+	case v.Type().Is(tftypes.Number):
+		f := new(big.Float)
+		if err := v.As(&f); err != nil {
+			return nil
+		}
+		n, _ := f.Float64()
+		return n
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	namespacedv1beta1 "github.com/upbound/provider-azapi/v2/apis/namespaced/v1beta1"
+)
+
+func TestPropagatedTags(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1beta2.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).Build()
+	mg := &v1beta2.Resource{ObjectMeta: metav1.ObjectMeta{
+		Name: "sa",
+		Labels: map[string]string{
+			"app.kubernetes.io/name":    "web",
+			"app.kubernetes.io/part-of": "shop",
+			"team":                      "payments",
+			labelComposite:              "shop-xyz",
+		},
+		Annotations: map[string]string{
+			"team":          "billing",
+			"cost/center":   "42",
+			"cost-center":   "43",
+			"internal/note": "x",
+		},
+	}}
+
+	cases := map[string]struct {
+		tp      *namespacedv1beta1.TagPropagation
+		want    map[string]string
+		wantErr string
+	}{
+		"CrossplaneMetadata": {
+			tp: &namespacedv1beta1.TagPropagation{},
+			want: map[string]string{
+				TagKind:      "Resource.resources.azapi.upbound.io",
+				TagName:      "sa",
+				TagComposite: "shop-xyz",
+			},
+		},
+		"IncludeExcludeMappingsPrefix": {
+			tp: &namespacedv1beta1.TagPropagation{
+				CrossplaneMetadata: ptr(false),
+				Labels: &namespacedv1beta1.TagSelector{
+					Include:  []string{"app.kubernetes.io/*"},
+					Exclude:  []string{"app.kubernetes.io/part-of"},
+					Mappings: map[string]string{"app.kubernetes.io/name": "application"},
+				},
+				Annotations: &namespacedv1beta1.TagSelector{
+					Include: []string{"cost/*"},
+					Prefix:  "k8s-",
+				},
+			},
+			want: map[string]string{
+				"application":     "web",
+				"k8s-cost-center": "42",
+			},
+		},
+		"AnnotationsOverLabels": {
+			tp: &namespacedv1beta1.TagPropagation{
+				CrossplaneMetadata: ptr(false),
+				Labels:             &namespacedv1beta1.TagSelector{Include: []string{"team"}},
+				Annotations:        &namespacedv1beta1.TagSelector{Include: []string{"team"}},
+			},
+			want: map[string]string{"team": "billing"},
+		},
+		"Collision": {
+			tp: &namespacedv1beta1.TagPropagation{
+				Annotations: &namespacedv1beta1.TagSelector{Include: []string{"cost-center", "cost/center"}},
+			},
+			wantErr: `the annotations "cost-center" and "cost/center" are both propagated into the tag "cost-center", map one of them to another tag or exclude it`,
+		},
+		"MappingCollision": {
+			tp: &namespacedv1beta1.TagPropagation{
+				Labels: &namespacedv1beta1.TagSelector{
+					Include:  []string{"app.kubernetes.io/name", "team"},
+					Mappings: map[string]string{"app.kubernetes.io/name": "team"},
+				},
+			},
+			wantErr: `the labels "app.kubernetes.io/name" and "team" are both propagated into the tag "team", map one of them to another tag or exclude it`,
+		},
+		"InvalidPattern": {
+			tp: &namespacedv1beta1.TagPropagation{
+				Labels: &namespacedv1beta1.TagSelector{Exclude: []string{"["}},
+			},
+			wantErr: `invalid tag propagation pattern "["`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := propagatedTags(kube, mg, tc.tp)
+			if tc.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// testTagsObject returns a resource object with the supplied type, body and
+// tags, which are null if nil.
+func testTagsObject(resourceType string, body map[string]any, tags map[string]string) tftypes.Value {
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+	tagsValue := tftypes.NewValue(tagsType, nil)
+	if tags != nil {
+		values := make(map[string]tftypes.Value, len(tags))
+		for k, v := range tags {
+			values[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		tagsValue = tftypes.NewValue(tagsType, values)
+	}
+	bodyValue := tftypes.NewValue(tftypes.DynamicPseudoType, nil)
+	if body != nil {
+		bodyValue = tfValueOf(body)
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		keyType: tftypes.String,
+		keyBody: tftypes.DynamicPseudoType,
+		keyTags: tagsType,
+	}}, map[string]tftypes.Value{
+		keyType: tftypes.NewValue(tftypes.String, resourceType),
+		keyBody: bodyValue,
+		keyTags: tagsValue,
+	})
+}
+
+func TestTagPropagatorApply(t *testing.T) {
+	const (
+		account = "Microsoft.Storage/storageAccounts@2023-05-01"
+		subnet  = "Microsoft.Network/virtualNetworks/subnets@2023-04-01"
+	)
+	propagated := map[string]string{"crossplane-name": "sa", "team": "payments"}
+
+	cases := map[string]struct {
+		p     *tagPropagator
+		raw   tftypes.Value
+		state tftypes.Value
+		want  tftypes.Value
+	}{
+		"UserTagsNotClobbered": {
+			p:    &tagPropagator{tags: propagated},
+			raw:  testTagsObject(account, nil, map[string]string{"team": "billing", "env": "dev"}),
+			want: testTagsObject(account, nil, map[string]string{"crossplane-name": "sa", "team": "billing", "env": "dev"}),
+		},
+		"StateTagsKeptWithoutPreviouslyPropagated": {
+			p:     &tagPropagator{tags: propagated, previous: map[string]any{"crossplane-namespace": "old"}},
+			raw:   testTagsObject(account, nil, nil),
+			state: testTagsObject(account, nil, map[string]string{"crossplane-namespace": "old", "owner": "ops"}),
+			want:  testTagsObject(account, nil, map[string]string{"crossplane-name": "sa", "team": "payments", "owner": "ops"}),
+		},
+		"BodyTags": {
+			p:    &tagPropagator{tags: propagated},
+			raw:  testTagsObject(account, map[string]any{"tags": map[string]any{"team": "billing"}}, nil),
+			want: testTagsObject(account, map[string]any{"tags": map[string]any{"crossplane-name": "sa", "team": "billing"}}, nil),
+		},
+		"InBody": {
+			p:    &tagPropagator{tags: propagated, inBody: true},
+			raw:  testTagsObject(account, map[string]any{"properties": map[string]any{}}, nil),
+			want: testTagsObject(account, map[string]any{"properties": map[string]any{}, "tags": map[string]any{"crossplane-name": "sa", "team": "payments"}}, nil),
+		},
+		"Untaggable": {
+			p:    &tagPropagator{tags: propagated},
+			raw:  testTagsObject(subnet, map[string]any{"properties": map[string]any{}}, nil),
+			want: testTagsObject(subnet, map[string]any{"properties": map[string]any{}}, nil),
+		},
+		"UntaggableInBody": {
+			p:    &tagPropagator{tags: propagated, inBody: true},
+			raw:  testTagsObject(subnet, nil, nil),
+			want: testTagsObject(subnet, nil, nil),
+		},
+		"Null": {
+			p:    &tagPropagator{tags: propagated},
+			raw:  tftypes.NewValue(tftypes.Object{}, nil),
+			want: tftypes.NewValue(tftypes.Object{}, nil),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.p.apply(tc.raw, tc.state)
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(goValueOf(tc.want), goValueOf(got)) {
+				t.Errorf("want %v, got %v", goValueOf(tc.want), goValueOf(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,489 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	ujresource "github.com/crossplane/upjet/v2/pkg/resource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+
+	namespacedv1beta1 "github.com/upbound/provider-azapi/v2/apis/namespaced/v1beta1"
+)
+
+const (
+	resourceTypeAzAPIUpdateResource = "azapi_update_resource"
+
+	keyBody           = "body"
+	keyBodyTemplating = "body_templating"
+	keyRenderedBody   = "rendered_body"
+
+	// maxRenderedSize is the maximum size of the output of a template.
+	maxRenderedSize = 1 << 20
+	// maxRangeIterations is the maximum number of iterations of the ranges
+	// of a template, all together.
+	maxRangeIterations = 10000
+	// funcRangeIteration is the function called at each iteration of the
+	// ranges of a template, which cannot be called by the templates.
+	funcRangeIteration = "rangeIteration"
+	// maxFormatWidth is the maximum width and precision of the verbs of the
+	// printf formats of a template.
+	maxFormatWidth = 100
+
+	errGetTemplatingParams = "cannot get the body templating parameters"
+	errRenderBody          = "cannot render the body"
+	errRenderValueFmt      = "cannot render the body value at %s"
+	errSetRenderedBody     = "cannot set the rendered body in status"
+	errTemplateActionFmt   = "%s actions are not allowed in body templates"
+	errTemplateRange       = "ranges are only allowed over the fields of the template data in body templates"
+	errTemplateIterations  = "the ranges of the body template exceed 10000 iterations"
+	errTemplateOutputSize  = "the output of the body template exceeds 1 MiB"
+	errTemplateValueSize   = "a value of the body template exceeds 1 MiB"
+	errTemplateFormat      = "the widths and precisions of the printf verbs of body templates must be numbers of at most 100"
+	errReplaceBody         = "cannot replace the body with the rendered body"
+)
+
+// the resource types whose body can be templated
+var templatedResourceTypes = map[string]bool{
+	resourceTypeAzAPIResource:          true,
+	resourceTypeAzAPIUpdateResource:    true,
+	resourceTypeAzAPIDataPlaneResource: true,
+}
+
+// singleAction matches the template strings that consist of a single
+// action, whose value replaces the string.
+var singleAction = regexp.MustCompile(`^\{\{-?\s*(.*?)\s*-?\}\}$`)
+
+// templateData is the data the body templates are executed with. It only
+// has plain values, so that the templates cannot call any method.
+type templateData struct {
+	Metadata    templateMetadata
+	Labels      map[string]string
+	Annotations map[string]string
+	Defaults    templateDefaults
+}
+
+type templateMetadata struct {
+	Name        string
+	Namespace   string
+	UID         string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+type templateDefaults struct {
+	Location string
+	Tags     map[string]string
+}
+
+// templateFuncs are the functions available in the body templates, in
+// addition to the builtin ones, some of which they replace with versions
+// whose output is limited before it's allocated. None of them has side
+// effects.
+var templateFuncs = template.FuncMap{
+	"printf": func(format string, args ...any) (string, error) {
+		if err := checkFormat(format); err != nil {
+			return "", err
+		}
+		return limited(fmt.Sprintf(format, args...))
+	},
+	"print":   func(args ...any) (string, error) { return limited(fmt.Sprint(args...)) },
+	"println": func(args ...any) (string, error) { return limited(fmt.Sprintln(args...)) },
+	"toJson": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"merge": func(maps ...map[string]string) map[string]string {
+		result := map[string]string{}
+		for _, m := range maps {
+			for k, v := range m {
+				result[k] = v
+			}
+		}
+		return result
+	},
+	"default": func(d, v any) any {
+		if s, ok := v.(string); v == nil || (ok && s == "") {
+			return d
+		}
+		return v
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"replace": func(old, new, s string) (string, error) {
+		n := strings.Count(s, old)
+		if len(s)+n*(len(new)-len(old)) > maxRenderedSize {
+			return "", errors.New(errTemplateValueSize)
+		}
+		return strings.ReplaceAll(s, old, new), nil
+	},
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+}
+
+// limited returns the supplied value of a template, or an error if it
+// exceeds the maximum output size of the templates.
+func limited(s string) (string, error) {
+	if len(s) > maxRenderedSize {
+		return "", errors.New(errTemplateValueSize)
+	}
+	return s, nil
+}
+
+// checkFormat returns an error if a verb of the supplied printf format has a
+// width or a precision that is not a number or is larger than
+// maxFormatWidth, as the padding is allocated before the output is limited.
+// The arguments are limited by the size of the template data.
+func checkFormat(format string) error {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		// the flags, the argument indexes, the width and the precision
+		// precede the verb
+		n, index := 0, false
+		for i++; i < len(format) && strings.IndexByte("+-# 0123456789.[]*", format[i]) >= 0; i++ {
+			switch c := format[i]; {
+			case c == '[':
+				index = true
+			case c == ']':
+				index = false
+			case c == '*':
+				return errors.New(errTemplateFormat)
+			case c >= '0' && c <= '9' && !index:
+				if n = n*10 + int(c-'0'); n > maxFormatWidth {
+					return errors.New(errTemplateFormat)
+				}
+			default:
+				n = 0
+			}
+		}
+	}
+	return nil
+}
+
+// bodyTemplatingInterceptor renders the body of the supplied managed
+// resource if its body templating is enabled, records the rendered body in
+// its status and returns the interceptor passing the rendered body to the
+// Terraform provider in place of the configured one. It returns nil if the
+// body templating is disabled.
+func bodyTemplatingInterceptor(mg resource.Managed, pcSpec *namespacedv1beta1.ProviderConfigSpec) (*Interceptor, error) {
+	tr, ok := mg.(ujresource.Terraformed)
+	if !ok {
+		return nil, errors.New(errNotTerraformedKind)
+	}
+	params, err := tr.GetParameters()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetTemplatingParams)
+	}
+	if enabled, _ := params[keyBodyTemplating].(bool); !enabled {
+		return nil, errors.Wrap(tr.SetObservation(map[string]any{keyRenderedBody: nil}), errSetRenderedBody)
+	}
+	rendered, err := renderBody(params[keyBody], newTemplateData(mg, pcSpec))
+	if err != nil {
+		return nil, errors.Wrap(err, errRenderBody)
+	}
+	b, err := json.Marshal(rendered)
+	if err != nil {
+		return nil, errors.Wrap(err, errRenderBody)
+	}
+	if err := tr.SetObservation(map[string]any{keyRenderedBody: string(b)}); err != nil {
+		return nil, errors.Wrap(err, errSetRenderedBody)
+	}
+	body := tfValueOf(rendered)
+	return &Interceptor{
+		ModifyPlan: func(ctx context.Context, req fwresource.ModifyPlanRequest, resp *fwresource.ModifyPlanResponse, next func(context.Context, fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse)) {
+			var err error
+			if req.Config.Raw, err = withAttribute(req.Config.Raw, keyBody, body); err == nil {
+				if req.Plan.Raw, err = withAttribute(req.Plan.Raw, keyBody, body); err == nil {
+					resp.Plan.Raw, err = withAttribute(resp.Plan.Raw, keyBody, body)
+				}
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(errReplaceBody, err.Error())
+				return
+			}
+			next(ctx, req, resp)
+		},
+		Create: func(ctx context.Context, req fwresource.CreateRequest, resp *fwresource.CreateResponse, next func(context.Context, fwresource.CreateRequest, *fwresource.CreateResponse)) {
+			var err error
+			if req.Config.Raw, err = withAttribute(req.Config.Raw, keyBody, body); err == nil {
+				req.Plan.Raw, err = withAttribute(req.Plan.Raw, keyBody, body)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(errReplaceBody, err.Error())
+				return
+			}
+			next(ctx, req, resp)
+		},
+		Update: func(ctx context.Context, req fwresource.UpdateRequest, resp *fwresource.UpdateResponse, next func(context.Context, fwresource.UpdateRequest, *fwresource.UpdateResponse)) {
+			var err error
+			if req.Config.Raw, err = withAttribute(req.Config.Raw, keyBody, body); err == nil {
+				req.Plan.Raw, err = withAttribute(req.Plan.Raw, keyBody, body)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(errReplaceBody, err.Error())
+				return
+			}
+			next(ctx, req, resp)
+		},
+	}, nil
+}
+
+func newTemplateData(mg resource.Managed, pcSpec *namespacedv1beta1.ProviderConfigSpec) templateData {
+	d := templateData{
+		Metadata: templateMetadata{
+			Name:        mg.GetName(),
+			Namespace:   mg.GetNamespace(),
+			UID:         string(mg.GetUID()),
+			Labels:      mg.GetLabels(),
+			Annotations: mg.GetAnnotations(),
+		},
+		Labels:      mg.GetLabels(),
+		Annotations: mg.GetAnnotations(),
+	}
+	if pcSpec != nil && pcSpec.Defaults != nil {
+		d.Defaults = templateDefaults{Location: pcSpec.Defaults.Location, Tags: pcSpec.Defaults.Tags}
+	}
+	return d
+}
+
+// renderBody returns the supplied body with its string values rendered as
+// templates with the supplied data.
+func renderBody(body any, data templateData) (any, error) {
+	return renderValue(body, data, "body")
+}
+
+func renderValue(v any, data templateData, path string) (any, error) {
+	switch t := v.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(t))
+		for k, e := range t {
+			r, err := renderValue(e, data, path+"."+k)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = r
+		}
+		return result, nil
+	case []any:
+		result := make([]any, len(t))
+		for i, e := range t {
+			r, err := renderValue(e, data, path+"["+strconv.Itoa(i)+"]")
+			if err != nil {
+				return nil, err
+			}
+			result[i] = r
+		}
+		return result, nil
+	case string:
+		if !strings.Contains(t, "{{") {
+			return t, nil
+		}
+		r, err := renderString(t, data)
+		return r, errors.Wrapf(err, errRenderValueFmt, path)
+	default:
+		return v, nil
+	}
+}
+
+// renderString renders the supplied template. The value of a template
+// consisting of a single action is returned as it is, e.g. a map, and the
+// other templates are rendered into strings.
+func renderString(s string, data templateData) (any, error) {
+	if m := singleAction.FindStringSubmatch(strings.TrimSpace(s)); m != nil && !strings.Contains(m[1], "{{") && !strings.Contains(m[1], ":=") {
+		out, err := execute("{{ ("+m[1]+") | toJson }}", data)
+		if err != nil {
+			return nil, err
+		}
+		var v any
+		return v, errors.Wrap(json.Unmarshal([]byte(out), &v), errRenderBody)
+	}
+	return execute(s, data)
+}
+
+// execute executes the supplied template with the supplied data in a
+// sandbox: the templates can only use the functions without side effects,
+// cannot define or call other templates, can only range over the fields of
+// the data, and both their iterations and their output are limited.
+func execute(text string, data templateData) (string, error) {
+	t, err := template.New("body").Option("missingkey=error").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	if len(t.Templates()) > 1 {
+		return "", errors.Errorf(errTemplateActionFmt, "define and block")
+	}
+	if err := checkNode(t.Root); err != nil {
+		return "", err
+	}
+	// the function is added once the template is parsed, so that it's only
+	// called by the actions added to the ranges
+	iterations := 0
+	t.Funcs(template.FuncMap{funcRangeIteration: func() (string, error) {
+		iterations++
+		if iterations > maxRangeIterations {
+			return "", errors.New(errTemplateIterations)
+		}
+		return "", nil
+	}})
+	w := &limitedBuffer{max: maxRenderedSize}
+	if err := t.Execute(w, data); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// checkNode returns an error if the supplied template node or any of its
+// children is not allowed in the body templates. The ranges are prepended
+// an action counting their iterations.
+func checkNode(n parse.Node) error { //nolint:gocyclo // a single type switch
+	switch t := n.(type) {
+	case *parse.ListNode:
+		if t == nil {
+			return nil
+		}
+		for _, c := range t.Nodes {
+			if err := checkNode(c); err != nil {
+				return err
+			}
+		}
+	case *parse.TemplateNode:
+		return errors.Errorf(errTemplateActionFmt, "template")
+	case *parse.RangeNode:
+		if len(t.Pipe.Cmds) != 1 || len(t.Pipe.Cmds[0].Args) != 1 || !isDataField(t.Pipe.Cmds[0].Args[0]) {
+			return errors.New(errTemplateRange)
+		}
+		if t.List == nil {
+			t.List = &parse.ListNode{NodeType: parse.NodeList, Pos: t.Pos}
+		}
+		t.List.Nodes = append([]parse.Node{&parse.ActionNode{
+			NodeType: parse.NodeAction,
+			Pos:      t.Pos,
+			Line:     t.Line,
+			Pipe: &parse.PipeNode{NodeType: parse.NodePipe, Pos: t.Pos, Line: t.Line, Cmds: []*parse.CommandNode{{
+				NodeType: parse.NodeCommand,
+				Pos:      t.Pos,
+				Args:     []parse.Node{parse.NewIdentifier(funcRangeIteration).SetPos(t.Pos)},
+			}}},
+		}}, t.List.Nodes...)
+		if err := checkNode(t.List); err != nil {
+			return err
+		}
+		return checkNode(t.ElseList)
+	case *parse.IfNode:
+		if err := checkNode(t.List); err != nil {
+			return err
+		}
+		return checkNode(t.ElseList)
+	case *parse.WithNode:
+		if err := checkNode(t.List); err != nil {
+			return err
+		}
+		return checkNode(t.ElseList)
+	}
+	return nil
+}
+
+// isDataField returns true if the supplied range argument is a field of the
+// template data, e.g. .Labels, $.Metadata.Annotations or (merge .Labels
+// .Defaults.Tags).x, which has a bounded number of items. The fields of the
+// data are strings, maps of strings and structs. The variables other than
+// $ and the dot, e.g. in a with action, may be bound to any value, such as
+// numbers.
+func isDataField(n parse.Node) bool {
+	switch t := n.(type) {
+	case *parse.FieldNode:
+		return true
+	case *parse.ChainNode:
+		return len(t.Field) > 0
+	case *parse.VariableNode:
+		return len(t.Ident) > 1 && t.Ident[0] == "$"
+	}
+	return false
+}
+
+// limitedBuffer is a buffer failing the writes beyond its maximum size.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.max {
+		return 0, errors.New(errTemplateOutputSize)
+	}
+	return b.Buffer.Write(p)
+}
+
+// tfValueOf returns the Terraform value of the supplied JSON value, with
+// the types implied by the value like the dynamic attributes of the azapi
+// provider: objects for the JSON objects and tuples for the JSON arrays.
+func tfValueOf(v any) tftypes.Value {
+	switch t := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		types := make(map[string]tftypes.Type, len(t))
+		values := make(map[string]tftypes.Value, len(t))
+		for _, k := range keys {
+			values[k] = tfValueOf(t[k])
+			types[k] = values[k].Type()
+		}
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: types}, values)
+	case []any:
+		types := make([]tftypes.Type, len(t))
+		values := make([]tftypes.Value, len(t))
+		for i, e := range t {
+			values[i] = tfValueOf(e)
+			types[i] = values[i].Type()
+		}
+		return tftypes.NewValue(tftypes.Tuple{ElementTypes: types}, values)
+	case string:
+		return tftypes.NewValue(tftypes.String, t)
+	case bool:
+		return tftypes.NewValue(tftypes.Bool, t)
+	case float64:
+		return tftypes.NewValue(tftypes.Number, big.NewFloat(t))
+	case int64:
+		return tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(t))
+	default:
+		// the attributes and elements of the objects and tuples must have
+		// concrete types, even if they are null
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+}
+
+// withAttribute returns the supplied resource object with the value of its
+// attribute with the supplied name replaced. The null and unknown objects,
+// e.g. the plans of the deletions, are returned as they are.
+func withAttribute(raw tftypes.Value, name string, value tftypes.Value) (tftypes.Value, error) {
+	if raw.IsNull() || !raw.IsKnown() {
+		return raw, nil
+	}
+	path := tftypes.NewAttributePath().WithAttributeName(name)
+	return tftypes.Transform(raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if p.Equal(path) {
+			return value, nil
+		}
+		return v, nil
+	})
+}
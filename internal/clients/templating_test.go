@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRenderBody(t *testing.T) {
+	data := templateData{
+		Metadata:    templateMetadata{Name: "sa", Namespace: "team"},
+		Labels:      map[string]string{"app": "web", "env": "dev"},
+		Annotations: map[string]string{"owner": "ops"},
+		Defaults:    templateDefaults{Location: "westeurope", Tags: map[string]string{"cost-center": "42", "env": "prod"}},
+	}
+	manyLabels := templateData{Labels: map[string]string{}}
+	for i := 0; i < 101; i++ {
+		manyLabels.Labels["l"+strconv.Itoa(i)] = "v"
+	}
+	bigAnnotation := templateData{Annotations: map[string]string{"big": strings.Repeat("x", maxRenderedSize/2+1)}}
+
+	cases := map[string]struct {
+		body    any
+		data    templateData
+		want    any
+		wantErr string
+	}{
+		"SingleActionString": {
+			body: map[string]any{"name": "{{ .Metadata.Name }}"},
+			data: data,
+			want: map[string]any{"name": "sa"},
+		},
+		"SingleActionObject": {
+			body: map[string]any{"tags": "{{- merge .Defaults.Tags .Labels -}}"},
+			data: data,
+			want: map[string]any{"tags": map[string]any{"app": "web", "cost-center": "42", "env": "dev"}},
+		},
+		"SingleActionDefault": {
+			body: []any{"{{ default .Defaults.Location .Annotations.location }}", true},
+			data: templateData{Defaults: templateDefaults{Location: "westeurope"}, Annotations: map[string]string{"location": ""}},
+			want: []any{"westeurope", true},
+		},
+		"MixedText": {
+			body: map[string]any{"properties": map[string]any{"name": "{{ .Metadata.Namespace }}-{{ upper .Metadata.Name }}", "count": int64(1)}},
+			data: data,
+			want: map[string]any{"properties": map[string]any{"name": "team-SA", "count": int64(1)}},
+		},
+		"RangeOverField": {
+			body: "{{ range $k, $v := .Labels }}{{ $k }}={{ $v }};{{ end }}",
+			data: data,
+			want: "app=web;env=dev;",
+		},
+		"RangeOverRootVariableField": {
+			body: "{{ with .Metadata }}{{ range $.Annotations }}{{ . }}{{ end }}{{ end }}",
+			data: data,
+			want: "ops",
+		},
+		"MissingKey": {
+			body:    "{{ .Labels.missing }}",
+			data:    data,
+			wantErr: `map has no entry for key "missing"`,
+		},
+		"Template": {
+			body:    `a{{ template "x" }}`,
+			data:    data,
+			wantErr: "template actions are not allowed in body templates",
+		},
+		"Define": {
+			body:    `{{ define "x" }}a{{ end }}b`,
+			data:    data,
+			wantErr: "define and block actions are not allowed in body templates",
+		},
+		"Block": {
+			body:    `{{ block "x" . }}a{{ end }}`,
+			data:    data,
+			wantErr: "define and block actions are not allowed in body templates",
+		},
+		"NestedTemplate": {
+			body:    `{{ if true }}{{ with .Labels }}{{ template "x" }}{{ end }}{{ end }}`,
+			data:    data,
+			wantErr: "template actions are not allowed in body templates",
+		},
+		"RangeOverNumber": {
+			body:    "{{ range 300000000 }}{{ end }}",
+			data:    data,
+			wantErr: errTemplateRange,
+		},
+		"RangeOverVariable": {
+			body:    "{{ $n := 300000000 }}{{ range $n }}{{ end }}",
+			data:    data,
+			wantErr: errTemplateRange,
+		},
+		"RangeOverDot": {
+			body:    "{{ with 300000000 }}{{ range . }}{{ end }}{{ end }}",
+			data:    data,
+			wantErr: errTemplateRange,
+		},
+		"RangeOverPipeline": {
+			body:    "{{ range len .Labels }}{{ end }}",
+			data:    data,
+			wantErr: errTemplateRange,
+		},
+		"RangeIterations": {
+			body:    "{{ range .Labels }}{{ range $.Labels }}{{ end }}{{ end }}",
+			data:    manyLabels,
+			wantErr: errTemplateIterations,
+		},
+		"RangeIterationFunction": {
+			body:    "{{ rangeIteration }}",
+			data:    data,
+			wantErr: `function "rangeIteration" not defined`,
+		},
+		"Printf": {
+			body: `{{ printf "%s-%03d-%.2f-%[1]s%%" .Metadata.Name 7 1.5 }}`,
+			data: data,
+			want: "sa-007-1.50-sa%",
+		},
+		"PrintfWidth": {
+			body:    `{{ printf "%0999999999d" 1 }}`,
+			data:    data,
+			wantErr: errTemplateFormat,
+		},
+		"PrintfPrecision": {
+			body:    `{{ printf "%.101f" 1.0 }}`,
+			data:    data,
+			wantErr: errTemplateFormat,
+		},
+		"PrintfStarWidth": {
+			body:    `{{ printf "%*d" 999999 1 }}`,
+			data:    data,
+			wantErr: errTemplateFormat,
+		},
+		"PrintManyArguments": {
+			body:    "a{{ print .Annotations.big .Annotations.big .Annotations.big }}",
+			data:    bigAnnotation,
+			wantErr: errTemplateValueSize,
+		},
+		"Replace": {
+			body:    `a{{ replace "" .Annotations.big .Annotations.big }}`,
+			data:    bigAnnotation,
+			wantErr: errTemplateValueSize,
+		},
+		"OutputSize": {
+			body:    "{{ .Annotations.big }}{{ .Annotations.big }}",
+			data:    bigAnnotation,
+			wantErr: errTemplateOutputSize,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := renderBody(tc.body, tc.data)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("want error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLimitedBuffer(t *testing.T) {
+	b := &limitedBuffer{max: 4}
+	if _, err := b.Write([]byte("abc")); err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if _, err := b.Write([]byte("de")); err == nil || err.Error() != errTemplateOutputSize {
+		t.Fatalf("want error %q, got %v", errTemplateOutputSize, err)
+	}
+	if _, err := b.Write([]byte("d")); err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if want, got := "abcd", b.String(); want != got {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestTFValueOf(t *testing.T) {
+	cases := map[string]struct {
+		v    any
+		want tftypes.Value
+	}{
+		"Null": {
+			v:    nil,
+			want: tftypes.NewValue(tftypes.String, nil),
+		},
+		"ObjectWithNull": {
+			v: map[string]any{"a": nil, "b": "x"},
+			want: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.String, "b": tftypes.String}}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, nil),
+				"b": tftypes.NewValue(tftypes.String, "x"),
+			}),
+		},
+		"Tuple": {
+			v: []any{true, int64(1), nil},
+			want: tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.Bool, tftypes.Number, tftypes.String}}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Bool, true),
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tfValueOf(tc.v)
+			if !tc.want.Equal(got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+			if got.Type().Is(tftypes.DynamicPseudoType) {
+				t.Errorf("want a concrete type, got %v", got.Type())
+			}
+		})
+	}
+}
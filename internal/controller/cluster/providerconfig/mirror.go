@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package providerconfig
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/v1beta1"
+	namespacedv1beta1 "github.com/upbound/provider-azapi/v2/apis/namespaced/v1beta1"
+	"github.com/upbound/provider-azapi/v2/internal/clients"
+)
+
+const (
+	// LabelKeyMirroredFrom is set on the ClusterProviderConfigs mirroring a
+	// legacy ProviderConfig to the name of the ProviderConfig.
+	LabelKeyMirroredFrom = "azapi.upbound.io/mirrored-from"
+	// annotationKeyMirroredGeneration records the generation of the
+	// ProviderConfig a ClusterProviderConfig was last synchronized with.
+	annotationKeyMirroredGeneration = "azapi.upbound.io/mirrored-generation"
+
+	// TypeMirrored is the type of the condition reporting whether a legacy
+	// ProviderConfig is mirrored into a ClusterProviderConfig.
+	TypeMirrored xpv1.ConditionType = "Mirrored"
+
+	reasonInSync   xpv1.ConditionReason = "InSync"
+	reasonDrift    xpv1.ConditionReason = "DriftCorrected"
+	reasonConflict xpv1.ConditionReason = "Conflict"
+	reasonFailed   xpv1.ConditionReason = "MirrorFailed"
+
+	reasonMirrorCreated event.Reason = "MirrorCreated"
+	reasonMirrorDrift   event.Reason = "MirrorDrift"
+	reasonMirrorFailed  event.Reason = "MirrorFailed"
+
+	mirrorControllerName = "providerconfig/mirror"
+
+	errGetProviderConfig = "cannot get the ProviderConfig"
+	errConvertSpec       = "cannot convert the ProviderConfig spec"
+	errGetMirror         = "cannot get the mirroring ClusterProviderConfig"
+	errCreateMirror      = "cannot create the mirroring ClusterProviderConfig"
+	errUpdateMirror      = "cannot update the mirroring ClusterProviderConfig"
+	errDeleteMirror      = "cannot delete the mirroring ClusterProviderConfig"
+	errUpdateStatus      = "cannot update the ProviderConfig status"
+	errConflict          = "a ClusterProviderConfig with the same name that does not mirror the ProviderConfig already exists"
+)
+
+// A mirrorReconciler mirrors the legacy ProviderConfigs into the
+// ClusterProviderConfigs of the namespaced API group with the same names, so
+// that the namespaced managed resources can reference them.
+type mirrorReconciler struct {
+	client client.Client
+	log    logging.Logger
+	record event.Recorder
+}
+
+// Reconcile creates or updates the ClusterProviderConfig mirroring the
+// legacy ProviderConfig with the supplied name. Changes made to the
+// ClusterProviderConfig are reported as drift and reverted. The
+// ClusterProviderConfig is deleted along with the ProviderConfig.
+func (r *mirrorReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, errors.Wrap(err, errGetProviderConfig)
+		}
+		return reconcile.Result{}, r.deleteMirror(ctx, log, req.Name)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, r.deleteMirror(ctx, log, pc.GetName())
+	}
+	spec, err := clients.LegacyToModernProviderConfigSpec(pc)
+	if err != nil {
+		return reconcile.Result{}, r.fail(ctx, pc, reasonFailed, errors.Wrap(err, errConvertSpec))
+	}
+
+	mirror := &namespacedv1beta1.ClusterProviderConfig{}
+	err = r.client.Get(ctx, client.ObjectKey{Name: pc.GetName()}, mirror)
+	switch {
+	case kerrors.IsNotFound(err):
+		mirror = &namespacedv1beta1.ClusterProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        pc.GetName(),
+				Labels:      map[string]string{LabelKeyMirroredFrom: pc.GetName()},
+				Annotations: map[string]string{annotationKeyMirroredGeneration: generation(pc)},
+			},
+			Spec: *spec,
+		}
+		if err := r.client.Create(ctx, mirror); err != nil {
+			return reconcile.Result{}, r.fail(ctx, pc, reasonFailed, errors.Wrap(err, errCreateMirror))
+		}
+		log.Debug("Created the mirroring ClusterProviderConfig")
+		r.record.Event(pc, event.Normal(reasonMirrorCreated, "Created the mirroring ClusterProviderConfig "+mirror.GetName()))
+		return reconcile.Result{}, r.setCondition(ctx, pc, reasonInSync, "")
+	case err != nil:
+		return reconcile.Result{}, r.fail(ctx, pc, reasonFailed, errors.Wrap(err, errGetMirror))
+	case mirror.GetLabels()[LabelKeyMirroredFrom] != pc.GetName():
+		// never take over a ClusterProviderConfig created by the user
+		return reconcile.Result{}, r.fail(ctx, pc, reasonConflict, errors.New(errConflict))
+	}
+
+	mirrored := mirror.GetAnnotations()[annotationKeyMirroredGeneration] == generation(pc)
+	if reflect.DeepEqual(mirror.Spec, *spec) && mirrored {
+		if c := pc.Status.GetCondition(TypeMirrored); c.Reason == reasonDrift && c.ObservedGeneration == pc.GetGeneration() {
+			// keep reporting the last drift until the ProviderConfig
+			// changes
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, r.setCondition(ctx, pc, reasonInSync, "")
+	}
+	// the ClusterProviderConfig drifted if it differs from the generation
+	// of the ProviderConfig it was last synchronized with
+	drifted := mirrored
+	mirror.Spec = *spec
+	meta.AddAnnotations(mirror, map[string]string{annotationKeyMirroredGeneration: generation(pc)})
+	if err := r.client.Update(ctx, mirror); err != nil {
+		return reconcile.Result{}, r.fail(ctx, pc, reasonFailed, errors.Wrap(err, errUpdateMirror))
+	}
+	if !drifted {
+		log.Debug("Updated the mirroring ClusterProviderConfig")
+		return reconcile.Result{}, r.setCondition(ctx, pc, reasonInSync, "")
+	}
+	msg := "The spec of the mirroring ClusterProviderConfig " + mirror.GetName() + " drifted from the ProviderConfig and was reverted"
+	log.Info("Reverted the drift of the mirroring ClusterProviderConfig")
+	r.record.Event(pc, event.Warning(reasonMirrorDrift, errors.New(msg)))
+	return reconcile.Result{}, r.setCondition(ctx, pc, reasonDrift, msg)
+}
+
+// deleteMirror deletes the ClusterProviderConfig mirroring the
+// ProviderConfig with the supplied name, if any. Its deletion is held by
+// its usage finalizer while namespaced managed resources still use it.
+func (r *mirrorReconciler) deleteMirror(ctx context.Context, log logging.Logger, name string) error {
+	mirror := &namespacedv1beta1.ClusterProviderConfig{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: name}, mirror); err != nil {
+		return errors.Wrap(client.IgnoreNotFound(err), errGetMirror)
+	}
+	if mirror.GetLabels()[LabelKeyMirroredFrom] != name || meta.WasDeleted(mirror) {
+		return nil
+	}
+	if err := r.client.Delete(ctx, mirror); client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errDeleteMirror)
+	}
+	log.Debug("Deleted the mirroring ClusterProviderConfig")
+	return nil
+}
+
+func generation(pc *v1beta1.ProviderConfig) string {
+	return strconv.FormatInt(pc.GetGeneration(), 10)
+}
+
+func (r *mirrorReconciler) fail(ctx context.Context, pc *v1beta1.ProviderConfig, reason xpv1.ConditionReason, err error) error {
+	r.record.Event(pc, event.Warning(reasonMirrorFailed, err))
+	if serr := r.setCondition(ctx, pc, reason, err.Error()); serr != nil {
+		return serr
+	}
+	if reason == reasonConflict {
+		// retrying does not resolve the conflict, the ClusterProviderConfig
+		// must be removed or labeled by the user
+		return nil
+	}
+	return err
+}
+
+func (r *mirrorReconciler) setCondition(ctx context.Context, pc *v1beta1.ProviderConfig, reason xpv1.ConditionReason, msg string) error {
+	c := xpv1.Condition{
+		Type:               TypeMirrored,
+		Status:             corev1.ConditionTrue,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: pc.GetGeneration(),
+		LastTransitionTime: metav1.Now(),
+	}
+	if reason == reasonConflict || reason == reasonFailed {
+		c.Status = corev1.ConditionFalse
+	}
+	if current := pc.Status.GetCondition(TypeMirrored); current.Equal(c) && current.ObservedGeneration == c.ObservedGeneration {
+		return nil
+	}
+	pc.Status.SetConditions(c)
+	return errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+}
+
+// SetupMirror adds a controller that mirrors the legacy ProviderConfigs into
+// the ClusterProviderConfigs of the namespaced API group.
+func SetupMirror(mgr ctrl.Manager, o controller.Options) error {
+	r := &mirrorReconciler{
+		client: mgr.GetClient(),
+		log:    o.Logger.WithValues("controller", mirrorControllerName),
+		record: event.NewAPIRecorder(mgr.GetEventRecorderFor(mirrorControllerName)),
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(mirrorControllerName).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}).
+		Watches(&namespacedv1beta1.ClusterProviderConfig{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, o client.Object) []reconcile.Request {
+			name, ok := o.GetLabels()[LabelKeyMirroredFrom]
+			if !ok {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: name}}}
+		})).
+		Complete(r)
+}
+
+// SetupMirrorGated adds a controller that mirrors the legacy ProviderConfigs
+// into the ClusterProviderConfigs of the namespaced API group.
+func SetupMirrorGated(mgr ctrl.Manager, o controller.Options) error {
+	o.Gate.Register(func() {
+		if err := SetupMirror(mgr, o); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", v1beta1.ProviderConfigGroupKind, "gvk", namespacedv1beta1.ClusterProviderConfigGroupKind)
+		}
+	}, v1beta1.ProviderConfigGroupVersionKind, namespacedv1beta1.ClusterProviderConfigGroupVersionKind)
+	return nil
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package providerconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/v1beta1"
+	namespacedv1beta1 "github.com/upbound/provider-azapi/v2/apis/namespaced/v1beta1"
+)
+
+// testProviderConfig returns a ProviderConfig of the supplied generation
+// defaulting to the supplied location.
+func testProviderConfig(generation int64, location string) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: generation},
+		Spec: v1beta1.ProviderConfigSpec{
+			Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+			Defaults:    &v1beta1.ProviderDefaults{Location: location},
+		},
+	}
+}
+
+// testMirror returns a ClusterProviderConfig defaulting to the supplied
+// location, mirroring the supplied generation of the ProviderConfig unless
+// it's empty.
+func testMirror(generation, location string) *namespacedv1beta1.ClusterProviderConfig {
+	m := &namespacedv1beta1.ClusterProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: namespacedv1beta1.ProviderConfigSpec{
+			Credentials: namespacedv1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+			Defaults:    &namespacedv1beta1.ProviderDefaults{Location: location},
+		},
+	}
+	if generation != "" {
+		m.SetLabels(map[string]string{LabelKeyMirroredFrom: "default"})
+		m.SetAnnotations(map[string]string{annotationKeyMirroredGeneration: generation})
+	}
+	return m
+}
+
+func TestMirrorReconcile(t *testing.T) {
+	deleting := testProviderConfig(2, "westeurope")
+	deleting.SetFinalizers([]string{"test"})
+	now := metav1.Now()
+	deleting.SetDeletionTimestamp(&now)
+
+	cases := map[string]struct {
+		pc             *v1beta1.ProviderConfig
+		mirror         *namespacedv1beta1.ClusterProviderConfig
+		wantMirror     bool
+		wantLocation   string
+		wantGeneration string
+		wantReason     xpv1.ConditionReason
+	}{
+		"Created": {
+			pc:             testProviderConfig(1, "westeurope"),
+			wantMirror:     true,
+			wantLocation:   "westeurope",
+			wantGeneration: "1",
+			wantReason:     reasonInSync,
+		},
+		"InSync": {
+			pc:             testProviderConfig(2, "westeurope"),
+			mirror:         testMirror("2", "westeurope"),
+			wantMirror:     true,
+			wantLocation:   "westeurope",
+			wantGeneration: "2",
+			wantReason:     reasonInSync,
+		},
+		"ProviderConfigChanged": {
+			pc:             testProviderConfig(3, "northeurope"),
+			mirror:         testMirror("2", "westeurope"),
+			wantMirror:     true,
+			wantLocation:   "northeurope",
+			wantGeneration: "3",
+			wantReason:     reasonInSync,
+		},
+		"MirrorDrifted": {
+			pc:             testProviderConfig(2, "westeurope"),
+			mirror:         testMirror("2", "northeurope"),
+			wantMirror:     true,
+			wantLocation:   "westeurope",
+			wantGeneration: "2",
+			wantReason:     reasonDrift,
+		},
+		"Conflict": {
+			pc:           testProviderConfig(1, "westeurope"),
+			mirror:       testMirror("", "northeurope"),
+			wantMirror:   true,
+			wantLocation: "northeurope",
+			wantReason:   reasonConflict,
+		},
+		"ProviderConfigRemoved": {
+			mirror: testMirror("2", "westeurope"),
+		},
+		"ProviderConfigDeleting": {
+			pc:     deleting,
+			mirror: testMirror("2", "westeurope"),
+		},
+		"ProviderConfigRemovedNotMirrored": {
+			mirror:       testMirror("", "northeurope"),
+			wantMirror:   true,
+			wantLocation: "northeurope",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := namespacedv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			b := fake.NewClientBuilder().WithScheme(s).WithStatusSubresource(&v1beta1.ProviderConfig{})
+			if tc.pc != nil {
+				b = b.WithObjects(tc.pc)
+			}
+			if tc.mirror != nil {
+				b = b.WithObjects(tc.mirror)
+			}
+			kube := b.Build()
+			r := &mirrorReconciler{client: kube, log: logging.NewNopLogger(), record: event.NewNopRecorder()}
+
+			req := reconcile.Request{NamespacedName: client.ObjectKey{Name: "default"}}
+			if _, err := r.Reconcile(context.Background(), req); err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+
+			mirror := &namespacedv1beta1.ClusterProviderConfig{}
+			err := kube.Get(context.Background(), req.NamespacedName, mirror)
+			if !tc.wantMirror {
+				if !kerrors.IsNotFound(err) {
+					t.Errorf("want the mirror deleted, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := mirror.Spec.Defaults.Location; got != tc.wantLocation {
+				t.Errorf("want the location %q, got %q", tc.wantLocation, got)
+			}
+			if got := mirror.GetAnnotations()[annotationKeyMirroredGeneration]; got != tc.wantGeneration {
+				t.Errorf("want the mirrored generation %q, got %q", tc.wantGeneration, got)
+			}
+			if tc.pc == nil {
+				return
+			}
+			pc := &v1beta1.ProviderConfig{}
+			if err := kube.Get(context.Background(), req.NamespacedName, pc); err != nil {
+				t.Fatal(err)
+			}
+			if got := pc.Status.GetCondition(TypeMirrored).Reason; got != tc.wantReason {
+				t.Errorf("want the reason %q, got %q", tc.wantReason, got)
+			}
+		})
+	}
+}
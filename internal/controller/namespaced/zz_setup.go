@@ -9,8 +9,14 @@ import (
 
 	"github.com/crossplane/upjet/v2/pkg/controller"
 
+	clientconfig "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/data/clientconfig"
+	resourcedata "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/data/resourcedata"
+	resourcelist "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/data/resourcelist"
 	providerconfig "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/providerconfig"
 	dataplaneresource "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/resources/dataplaneresource"
+	ephemeralresourceaction "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/resources/ephemeralresourceaction"
+	listkeysaction "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/resources/listkeysaction"
+	regeneratekeyaction "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/resources/regeneratekeyaction"
 	resource "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/resources/resource"
 	resourceaction "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/resources/resourceaction"
 	updateresource "github.com/upbound/provider-azapi/v2/internal/controller/namespaced/resources/updateresource"
@@ -21,7 +27,13 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		providerconfig.Setup,
+		clientconfig.Setup,
+		resourcedata.Setup,
+		resourcelist.Setup,
 		dataplaneresource.Setup,
+		ephemeralresourceaction.Setup,
+		listkeysaction.Setup,
+		regeneratekeyaction.Setup,
 		resource.Setup,
 		resourceaction.Setup,
 		updateresource.Setup,
@@ -38,7 +50,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 func SetupGated(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		providerconfig.SetupGated,
+		clientconfig.SetupGated,
+		resourcedata.SetupGated,
+		resourcelist.SetupGated,
 		dataplaneresource.SetupGated,
+		ephemeralresourceaction.SetupGated,
+		listkeysaction.SetupGated,
+		regeneratekeyaction.SetupGated,
 		resource.SetupGated,
 		resourceaction.SetupGated,
 		updateresource.SetupGated,
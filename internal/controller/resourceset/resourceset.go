@@ -0,0 +1,547 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resourceset contains the controllers of the ResourceSets, which
+// deploy sets of Resources from templates referencing each other.
+package resourceset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/upjet/v2/pkg/controller"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1alpha1 "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1alpha1"
+	clusterv1beta2 "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	namespacedv1alpha1 "github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1alpha1"
+	namespacedv1beta2 "github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1beta2"
+)
+
+const (
+	// LabelKeyResourceSet is set on the Resources of a ResourceSet to the
+	// name of the ResourceSet.
+	LabelKeyResourceSet = "azapi.upbound.io/resource-set"
+	// LabelKeyTemplate is set on the Resources of a ResourceSet to the name
+	// of their template.
+	LabelKeyTemplate = "azapi.upbound.io/resource-set-template"
+	// annotationKeyAppliedFields records the forProvider fields of a
+	// Resource last applied from its template, so that the fields removed
+	// from the template are removed from the Resource while the late
+	// initialized fields are kept.
+	annotationKeyAppliedFields = "azapi.upbound.io/resource-set-fields"
+
+	finalizer = "finalizer.azapi.upbound.io/resource-set"
+
+	// deletionPollInterval is the interval the deletion of the Resources
+	// of a ResourceSet is checked at, in addition to their watch.
+	deletionPollInterval = 30 * time.Second
+
+	reasonCreatedResource  event.Reason = "CreatedResource"
+	reasonDeletedResource  event.Reason = "DeletedResource"
+	reasonInvalidTemplates event.Reason = "InvalidTemplates"
+	reasonApplyResource    event.Reason = "CannotApplyResource"
+
+	errGetResourceSet       = "cannot get the ResourceSet"
+	errUpdateResourceSet    = "cannot update the ResourceSet"
+	errUpdateStatus         = "cannot update the ResourceSet status"
+	errListResources        = "cannot list the Resources of the ResourceSet"
+	errCreateResourceFmt    = "cannot create the Resource of the template %q"
+	errUpdateResourceFmt    = "cannot update the Resource of the template %q"
+	errDeleteResourceFmt    = "cannot delete the Resource of the template %q"
+	errConvertTemplateFmt   = "cannot convert the template %q"
+	errConvertSpec          = "cannot convert the Resource spec of the ResourceSet"
+	errResourceConflictFmt  = "the Resource %q exists and is not controlled by the ResourceSet"
+	waitingForTemplatesFmt  = "Waiting for the Resources of the templates %s to be ready"
+	waitingForValuesFmt     = "Waiting for the referenced values %s"
+	waitingForResourcesFmt  = "Waiting for the Resources of the templates %s"
+	deletingResourcesPrefix = "Deleting the Resources of the templates "
+)
+
+// A resourceSet is a ResourceSet of either scope.
+type resourceSet interface {
+	client.Object
+	// object returns the ResourceSet API object the client and the event
+	// recorder know of.
+	object() client.Object
+	// templates returns the templates of the Resources of the set.
+	templates() ([]template, error)
+	// resourceSpec returns the spec fields set on the Resources of the set
+	// other than forProvider.
+	resourceSpec() (map[string]any, error)
+	setConditions(c ...xpv1.Condition)
+	setResources(resources []resourceStatus)
+}
+
+// A resourceStatus is the observed state of a Resource of a ResourceSet.
+type resourceStatus struct {
+	name         string
+	resourceName string
+	id           string
+	ready        bool
+	message      string
+}
+
+// A reconciler reconciles the ResourceSets of a scope.
+type reconciler struct {
+	client      client.Client
+	log         logging.Logger
+	record      event.Recorder
+	newSet      func() resourceSet
+	setGVK      schema.GroupVersionKind
+	resourceGVK schema.GroupVersionKind
+}
+
+// Reconcile applies the Resources of the supplied ResourceSet in the order
+// of their dependencies. A Resource is applied once the Resources it depends
+// on are ready and the values it references are observed. The ResourceSet
+// is ready once all its Resources are ready.
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) { //nolint:gocyclo // easier to follow as a unit
+	log := r.log.WithValues("request", req)
+	set := r.newSet()
+	if err := r.client.Get(ctx, req.NamespacedName, set.object()); err != nil {
+		return reconcile.Result{}, errors.Wrap(client.IgnoreNotFound(err), errGetResourceSet)
+	}
+	children, err := r.resources(ctx, set)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	templates, err := set.templates()
+	var ordered []template
+	var deps map[string][]string
+	if err == nil {
+		ordered, deps, err = order(templates)
+	}
+	if meta.WasDeleted(set) {
+		return r.delete(ctx, set, children, deps)
+	}
+	if err != nil {
+		r.record.Event(set.object(), event.Warning(reasonInvalidTemplates, err))
+		set.setConditions(xpv1.ReconcileError(err))
+		// retrying does not resolve the invalid templates
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, set.object()), errUpdateStatus)
+	}
+	if !meta.FinalizerExists(set, finalizer) {
+		meta.AddFinalizer(set, finalizer)
+		if err := r.client.Update(ctx, set.object()); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errUpdateResourceSet)
+		}
+	}
+
+	// the Resources of the templates removed from the set are deleted
+	for name, child := range children {
+		if _, ok := deps[name]; ok || child.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := r.client.Delete(ctx, child); client.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, errors.Wrapf(err, errDeleteResourceFmt, name)
+		}
+		r.record.Event(set.object(), event.Normal(reasonDeletedResource, "Deleted the Resource "+child.GetName()+" of the removed template "+name))
+	}
+
+	spec, err := set.resourceSpec()
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errConvertSpec)
+	}
+	statuses := make(map[string]resourceStatus, len(ordered))
+	observed := map[string]map[string]any{}
+	for _, t := range ordered {
+		s := resourceStatus{name: t.name, resourceName: resourceName(set, t.name)}
+		var notReady []string
+		for _, d := range deps[t.name] {
+			if !statuses[d].ready {
+				notReady = append(notReady, d)
+			}
+		}
+		child := children[t.name]
+		switch {
+		case len(notReady) > 0:
+			s.message = waitingFor(waitingForTemplatesFmt, notReady)
+		default:
+			rendered, missing := render(t.forProvider, observed)
+			if len(missing) > 0 {
+				s.message = waitingFor(waitingForValuesFmt, missing)
+				break
+			}
+			forProvider, _ := rendered.(map[string]any)
+			if child, err = r.apply(ctx, set, t.name, forProvider, spec, child); err != nil {
+				r.record.Event(set.object(), event.Warning(reasonApplyResource, err))
+				set.setConditions(xpv1.ReconcileError(err))
+				if serr := r.updateStatus(ctx, set, templates, statuses); serr != nil {
+					log.Debug("Cannot update the status", "error", serr)
+				}
+				return reconcile.Result{}, err
+			}
+		}
+		if child != nil {
+			atProvider, _, _ := unstructured.NestedMap(child.Object, "status", "atProvider")
+			observed[t.name] = atProvider
+			s.id, _ = atProvider["id"].(string)
+			s.ready, s.message = readiness(child, s.message)
+		}
+		statuses[t.name] = s
+	}
+	set.setConditions(xpv1.ReconcileSuccess())
+	return reconcile.Result{}, r.updateStatus(ctx, set, templates, statuses)
+}
+
+// apply creates or updates the Resource of the supplied template with the
+// supplied forProvider and spec fields.
+func (r *reconciler) apply(ctx context.Context, set resourceSet, name string, forProvider, spec map[string]any, child *unstructured.Unstructured) (*unstructured.Unstructured, error) { //nolint:gocyclo // easier to follow as a unit
+	create := child == nil
+	if create {
+		child = &unstructured.Unstructured{}
+		child.SetGroupVersionKind(r.resourceGVK)
+		child.SetName(resourceName(set, name))
+		child.SetNamespace(set.GetNamespace())
+		child.SetLabels(map[string]string{LabelKeyResourceSet: set.GetName(), LabelKeyTemplate: name})
+		meta.AddOwnerReference(child, meta.AsController(meta.TypedReferenceTo(set, r.setGVK)))
+	}
+	changed := create
+	current, _, _ := unstructured.NestedMap(child.Object, "spec", "forProvider")
+	if current == nil {
+		current = map[string]any{}
+	}
+	for _, k := range strings.Split(child.GetAnnotations()[annotationKeyAppliedFields], ",") {
+		if _, ok := forProvider[k]; !ok && k != "" {
+			if _, ok := current[k]; ok {
+				delete(current, k)
+				changed = true
+			}
+		}
+	}
+	fields := make([]string, 0, len(forProvider))
+	for k, v := range forProvider {
+		fields = append(fields, k)
+		if !jsonEqual(current[k], v) {
+			current[k] = v
+			changed = true
+		}
+	}
+	for k, v := range spec {
+		if cv, _, _ := unstructured.NestedFieldNoCopy(child.Object, "spec", k); !jsonEqual(cv, v) {
+			if err := unstructured.SetNestedField(child.Object, v, "spec", k); err != nil {
+				return nil, errors.Wrapf(err, errConvertTemplateFmt, name)
+			}
+			changed = true
+		}
+	}
+	sort.Strings(fields)
+	if child.GetAnnotations()[annotationKeyAppliedFields] != strings.Join(fields, ",") {
+		meta.AddAnnotations(child, map[string]string{annotationKeyAppliedFields: strings.Join(fields, ",")})
+		changed = true
+	}
+	if !changed {
+		return child, nil
+	}
+	if err := unstructured.SetNestedMap(child.Object, current, "spec", "forProvider"); err != nil {
+		return nil, errors.Wrapf(err, errConvertTemplateFmt, name)
+	}
+	if create {
+		if err := r.client.Create(ctx, child); err != nil {
+			if kerrors.IsAlreadyExists(err) {
+				return nil, errors.Errorf(errResourceConflictFmt, child.GetName())
+			}
+			return nil, errors.Wrapf(err, errCreateResourceFmt, name)
+		}
+		r.record.Event(set.object(), event.Normal(reasonCreatedResource, "Created the Resource "+child.GetName()+" of the template "+name))
+		return child, nil
+	}
+	return child, errors.Wrapf(r.client.Update(ctx, child), errUpdateResourceFmt, name)
+}
+
+// delete deletes the Resources of the supplied ResourceSet that no other
+// Resource of the set depends on, and removes the finalizer of the set once
+// they are all gone. The Resources of invalid templates are all deleted at
+// once.
+func (r *reconciler) delete(ctx context.Context, set resourceSet, children map[string]*unstructured.Unstructured, deps map[string][]string) (reconcile.Result, error) {
+	if len(children) == 0 {
+		meta.RemoveFinalizer(set, finalizer)
+		return reconcile.Result{}, errors.Wrap(r.client.Update(ctx, set.object()), errUpdateResourceSet)
+	}
+	blocked := map[string]bool{}
+	for name := range children {
+		for _, d := range deps[name] {
+			blocked[d] = true
+		}
+	}
+	names := make([]string, 0, len(children))
+	for name, child := range children {
+		names = append(names, name)
+		if blocked[name] || child.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := r.client.Delete(ctx, child); client.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, errors.Wrapf(err, errDeleteResourceFmt, name)
+		}
+		r.record.Event(set.object(), event.Normal(reasonDeletedResource, "Deleted the Resource "+child.GetName()+" of the template "+name))
+	}
+	sort.Strings(names)
+	set.setConditions(xpv1.Deleting().WithMessage(deletingResourcesPrefix + strings.Join(names, ", ")))
+	if err := r.client.Status().Update(ctx, set.object()); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateStatus)
+	}
+	return reconcile.Result{RequeueAfter: deletionPollInterval}, nil
+}
+
+// resources returns the Resources controlled by the supplied ResourceSet by
+// template name.
+func (r *reconciler) resources(ctx context.Context, set resourceSet) (map[string]*unstructured.Unstructured, error) {
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(r.resourceGVK.GroupVersion().WithKind(r.resourceGVK.Kind + "List"))
+	if err := r.client.List(ctx, l, client.InNamespace(set.GetNamespace()), client.MatchingLabels{LabelKeyResourceSet: set.GetName()}); err != nil {
+		return nil, errors.Wrap(err, errListResources)
+	}
+	result := make(map[string]*unstructured.Unstructured, len(l.Items))
+	for i := range l.Items {
+		child := &l.Items[i]
+		if c := metav1.GetControllerOf(child); c == nil || c.UID != set.GetUID() {
+			continue
+		}
+		result[child.GetLabels()[LabelKeyTemplate]] = child
+	}
+	return result, nil
+}
+
+// updateStatus sets the statuses of the Resources in the order of the
+// supplied templates and the readiness of the supplied ResourceSet.
+func (r *reconciler) updateStatus(ctx context.Context, set resourceSet, templates []template, statuses map[string]resourceStatus) error {
+	resources := make([]resourceStatus, 0, len(templates))
+	var notReady, missing []string
+	for _, t := range templates {
+		s, ok := statuses[t.name]
+		if !ok {
+			s = resourceStatus{name: t.name, resourceName: resourceName(set, t.name)}
+		}
+		resources = append(resources, s)
+		if !s.ready {
+			notReady = append(notReady, t.name)
+		}
+		if s.id == "" && !s.ready {
+			missing = append(missing, t.name)
+		}
+	}
+	set.setResources(resources)
+	switch {
+	case len(notReady) == 0:
+		set.setConditions(xpv1.Available())
+	case len(missing) > 0:
+		set.setConditions(xpv1.Creating().WithMessage(waitingFor(waitingForResourcesFmt, notReady)))
+	default:
+		set.setConditions(xpv1.Unavailable().WithMessage(waitingFor(waitingForResourcesFmt, notReady)))
+	}
+	return errors.Wrap(r.client.Status().Update(ctx, set.object()), errUpdateStatus)
+}
+
+// readiness returns whether the supplied Resource is ready and, if it's
+// not, why. The supplied message is returned if the Resource does not
+// report why it's not ready.
+func readiness(child *unstructured.Unstructured, message string) (bool, string) {
+	conditions, _, _ := unstructured.NestedSlice(child.Object, "status", "conditions")
+	var ready bool
+	for _, c := range conditions {
+		m, _ := c.(map[string]any)
+		status, _ := m["status"].(string)
+		msg, _ := m["message"].(string)
+		switch xpv1.ConditionType(fmtString(m["type"])) {
+		case xpv1.TypeReady:
+			ready = status == string(corev1.ConditionTrue)
+			if !ready && message == "" {
+				message = fmtString(m["reason"])
+			}
+		case xpv1.TypeSynced:
+			if status == string(corev1.ConditionFalse) && msg != "" {
+				message = msg
+			}
+		}
+	}
+	if ready {
+		return true, ""
+	}
+	return false, message
+}
+
+func fmtString(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+// resourceName returns the name of the Resource of the supplied template of
+// the supplied ResourceSet.
+func resourceName(set resourceSet, template string) string {
+	return set.GetName() + "-" + template
+}
+
+func waitingFor(format string, names []string) string {
+	return fmt.Sprintf(format, strings.Join(names, ", "))
+}
+
+// jsonEqual returns true if the supplied JSON compatible values have the
+// same JSON encoding, e.g. the integers decoded as int64 and float64.
+func jsonEqual(a, b any) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ja, jb)
+}
+
+// toTemplate returns the template with the supplied name, dependencies and
+// forProvider.
+func toTemplate(name string, dependsOn []string, forProvider any) (template, error) {
+	t := template{name: name, dependsOn: dependsOn}
+	b, err := json.Marshal(forProvider)
+	if err != nil {
+		return template{}, errors.Wrapf(err, errConvertTemplateFmt, name)
+	}
+	if err := json.Unmarshal(b, &t.forProvider); err != nil {
+		return template{}, errors.Wrapf(err, errConvertTemplateFmt, name)
+	}
+	return t, nil
+}
+
+// toMap returns the JSON object encoding of the supplied value.
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{}
+	return m, json.Unmarshal(b, &m)
+}
+
+type clusterSet struct {
+	*clusterv1alpha1.ResourceSet
+}
+
+func (s clusterSet) object() client.Object {
+	return s.ResourceSet
+}
+
+func (s clusterSet) templates() ([]template, error) {
+	result := make([]template, len(s.Spec.Resources))
+	for i, t := range s.Spec.Resources {
+		var err error
+		if result[i], err = toTemplate(t.Name, t.DependsOn, t.ForProvider); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s clusterSet) resourceSpec() (map[string]any, error) {
+	return toMap(struct {
+		ProviderConfigReference *xpv1.Reference         `json:"providerConfigRef,omitempty"`
+		ManagementPolicies      xpv1.ManagementPolicies `json:"managementPolicies,omitempty"`
+		DeletionPolicy          xpv1.DeletionPolicy     `json:"deletionPolicy,omitempty"`
+	}{s.Spec.ProviderConfigReference, s.Spec.ManagementPolicies, s.Spec.DeletionPolicy})
+}
+
+func (s clusterSet) setConditions(c ...xpv1.Condition) {
+	s.Status.SetConditions(c...)
+}
+
+func (s clusterSet) setResources(resources []resourceStatus) {
+	s.Status.ObservedGeneration = s.GetGeneration()
+	s.Status.Resources = make([]clusterv1alpha1.ResourceSetResourceStatus, len(resources))
+	for i, r := range resources {
+		s.Status.Resources[i] = clusterv1alpha1.ResourceSetResourceStatus{Name: r.name, ResourceName: r.resourceName, ID: r.id, Ready: r.ready, Message: r.message}
+	}
+}
+
+type namespacedSet struct {
+	*namespacedv1alpha1.ResourceSet
+}
+
+func (s namespacedSet) object() client.Object {
+	return s.ResourceSet
+}
+
+func (s namespacedSet) templates() ([]template, error) {
+	result := make([]template, len(s.Spec.Resources))
+	for i, t := range s.Spec.Resources {
+		var err error
+		if result[i], err = toTemplate(t.Name, t.DependsOn, t.ForProvider); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s namespacedSet) resourceSpec() (map[string]any, error) {
+	return toMap(struct {
+		ProviderConfigReference *xpv1.ProviderConfigReference `json:"providerConfigRef,omitempty"`
+		ManagementPolicies      xpv1.ManagementPolicies       `json:"managementPolicies,omitempty"`
+	}{s.Spec.ProviderConfigReference, s.Spec.ManagementPolicies})
+}
+
+func (s namespacedSet) setConditions(c ...xpv1.Condition) {
+	s.Status.SetConditions(c...)
+}
+
+func (s namespacedSet) setResources(resources []resourceStatus) {
+	s.Status.ObservedGeneration = s.GetGeneration()
+	s.Status.Resources = make([]namespacedv1alpha1.ResourceSetResourceStatus, len(resources))
+	for i, r := range resources {
+		s.Status.Resources[i] = namespacedv1alpha1.ResourceSetResourceStatus{Name: r.name, ResourceName: r.resourceName, ID: r.id, Ready: r.ready, Message: r.message}
+	}
+}
+
+// Setup adds a controller that reconciles the ResourceSets of the supplied
+// scope.
+func Setup(mgr ctrl.Manager, o controller.Options, namespaced bool) error {
+	r := &reconciler{
+		client:      mgr.GetClient(),
+		newSet:      func() resourceSet { return clusterSet{&clusterv1alpha1.ResourceSet{}} },
+		setGVK:      clusterv1alpha1.ResourceSet_GroupVersionKind,
+		resourceGVK: clusterv1beta2.Resource_GroupVersionKind,
+	}
+	if namespaced {
+		r.newSet = func() resourceSet { return namespacedSet{&namespacedv1alpha1.ResourceSet{}} }
+		r.setGVK = namespacedv1alpha1.ResourceSet_GroupVersionKind
+		r.resourceGVK = namespacedv1beta2.Resource_GroupVersionKind
+	}
+	name := "resourceset/" + strings.ToLower(r.setGVK.GroupKind().String())
+	r.log = o.Logger.WithValues("controller", name)
+	r.record = event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	owned := &unstructured.Unstructured{}
+	owned.SetGroupVersionKind(r.resourceGVK)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(r.newSet().object()).
+		Owns(owned).
+		Complete(r)
+}
+
+// SetupGated adds a controller that reconciles the ResourceSets of the
+// supplied scope once their CRDs and the CRDs of the Resources are
+// available.
+func SetupGated(mgr ctrl.Manager, o controller.Options, namespaced bool) error {
+	gvks := []schema.GroupVersionKind{clusterv1alpha1.ResourceSet_GroupVersionKind, clusterv1beta2.Resource_GroupVersionKind}
+	if namespaced {
+		gvks = []schema.GroupVersionKind{namespacedv1alpha1.ResourceSet_GroupVersionKind, namespacedv1beta2.Resource_GroupVersionKind}
+	}
+	o.Gate.Register(func() {
+		if err := Setup(mgr, o, namespaced); err != nil {
+			mgr.GetLogger().Error(err, "unable to setup reconciler", "gvk", gvks[0], "gvk", gvks[1])
+		}
+	}, gvks...)
+	return nil
+}
@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceset
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1alpha1 "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1alpha1"
+	clusterv1beta2 "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+)
+
+const testSetUID = "set-uid"
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// testSet returns a ResourceSet of a virtual network and of a subnet
+// referencing it.
+func testSet() *clusterv1alpha1.ResourceSet {
+	return &clusterv1alpha1.ResourceSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "set", UID: testSetUID},
+		Spec: clusterv1alpha1.ResourceSetSpec{
+			Resources: []clusterv1alpha1.ResourceSetTemplate{
+				{Name: "subnet", ForProvider: clusterv1beta2.ResourceParameters{
+					Type:     ptr("Microsoft.Network/virtualNetworks/subnets@2023-04-01"),
+					Name:     ptr("a"),
+					ParentID: ptr("${resources.vnet.id}"),
+				}},
+				{Name: "vnet", ForProvider: clusterv1beta2.ResourceParameters{
+					Type: ptr("Microsoft.Network/virtualNetworks@2023-04-01"),
+					Name: ptr("vnet"),
+				}},
+			},
+		},
+	}
+}
+
+// testChild returns the Resource of the supplied template of the ResourceSet
+// returned by testSet.
+func testChild(template string, forProvider map[string]any, fields string) *unstructured.Unstructured {
+	child := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"forProvider": forProvider}}}
+	child.SetGroupVersionKind(clusterv1beta2.Resource_GroupVersionKind)
+	child.SetName("set-" + template)
+	child.SetLabels(map[string]string{LabelKeyResourceSet: "set", LabelKeyTemplate: template})
+	child.SetAnnotations(map[string]string{annotationKeyAppliedFields: fields})
+	child.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: clusterv1alpha1.CRDGroupVersion.String(),
+		Kind:       clusterv1alpha1.ResourceSet_Kind,
+		Name:       "set",
+		UID:        testSetUID,
+		Controller: ptr(true),
+	}})
+	return child
+}
+
+func testReconciler(t *testing.T, objs ...client.Object) (*reconciler, client.Client) {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := clusterv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := clusterv1beta2.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithStatusSubresource(&clusterv1alpha1.ResourceSet{}).
+		Build()
+	return &reconciler{
+		client:      kube,
+		log:         logging.NewNopLogger(),
+		record:      event.NewNopRecorder(),
+		newSet:      func() resourceSet { return clusterSet{&clusterv1alpha1.ResourceSet{}} },
+		setGVK:      clusterv1alpha1.ResourceSet_GroupVersionKind,
+		resourceGVK: clusterv1beta2.Resource_GroupVersionKind,
+	}, kube
+}
+
+// children returns the forProvider of the Resources of the ResourceSet
+// returned by testSet by name.
+func children(t *testing.T, kube client.Client) map[string]map[string]any {
+	t.Helper()
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(clusterv1beta2.Resource_GroupVersionKind.GroupVersion().WithKind("ResourceList"))
+	if err := kube.List(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+	result := make(map[string]map[string]any, len(l.Items))
+	for _, c := range l.Items {
+		result[c.GetName()], _, _ = unstructured.NestedMap(c.Object, "spec", "forProvider")
+	}
+	return result
+}
+
+func TestReconcile(t *testing.T) {
+	r, kube := testReconciler(t, testSet())
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "set"}}
+
+	// the subnet waits for the virtual network to be ready
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	want := map[string]map[string]any{
+		"set-vnet": {"type": "Microsoft.Network/virtualNetworks@2023-04-01", "name": "vnet"},
+	}
+	if got := children(t, kube); !reflect.DeepEqual(want, got) {
+		t.Fatalf("want the Resources %v, got %v", want, got)
+	}
+	set := &clusterv1alpha1.ResourceSet{}
+	if err := kube.Get(context.Background(), req.NamespacedName, set); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := set.Status.Resources[0].Message, "Waiting for the Resources of the templates vnet to be ready"; got != want {
+		t.Errorf("want the message %q, got %q", want, got)
+	}
+
+	vnet := &unstructured.Unstructured{}
+	vnet.SetGroupVersionKind(clusterv1beta2.Resource_GroupVersionKind)
+	if err := kube.Get(context.Background(), client.ObjectKey{Name: "set-vnet"}, vnet); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := vnet.GetAnnotations()[annotationKeyAppliedFields], "name,type"; got != want {
+		t.Errorf("want the applied fields %q, got %q", want, got)
+	}
+	vnet.Object["status"] = map[string]any{
+		"atProvider": map[string]any{"id": "/vnet"},
+		"conditions": []any{map[string]any{"type": "Ready", "status": "True", "reason": "Available", "lastTransitionTime": "2025-01-01T00:00:00Z"}},
+	}
+	if err := kube.Update(context.Background(), vnet); err != nil {
+		t.Fatal(err)
+	}
+
+	// the subnet is applied with the ID of the virtual network
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	want["set-subnet"] = map[string]any{"type": "Microsoft.Network/virtualNetworks/subnets@2023-04-01", "name": "a", "parentId": "/vnet"}
+	if got := children(t, kube); !reflect.DeepEqual(want, got) {
+		t.Errorf("want the Resources %v, got %v", want, got)
+	}
+}
+
+func TestApply(t *testing.T) {
+	const vnet = "Microsoft.Network/virtualNetworks@2023-04-01"
+	cases := map[string]struct {
+		child       *unstructured.Unstructured
+		forProvider map[string]any
+		want        map[string]any
+		wantFields  string
+		wantUpdate  bool
+	}{
+		"Create": {
+			forProvider: map[string]any{"type": vnet, "name": "vnet"},
+			want:        map[string]any{"type": vnet, "name": "vnet"},
+			wantFields:  "name,type",
+		},
+		"Unchanged": {
+			child:       testChild("vnet", map[string]any{"type": vnet, "name": "vnet", "location": "westeurope"}, "name,type"),
+			forProvider: map[string]any{"type": vnet, "name": "vnet"},
+			want:        map[string]any{"type": vnet, "name": "vnet", "location": "westeurope"},
+			wantFields:  "name,type",
+		},
+		"RemovedField": {
+			child:       testChild("vnet", map[string]any{"type": vnet, "name": "vnet", "body": map[string]any{}, "location": "westeurope"}, "body,name,type"),
+			forProvider: map[string]any{"type": vnet, "name": "vnet"},
+			want:        map[string]any{"type": vnet, "name": "vnet", "location": "westeurope"},
+			wantFields:  "name,type",
+			wantUpdate:  true,
+		},
+		"ChangedField": {
+			child:       testChild("vnet", map[string]any{"type": vnet, "name": "vnet", "location": "westeurope"}, "name,type"),
+			forProvider: map[string]any{"type": vnet, "name": "other"},
+			want:        map[string]any{"type": vnet, "name": "other", "location": "westeurope"},
+			wantFields:  "name,type",
+			wantUpdate:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			set := testSet()
+			objs := []client.Object{set}
+			if tc.child != nil {
+				objs = append(objs, tc.child)
+			}
+			r, kube := testReconciler(t, objs...)
+			var version string
+			if tc.child != nil {
+				if err := kube.Get(context.Background(), client.ObjectKeyFromObject(tc.child), tc.child); err != nil {
+					t.Fatal(err)
+				}
+				version = tc.child.GetResourceVersion()
+			}
+			if _, err := r.apply(context.Background(), clusterSet{set}, "vnet", tc.forProvider, nil, tc.child); err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			got := &unstructured.Unstructured{}
+			got.SetGroupVersionKind(clusterv1beta2.Resource_GroupVersionKind)
+			if err := kube.Get(context.Background(), client.ObjectKey{Name: "set-vnet"}, got); err != nil {
+				t.Fatal(err)
+			}
+			forProvider, _, _ := unstructured.NestedMap(got.Object, "spec", "forProvider")
+			if !reflect.DeepEqual(tc.want, forProvider) {
+				t.Errorf("want the forProvider %v, got %v", tc.want, forProvider)
+			}
+			if fields := got.GetAnnotations()[annotationKeyAppliedFields]; fields != tc.wantFields {
+				t.Errorf("want the applied fields %q, got %q", tc.wantFields, fields)
+			}
+			if tc.child != nil {
+				if updated := got.GetResourceVersion() != version; updated != tc.wantUpdate {
+					t.Errorf("want an update %t, got %t", tc.wantUpdate, updated)
+				}
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	set := testSet()
+	set.SetFinalizers([]string{finalizer})
+	now := metav1.Now()
+	set.SetDeletionTimestamp(&now)
+	r, kube := testReconciler(t, set,
+		testChild("vnet", map[string]any{"name": "vnet"}, "name"),
+		testChild("subnet", map[string]any{"parentId": "/vnet"}, "parentId"))
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "set"}}
+
+	// the virtual network is deleted once the subnet depending on it is gone
+	for _, want := range [][]string{{"set-vnet"}, nil} {
+		res, err := r.Reconcile(context.Background(), req)
+		if err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+		if res.RequeueAfter != deletionPollInterval {
+			t.Errorf("want a requeue after %s, got %s", deletionPollInterval, res.RequeueAfter)
+		}
+		var got []string
+		for name := range children(t, kube) {
+			got = append(got, name)
+		}
+		if !reflect.DeepEqual(want, got) {
+			t.Fatalf("want the remaining Resources %v, got %v", want, got)
+		}
+	}
+
+	// the finalizer is removed once the Resources are gone
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if err := kube.Get(context.Background(), req.NamespacedName, &clusterv1alpha1.ResourceSet{}); err == nil {
+		t.Errorf("want the ResourceSet deleted, got it")
+	}
+}
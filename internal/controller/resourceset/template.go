@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceset
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errDuplicateTemplateFmt = "duplicate template name %q"
+	errUnknownTemplateFmt   = "template %q depends on the unknown template %q"
+	errSelfReferenceFmt     = "template %q depends on itself"
+	errCycleFmt             = "the templates %s depend on each other"
+)
+
+// reference matches the references to the observed state of the Resources
+// of the other templates, e.g. ${resources.vnet.id}.
+var reference = regexp.MustCompile(`\$\{resources\.([a-z0-9]([-a-z0-9]*[a-z0-9])?)\.([^}]+)\}`)
+
+// A template is the template of a Resource of a ResourceSet.
+type template struct {
+	name        string
+	dependsOn   []string
+	forProvider map[string]any
+}
+
+// dependencies returns the names of the templates the supplied template
+// depends on, explicitly or through its references, sorted.
+func (t template) dependencies() []string {
+	names := map[string]bool{}
+	for _, n := range t.dependsOn {
+		names[n] = true
+	}
+	walkStrings(t.forProvider, func(s string) {
+		for _, m := range reference.FindAllStringSubmatch(s, -1) {
+			names[m[1]] = true
+		}
+	})
+	result := make([]string, 0, len(names))
+	for n := range names {
+		result = append(result, n)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// order returns the supplied templates in an order where every template
+// follows the ones it depends on, which is the order of the list otherwise,
+// along with the dependencies of each template. An error is returned if
+// the dependencies do not form a directed acyclic graph.
+func order(templates []template) ([]template, map[string][]string, error) { //nolint:gocyclo // Kahn's algorithm with validations
+	index := make(map[string]int, len(templates))
+	for i, t := range templates {
+		if _, ok := index[t.name]; ok {
+			return nil, nil, errors.Errorf(errDuplicateTemplateFmt, t.name)
+		}
+		index[t.name] = i
+	}
+	deps := make(map[string][]string, len(templates))
+	pending := make(map[string]int, len(templates))
+	dependents := map[string][]string{}
+	for _, t := range templates {
+		d := t.dependencies()
+		for _, n := range d {
+			if n == t.name {
+				return nil, nil, errors.Errorf(errSelfReferenceFmt, t.name)
+			}
+			if _, ok := index[n]; !ok {
+				return nil, nil, errors.Errorf(errUnknownTemplateFmt, t.name, n)
+			}
+			dependents[n] = append(dependents[n], t.name)
+		}
+		deps[t.name] = d
+		pending[t.name] = len(d)
+	}
+	result := make([]template, 0, len(templates))
+	done := make(map[string]bool, len(templates))
+	for len(result) < len(templates) {
+		// the first template of the list whose dependencies are all done
+		next := -1
+		for i, t := range templates {
+			if !done[t.name] && pending[t.name] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			var cycle []string
+			for _, t := range templates {
+				if !done[t.name] {
+					cycle = append(cycle, strconv.Quote(t.name))
+				}
+			}
+			return nil, nil, errors.Errorf(errCycleFmt, strings.Join(cycle, ", "))
+		}
+		t := templates[next]
+		done[t.name] = true
+		result = append(result, t)
+		for _, n := range dependents[t.name] {
+			pending[n]--
+		}
+	}
+	return result, deps, nil
+}
+
+// render returns a copy of the supplied value with the references replaced
+// with the values they reference in the supplied observed states of the
+// Resources, by template name. A string consisting of a single reference is
+// replaced with the referenced value, the references embedded in strings
+// with the string or JSON encoding of the values. The references to missing
+// values are returned and left as they are.
+func render(v any, observed map[string]map[string]any) (any, []string) {
+	var missing []string
+	var walk func(v any) any
+	walk = func(v any) any {
+		switch t := v.(type) {
+		case string:
+			if m := reference.FindStringSubmatch(t); m != nil && m[0] == t {
+				rv, ok := lookup(observed[m[1]], m[3])
+				if !ok {
+					missing = append(missing, t)
+					return t
+				}
+				return rv
+			}
+			return reference.ReplaceAllStringFunc(t, func(s string) string {
+				m := reference.FindStringSubmatch(s)
+				rv, ok := lookup(observed[m[1]], m[3])
+				if !ok {
+					missing = append(missing, s)
+					return s
+				}
+				if str, ok := rv.(string); ok {
+					return str
+				}
+				b, _ := json.Marshal(rv)
+				return string(b)
+			})
+		case map[string]any:
+			result := make(map[string]any, len(t))
+			for k, e := range t {
+				result[k] = walk(e)
+			}
+			return result
+		case []any:
+			result := make([]any, len(t))
+			for i, e := range t {
+				result[i] = walk(e)
+			}
+			return result
+		}
+		return v
+	}
+	return walk(v), missing
+}
+
+// lookup returns the value at the supplied dot separated path of the
+// supplied object and whether the path exists. The segments of the path
+// index the lists they apply to. A path to a JSON null exists and its value
+// is nil.
+func lookup(obj map[string]any, path string) (any, bool) {
+	var v any = obj
+	for _, s := range strings.Split(path, ".") {
+		switch t := v.(type) {
+		case map[string]any:
+			e, ok := t[s]
+			if !ok {
+				return nil, false
+			}
+			v = e
+		case []any:
+			i, err := strconv.Atoi(s)
+			if err != nil || i < 0 || i >= len(t) {
+				return nil, false
+			}
+			v = t[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// walkStrings calls the supplied function with the string values nested in
+// the supplied value.
+func walkStrings(v any, fn func(string)) {
+	switch t := v.(type) {
+	case string:
+		fn(t)
+	case map[string]any:
+		for _, e := range t {
+			walkStrings(e, fn)
+		}
+	case []any:
+		for _, e := range t {
+			walkStrings(e, fn)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceset
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestOrder(t *testing.T) {
+	vnet := template{name: "vnet", forProvider: map[string]any{"name": "vnet"}}
+	subnet := template{name: "subnet", forProvider: map[string]any{"parentId": "${resources.vnet.id}"}}
+	nic := template{name: "nic", forProvider: map[string]any{
+		"body": map[string]any{"properties": map[string]any{"ipConfigurations": []any{
+			map[string]any{"subnet": map[string]any{"id": "${resources.subnet.id}"}},
+		}}},
+	}}
+	rg := template{name: "rg"}
+	cases := map[string]struct {
+		templates []template
+		want      []string
+		wantDeps  map[string][]string
+		wantErr   string
+	}{
+		"ListOrder": {
+			templates: []template{rg, vnet},
+			want:      []string{"rg", "vnet"},
+			wantDeps:  map[string][]string{"rg": {}, "vnet": {}},
+		},
+		"References": {
+			templates: []template{nic, subnet, rg, vnet},
+			want:      []string{"rg", "vnet", "subnet", "nic"},
+			wantDeps:  map[string][]string{"nic": {"subnet"}, "subnet": {"vnet"}, "rg": {}, "vnet": {}},
+		},
+		"DependsOn": {
+			templates: []template{{name: "vnet", dependsOn: []string{"rg"}}, rg},
+			want:      []string{"rg", "vnet"},
+			wantDeps:  map[string][]string{"vnet": {"rg"}, "rg": {}},
+		},
+		"Duplicate": {
+			templates: []template{vnet, vnet},
+			wantErr:   `duplicate template name "vnet"`,
+		},
+		"Unknown": {
+			templates: []template{subnet},
+			wantErr:   `template "subnet" depends on the unknown template "vnet"`,
+		},
+		"SelfReference": {
+			templates: []template{{name: "vnet", forProvider: map[string]any{"name": "${resources.vnet.name}"}}},
+			wantErr:   `template "vnet" depends on itself`,
+		},
+		"Cycle": {
+			templates: []template{rg, {name: "vnet", dependsOn: []string{"subnet"}}, subnet},
+			wantErr:   `the templates "vnet", "subnet" depend on each other`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, deps, err := order(tc.templates)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			names := make([]string, len(got))
+			for i, tmpl := range got {
+				names[i] = tmpl.name
+			}
+			if !reflect.DeepEqual(tc.want, names) {
+				t.Errorf("want the order %v, got %v", tc.want, names)
+			}
+			if !reflect.DeepEqual(tc.wantDeps, deps) {
+				t.Errorf("want the dependencies %v, got %v", tc.wantDeps, deps)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	observed := map[string]map[string]any{
+		"vnet": {
+			"id": "/vnet",
+			"output": map[string]any{
+				"properties": map[string]any{
+					"addressSpace": map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}},
+					"guid":         nil,
+				},
+			},
+		},
+	}
+	cases := map[string]struct {
+		v           any
+		want        any
+		wantMissing []string
+	}{
+		"Reference": {
+			v:    map[string]any{"parentId": "${resources.vnet.id}"},
+			want: map[string]any{"parentId": "/vnet"},
+		},
+		"ReferenceToObject": {
+			v:    map[string]any{"space": "${resources.vnet.output.properties.addressSpace}"},
+			want: map[string]any{"space": map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}}},
+		},
+		"ListIndex": {
+			v:    []any{"${resources.vnet.output.properties.addressSpace.addressPrefixes.0}"},
+			want: []any{"10.0.0.0/16"},
+		},
+		"Embedded": {
+			v:    "${resources.vnet.id}/subnets/a in ${resources.vnet.output.properties.addressSpace.addressPrefixes}",
+			want: `/vnet/subnets/a in ["10.0.0.0/16"]`,
+		},
+		"Null": {
+			v:    map[string]any{"guid": "${resources.vnet.output.properties.guid}"},
+			want: map[string]any{"guid": nil},
+		},
+		"EmbeddedNull": {
+			v:    "guid: ${resources.vnet.output.properties.guid}",
+			want: "guid: null",
+		},
+		"Missing": {
+			v:           map[string]any{"a": "${resources.vnet.output.properties.missing}", "b": "${resources.subnet.id}/x"},
+			want:        map[string]any{"a": "${resources.vnet.output.properties.missing}", "b": "${resources.subnet.id}/x"},
+			wantMissing: []string{"${resources.subnet.id}", "${resources.vnet.output.properties.missing}"},
+		},
+		"OutOfRange": {
+			v:           "${resources.vnet.output.properties.addressSpace.addressPrefixes.1}",
+			want:        "${resources.vnet.output.properties.addressSpace.addressPrefixes.1}",
+			wantMissing: []string{"${resources.vnet.output.properties.addressSpace.addressPrefixes.1}"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, missing := render(tc.v, observed)
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+			sort.Strings(missing)
+			if !reflect.DeepEqual(tc.wantMissing, missing) {
+				t.Errorf("want the missing references %q, got %q", tc.wantMissing, missing)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	obj := map[string]any{
+		"id":   "/vnet",
+		"tags": nil,
+		"list": []any{map[string]any{"name": "a"}},
+	}
+	cases := map[string]struct {
+		path   string
+		want   any
+		wantOK bool
+	}{
+		"Value": {
+			path:   "id",
+			want:   "/vnet",
+			wantOK: true,
+		},
+		"Null": {
+			path:   "tags",
+			wantOK: true,
+		},
+		"Absent": {
+			path: "location",
+		},
+		"UnderNull": {
+			path: "tags.env",
+		},
+		"ListIndex": {
+			path:   "list.0.name",
+			want:   "a",
+			wantOK: true,
+		},
+		"ListName": {
+			path: "list.name",
+		},
+		"UnderString": {
+			path: "id.name",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := lookup(obj, tc.path)
+			if ok != tc.wantOK {
+				t.Errorf("want found %t, got %t", tc.wantOK, ok)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
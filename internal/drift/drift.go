@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package drift implements event-driven drift detection. Resource write and
+// delete events received from an Azure Event Grid webhook subscription or an
+// Event Hub are mapped to the managed resources observing the affected ARM
+// IDs, which are then enqueued for an immediate reconciliation instead of
+// waiting for the next poll.
+package drift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	xpresource "github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	clusterresources "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	namespacedresources "github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1beta2"
+	"github.com/upbound/provider-azapi/v2/config/typed"
+)
+
+const (
+	// AnnotationKeyEventTime is set on a managed resource to the time of the
+	// latest Azure event that enqueued it. Updating the annotation triggers
+	// a reconciliation of the managed resource.
+	AnnotationKeyEventTime = "azapi.upbound.io/drift-event-time"
+
+	defaultMinInterval = 10 * time.Second
+
+	rootGroupCluster    = "azapi.upbound.io"
+	rootGroupNamespaced = "azapi.m.upbound.io"
+
+	errListManaged  = "cannot list managed resources"
+	errPatchManaged = "cannot annotate the managed resource with the drift event time"
+	errNoToken      = "the Event Grid webhook requires a token unless unauthenticated deliveries are explicitly allowed"
+)
+
+// managedLists returns empty lists of the managed resource kinds whose
+// external names are ARM resource IDs, in both scopes, including the typed
+// kinds registered with the supplied scheme. The lists are of the versions
+// the controllers watch, so that the informers are shared.
+func managedLists(s *runtime.Scheme) []xpresource.ManagedList {
+	lists := []xpresource.ManagedList{
+		&clusterresources.ResourceList{},
+		&clusterresources.UpdateResourceList{},
+		&namespacedresources.ResourceList{},
+		&namespacedresources.UpdateResourceList{},
+	}
+	kinds, err := typed.Kinds()
+	if err != nil {
+		return lists
+	}
+	for _, k := range kinds {
+		for _, root := range []string{rootGroupCluster, rootGroupNamespaced} {
+			o, err := s.New(schema.GroupVersionKind{Group: k.Group + "." + root, Version: typed.Version, Kind: k.Kind + "List"})
+			if err != nil {
+				// the kind is not registered in this scope
+				continue
+			}
+			if l, ok := o.(xpresource.ManagedList); ok {
+				lists = append(lists, l)
+			}
+		}
+	}
+	return lists
+}
+
+// An EnqueuerOption configures an Enqueuer.
+type EnqueuerOption func(*Enqueuer)
+
+// WithLogger sets the logger of an Enqueuer.
+func WithLogger(l logging.Logger) EnqueuerOption {
+	return func(e *Enqueuer) {
+		e.log = l
+	}
+}
+
+// WithMinInterval sets the minimum interval between two enqueues of the same
+// ARM ID. Events received within the interval are coalesced.
+func WithMinInterval(d time.Duration) EnqueuerOption {
+	return func(e *Enqueuer) {
+		e.minInterval = d
+	}
+}
+
+// An Enqueuer enqueues the managed resources matching ARM resource IDs.
+type Enqueuer struct {
+	client      client.Client
+	log         logging.Logger
+	minInterval time.Duration
+
+	mu   sync.Mutex
+	last map[string]time.Time
+}
+
+// NewEnqueuer returns a new Enqueuer using the supplied client. The client
+// should be backed by the manager's cache.
+func NewEnqueuer(c client.Client, opts ...EnqueuerOption) *Enqueuer {
+	e := &Enqueuer{
+		client:      c,
+		log:         logging.NewNopLogger(),
+		minInterval: defaultMinInterval,
+		last:        make(map[string]time.Time),
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e
+}
+
+// Enqueue triggers the reconciliation of the managed resources whose
+// external names match any of the supplied ARM resource IDs. The match is
+// case-insensitive. The IDs are not considered enqueued if an error is
+// returned, so that the redelivery of the events is not coalesced.
+func (e *Enqueuer) Enqueue(ctx context.Context, ids []string) error {
+	pending := e.admit(ids)
+	if len(pending) == 0 {
+		return nil
+	}
+	if err := e.enqueue(ctx, pending); err != nil {
+		e.rollback(pending)
+		return err
+	}
+	return nil
+}
+
+func (e *Enqueuer) enqueue(ctx context.Context, pending map[string]struct{}) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, l := range managedLists(e.client.Scheme()) {
+		if err := e.client.List(ctx, l); err != nil {
+			if kmeta.IsNoMatchError(err) {
+				// the kind is not installed or not activated
+				continue
+			}
+			return errors.Wrap(err, errListManaged)
+		}
+		for _, mg := range l.GetItems() {
+			if _, ok := pending[strings.ToLower(meta.GetExternalName(mg))]; !ok {
+				continue
+			}
+			if err := e.annotate(ctx, mg, now); err != nil {
+				return err
+			}
+			e.log.Debug("Enqueued managed resource on Azure event", "kind", fmt.Sprintf("%T", mg), "name", mg.GetName(), "namespace", mg.GetNamespace(), "external-name", meta.GetExternalName(mg))
+		}
+	}
+	return nil
+}
+
+// admit returns the set of lower-cased IDs that have not been enqueued
+// within the minimum interval.
+func (e *Enqueuer) admit(ids []string) map[string]struct{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := time.Now()
+	result := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		id = strings.ToLower(strings.TrimSuffix(id, "/"))
+		if id == "" {
+			continue
+		}
+		if t, ok := e.last[id]; ok && now.Sub(t) < e.minInterval {
+			continue
+		}
+		e.last[id] = now
+		result[id] = struct{}{}
+	}
+	// drop the stale entries so that the map stays bounded
+	for id, t := range e.last {
+		if now.Sub(t) >= e.minInterval {
+			delete(e.last, id)
+		}
+	}
+	return result
+}
+
+// rollback drops the supplied IDs admitted by a failed enqueue.
+func (e *Enqueuer) rollback(ids map[string]struct{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for id := range ids {
+		delete(e.last, id)
+	}
+}
+
+func (e *Enqueuer) annotate(ctx context.Context, mg xpresource.Managed, t string) error {
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, AnnotationKeyEventTime, t)
+	err := e.client.Patch(ctx, mg, client.RawPatch(types.MergePatchType, []byte(patch)))
+	return errors.Wrap(client.IgnoreNotFound(err), errPatchManaged)
+}
+
+// EventHubOptions configures the Event Hub event source.
+type EventHubOptions struct {
+	// ConnectionString of the Event Hub. Either the connection string or
+	// the fully qualified namespace must be set.
+	ConnectionString string
+	// Namespace is the fully qualified Event Hubs namespace, e.g.
+	// example.servicebus.windows.net, used with the default Azure
+	// credential chain.
+	Namespace string
+	// Name of the Event Hub.
+	Name string
+	// ConsumerGroup to receive the events with.
+	ConsumerGroup string
+}
+
+// Options configures the drift event sources.
+type Options struct {
+	Logger logging.Logger
+	// MinInterval between two enqueues of the same ARM ID.
+	MinInterval time.Duration
+	// WebhookAddress is the address the Event Grid webhook listens on. The
+	// webhook is disabled if empty.
+	WebhookAddress string
+	// WebhookToken must be supplied by the Event Grid subscription as the
+	// value of the token query parameter. It's required unless
+	// WebhookAllowUnauthenticated is set.
+	WebhookToken string
+	// WebhookAllowUnauthenticated allows the webhook to accept deliveries
+	// without a token, e.g. behind an authenticating proxy.
+	WebhookAllowUnauthenticated bool
+	// EventHub configures the Event Hub source. The source is disabled if
+	// the Event Hub name is empty.
+	EventHub EventHubOptions
+}
+
+// Setup adds the configured drift event sources to the supplied manager.
+func Setup(mgr manager.Manager, o Options) error {
+	if o.Logger == nil {
+		o.Logger = logging.NewNopLogger()
+	}
+	opts := []EnqueuerOption{WithLogger(o.Logger)}
+	if o.MinInterval > 0 {
+		opts = append(opts, WithMinInterval(o.MinInterval))
+	}
+	e := NewEnqueuer(mgr.GetClient(), opts...)
+	if o.WebhookAddress != "" {
+		if o.WebhookToken == "" && !o.WebhookAllowUnauthenticated {
+			return errors.New(errNoToken)
+		}
+		if err := mgr.Add(NewWebhook(o.WebhookAddress, e, WithWebhookToken(o.WebhookToken), WithWebhookLogger(o.Logger))); err != nil {
+			return errors.Wrap(err, "cannot add the Event Grid webhook to the manager")
+		}
+	}
+	if o.EventHub.Name != "" {
+		s, err := NewEventHubSource(o.EventHub, e, o.Logger)
+		if err != nil {
+			return err
+		}
+		if err := mgr.Add(s); err != nil {
+			return errors.Wrap(err, "cannot add the Event Hub source to the manager")
+		}
+	}
+	return nil
+}
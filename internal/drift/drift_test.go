@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterresources "github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	namespacedresources "github.com/upbound/provider-azapi/v2/apis/namespaced/resources/v1beta2"
+)
+
+func testResource(name, id string) *clusterresources.Resource {
+	r := &clusterresources.Resource{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	meta.SetExternalName(r, id)
+	return r
+}
+
+// newTestEnqueuer returns an Enqueuer backed by a fake client observing the
+// supplied resources and counting the patches per resource name. The
+// patches fail while *fail is set.
+func newTestEnqueuer(t *testing.T, fail *bool, objs ...client.Object) (*Enqueuer, map[string]int) {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := clusterresources.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := namespacedresources.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	patches := map[string]int{}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if *fail {
+				return errors.New("boom")
+			}
+			patches[obj.GetName()]++
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	}).Build()
+	return NewEnqueuer(c, WithMinInterval(time.Hour)), patches
+}
+
+func TestEnqueue(t *testing.T) {
+	type step struct {
+		ids  []string
+		fail bool
+		// the patches per resource name expected after the step
+		want    map[string]int
+		wantErr bool
+	}
+	cases := map[string][]step{
+		"CaseInsensitiveMatch": {
+			{ids: []string{strings.ToUpper(testIDA) + "/"}, want: map[string]int{"a": 1}},
+		},
+		"Coalesced": {
+			{ids: []string{testIDA, testIDA}, want: map[string]int{"a": 1}},
+			{ids: []string{testIDA, testIDB}, want: map[string]int{"a": 1, "b": 1}},
+			{ids: []string{testIDB}, want: map[string]int{"a": 1, "b": 1}},
+		},
+		"RetriedAfterFailure": {
+			{ids: []string{testIDA}, fail: true, want: map[string]int{}, wantErr: true},
+			{ids: []string{testIDA}, want: map[string]int{"a": 1}},
+			{ids: []string{testIDA}, want: map[string]int{"a": 1}},
+		},
+		"Unmatched": {
+			{ids: []string{"/subscriptions/other"}, want: map[string]int{}},
+		},
+	}
+	for name, steps := range cases {
+		t.Run(name, func(t *testing.T) {
+			fail := false
+			e, patches := newTestEnqueuer(t, &fail, testResource("a", testIDA), testResource("b", testIDB))
+			for i, s := range steps {
+				fail = s.fail
+				err := e.Enqueue(context.Background(), s.ids)
+				if (err != nil) != s.wantErr {
+					t.Fatalf("step %d: Enqueue: want error %t, got %v", i, s.wantErr, err)
+				}
+				for n, want := range s.want {
+					if patches[n] != want {
+						t.Errorf("step %d: want %d patches of %s, got %d", i, want, n, patches[n])
+					}
+				}
+				if len(patches) != len(s.want) {
+					t.Errorf("step %d: want the patches %v, got %v", i, s.want, patches)
+				}
+			}
+		})
+	}
+}
+
+func TestEnqueueAnnotates(t *testing.T) {
+	fail := false
+	e, _ := newTestEnqueuer(t, &fail, testResource("a", testIDA))
+	if err := e.Enqueue(context.Background(), []string{testIDA}); err != nil {
+		t.Fatalf("Enqueue: %v", err)
+	}
+	got := &clusterresources.Resource{}
+	if err := e.client.Get(context.Background(), client.ObjectKey{Name: "a"}, got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, err := time.Parse(time.RFC3339, got.GetAnnotations()[AnnotationKeyEventTime]); err != nil {
+		t.Errorf("want the %s annotation set to an RFC 3339 time, got %v", AnnotationKeyEventTime, got.GetAnnotations())
+	}
+}
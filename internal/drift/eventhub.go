@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azeventhubs/v2"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultConsumerGroup = azeventhubs.DefaultConsumerGroup
+
+	receiveBatchSize = 100
+	receiveTimeout   = 10 * time.Second
+	retryBackoff     = 30 * time.Second
+
+	errNewConsumer = "cannot initialize the Event Hub consumer"
+)
+
+// EventHubSource receives events from all the partitions of an Event Hub.
+// Events routed by Event Grid and Activity Log records exported through
+// diagnostic settings are both understood. Receiving starts at the latest
+// event of each partition: events published while the provider is not
+// running are covered by the regular poll.
+type EventHubSource struct {
+	consumer *azeventhubs.ConsumerClient
+	enqueuer *Enqueuer
+	log      logging.Logger
+}
+
+// NewEventHubSource returns a new EventHubSource for the supplied options.
+func NewEventHubSource(o EventHubOptions, e *Enqueuer, l logging.Logger) (*EventHubSource, error) {
+	cg := o.ConsumerGroup
+	if cg == "" {
+		cg = defaultConsumerGroup
+	}
+	var c *azeventhubs.ConsumerClient
+	var err error
+	switch {
+	case o.ConnectionString != "":
+		c, err = azeventhubs.NewConsumerClientFromConnectionString(o.ConnectionString, o.Name, cg, nil)
+	case o.Namespace != "":
+		cred, cErr := azidentity.NewDefaultAzureCredential(nil)
+		if cErr != nil {
+			return nil, errors.Wrap(cErr, errNewConsumer)
+		}
+		c, err = azeventhubs.NewConsumerClient(o.Namespace, o.Name, cg, cred, nil)
+	default:
+		return nil, errors.New("either the Event Hub connection string or namespace must be set")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errNewConsumer)
+	}
+	return &EventHubSource{consumer: c, enqueuer: e, log: l}, nil
+}
+
+// Start receives events until the supplied context is done. It implements
+// manager.Runnable and runs on the leader only, as partition receivers of
+// the same consumer group would otherwise see duplicate events.
+func (s *EventHubSource) Start(ctx context.Context) error {
+	defer s.consumer.Close(context.Background()) //nolint:errcheck // nothing to do with the error
+	props, err := s.consumer.GetEventHubProperties(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot get the Event Hub properties")
+	}
+	s.log.Info("Receiving drift events from the Event Hub", "event-hub", props.Name, "partitions", len(props.PartitionIDs))
+	wg := sync.WaitGroup{}
+	for _, pid := range props.PartitionIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.receive(ctx, pid)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+func (s *EventHubSource) receive(ctx context.Context, partitionID string) {
+	log := s.log.WithValues("partition", partitionID)
+	latest := true
+	for ctx.Err() == nil {
+		pc, err := s.consumer.NewPartitionClient(partitionID, &azeventhubs.PartitionClientOptions{
+			StartPosition: azeventhubs.StartPosition{Latest: &latest},
+		})
+		if err != nil {
+			log.Info("Cannot create the Event Hub partition client", "error", err)
+			sleep(ctx, retryBackoff)
+			continue
+		}
+		err = s.receiveFrom(ctx, pc, log)
+		_ = pc.Close(context.Background())
+		if err != nil && ctx.Err() == nil {
+			log.Info("Cannot receive events from the Event Hub partition", "error", err)
+			sleep(ctx, retryBackoff)
+		}
+	}
+}
+
+func (s *EventHubSource) receiveFrom(ctx context.Context, pc *azeventhubs.PartitionClient, log logging.Logger) error {
+	for {
+		rctx, cancel := context.WithTimeout(ctx, receiveTimeout)
+		events, err := pc.ReceiveEvents(rctx, receiveBatchSize, nil)
+		cancel()
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		var ids []string
+		for _, e := range events {
+			p, err := parsePayload(e.Body)
+			if err != nil {
+				log.Debug("Cannot parse the Event Hub event", "error", err)
+				continue
+			}
+			ids = append(ids, p.ResourceIDs...)
+		}
+		if err := s.enqueuer.Enqueue(ctx, ids); err != nil {
+			log.Info("Cannot enqueue the managed resources of the Event Hub events", "error", err)
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	eventTypeSubscriptionValidation = "Microsoft.EventGrid.SubscriptionValidationEvent"
+
+	errUnmarshalEvents = "cannot unmarshal the event payload"
+)
+
+// resourceEventTypes are the Azure Resource Manager event types that may
+// signal a drift of the affected resource.
+var resourceEventTypes = map[string]struct{}{
+	"Microsoft.Resources.ResourceWriteSuccess":  {},
+	"Microsoft.Resources.ResourceDeleteSuccess": {},
+	"Microsoft.Resources.ResourceActionSuccess": {},
+}
+
+// event is an event in either the Event Grid or the CloudEvents 1.0 schema.
+type event struct {
+	// EventType is the type of an Event Grid schema event.
+	EventType string `json:"eventType"`
+	// Type is the type of a CloudEvents schema event.
+	Type    string          `json:"type"`
+	Subject string          `json:"subject"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func (e event) typ() string {
+	if e.EventType != "" {
+		return e.EventType
+	}
+	return e.Type
+}
+
+type eventData struct {
+	ResourceURI    string `json:"resourceUri"`
+	ValidationCode string `json:"validationCode"`
+}
+
+// activityLogRecord is an Activity Log record as exported by the diagnostic
+// settings of a subscription to an Event Hub.
+type activityLogRecord struct {
+	ResourceID    string `json:"resourceId"`
+	OperationName string `json:"operationName"`
+	ResultType    string `json:"resultType"`
+}
+
+// payload is the result of parsing an event delivery.
+type payload struct {
+	// ResourceIDs are the ARM IDs of the resources affected by the events.
+	ResourceIDs []string
+	// ValidationCode is set if the delivery is an Event Grid subscription
+	// validation request.
+	ValidationCode string
+}
+
+// parsePayload parses a batch of events in the Event Grid schema, a single
+// or a batch of events in the CloudEvents schema, or a batch of Activity Log
+// records.
+func parsePayload(b []byte) (payload, error) {
+	b = bytes.TrimSpace(b)
+	var events []event
+	switch {
+	case len(b) == 0:
+		return payload{}, nil
+	case b[0] == '[':
+		if err := json.Unmarshal(b, &events); err != nil {
+			return payload{}, errors.Wrap(err, errUnmarshalEvents)
+		}
+	default:
+		var obj struct {
+			event
+			Records []activityLogRecord `json:"records"`
+		}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return payload{}, errors.Wrap(err, errUnmarshalEvents)
+		}
+		if obj.Records != nil {
+			return payload{ResourceIDs: activityLogResourceIDs(obj.Records)}, nil
+		}
+		events = []event{obj.event}
+	}
+
+	p := payload{}
+	for _, e := range events {
+		t := e.typ()
+		if t == eventTypeSubscriptionValidation {
+			d := eventData{}
+			if err := json.Unmarshal(e.Data, &d); err != nil {
+				return payload{}, errors.Wrap(err, errUnmarshalEvents)
+			}
+			p.ValidationCode = d.ValidationCode
+			continue
+		}
+		if _, ok := resourceEventTypes[t]; !ok {
+			continue
+		}
+		d := eventData{}
+		// the subject is used if the data cannot be decoded
+		_ = json.Unmarshal(e.Data, &d)
+		id := d.ResourceURI
+		if id == "" {
+			id = e.Subject
+		}
+		if isARMID(id) {
+			p.ResourceIDs = append(p.ResourceIDs, id)
+		}
+	}
+	return p, nil
+}
+
+func activityLogResourceIDs(records []activityLogRecord) []string {
+	ids := make([]string, 0, len(records))
+	for _, r := range records {
+		if !strings.EqualFold(r.ResultType, "Success") || !isARMID(r.ResourceID) {
+			continue
+		}
+		op := strings.ToUpper(r.OperationName)
+		if strings.HasSuffix(op, "/WRITE") || strings.HasSuffix(op, "/DELETE") || strings.HasSuffix(op, "/ACTION") {
+			ids = append(ids, r.ResourceID)
+		}
+	}
+	return ids
+}
+
+func isARMID(id string) bool {
+	id = strings.ToLower(id)
+	return strings.HasPrefix(id, "/subscriptions/") || strings.HasPrefix(id, "/providers/")
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	testIDA = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/a"
+	testIDB = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/b"
+)
+
+func TestParsePayload(t *testing.T) {
+	cases := map[string]struct {
+		body    string
+		want    payload
+		wantErr bool
+	}{
+		"Empty": {
+			body: " ",
+			want: payload{},
+		},
+		"EventGridBatch": {
+			body: `[
+				{"eventType": "Microsoft.Resources.ResourceWriteSuccess", "subject": "ignored", "data": {"resourceUri": "` + testIDA + `"}},
+				{"eventType": "Microsoft.Resources.ResourceDeleteSuccess", "subject": "` + testIDB + `", "data": {}},
+				{"eventType": "Microsoft.Resources.ResourceWriteFailure", "data": {"resourceUri": "` + testIDA + `"}}
+			]`,
+			want: payload{ResourceIDs: []string{testIDA, testIDB}},
+		},
+		"EventGridValidation": {
+			body: `[{"eventType": "Microsoft.EventGrid.SubscriptionValidationEvent", "data": {"validationCode": "code"}}]`,
+			want: payload{ValidationCode: "code"},
+		},
+		"CloudEvent": {
+			body: `{"type": "Microsoft.Resources.ResourceActionSuccess", "subject": "` + testIDA + `", "data": {"resourceUri": "` + testIDA + `"}}`,
+			want: payload{ResourceIDs: []string{testIDA}},
+		},
+		"CloudEventsBatch": {
+			body: `[{"type": "Microsoft.Resources.ResourceWriteSuccess", "data": {"resourceUri": "` + testIDB + `"}}]`,
+			want: payload{ResourceIDs: []string{testIDB}},
+		},
+		"NotAnARMID": {
+			body: `[{"eventType": "Microsoft.Resources.ResourceWriteSuccess", "subject": "/blobServices/default", "data": {}}]`,
+			want: payload{},
+		},
+		"ActivityLog": {
+			body: `{"records": [
+				{"resourceId": "` + testIDA + `", "operationName": "MICROSOFT.RESOURCES/SUBSCRIPTIONS/RESOURCEGROUPS/WRITE", "resultType": "Success"},
+				{"resourceId": "` + testIDB + `", "operationName": "Microsoft.Resources/subscriptions/resourceGroups/delete", "resultType": "Start"},
+				{"resourceId": "` + testIDB + `", "operationName": "Microsoft.Resources/subscriptions/resourceGroups/read", "resultType": "Success"}
+			]}`,
+			want: payload{ResourceIDs: []string{testIDA}},
+		},
+		"InvalidJSON": {
+			body:    `[{"eventType": `,
+			wantErr: true,
+		},
+		"InvalidValidationData": {
+			body:    `[{"eventType": "Microsoft.EventGrid.SubscriptionValidationEvent", "data": "code"}]`,
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parsePayload([]byte(tc.body))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parsePayload: want error %t, got %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/pkg/errors"
+)
+
+const (
+	// WebhookPath is the path the Event Grid webhook serves events on.
+	WebhookPath = "/events"
+
+	maxPayloadSize  = 1 << 20
+	shutdownTimeout = 10 * time.Second
+)
+
+// A WebhookOption configures a Webhook.
+type WebhookOption func(*Webhook)
+
+// WithWebhookToken requires the supplied token as the value of the token
+// query parameter of the deliveries, which can be embedded in the endpoint
+// URL of the Event Grid subscription.
+func WithWebhookToken(t string) WebhookOption {
+	return func(w *Webhook) {
+		w.token = t
+	}
+}
+
+// WithWebhookLogger sets the logger of a Webhook.
+func WithWebhookLogger(l logging.Logger) WebhookOption {
+	return func(w *Webhook) {
+		w.log = l
+	}
+}
+
+// Webhook is an Event Grid webhook endpoint accepting deliveries in both the
+// Event Grid and the CloudEvents schemas.
+type Webhook struct {
+	address  string
+	token    string
+	enqueuer *Enqueuer
+	log      logging.Logger
+}
+
+// NewWebhook returns a new Webhook listening on the supplied address.
+func NewWebhook(address string, e *Enqueuer, opts ...WebhookOption) *Webhook {
+	w := &Webhook{
+		address:  address,
+		enqueuer: e,
+		log:      logging.NewNopLogger(),
+	}
+	for _, o := range opts {
+		o(w)
+	}
+	return w
+}
+
+// NeedLeaderElection returns false as events can be delivered to any
+// replica behind the webhook service.
+func (w *Webhook) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the webhook until the supplied context is done. It
+// implements manager.Runnable.
+func (w *Webhook) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(WebhookPath, w)
+	srv := &http.Server{
+		Addr:              w.address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+	w.log.Info("Serving the Event Grid drift webhook", "address", w.address, "path", WebhookPath)
+	select {
+	case err := <-errCh:
+		return errors.Wrap(err, "cannot serve the Event Grid drift webhook")
+	case <-ctx.Done():
+	}
+	sctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return errors.Wrap(srv.Shutdown(sctx), "cannot shut down the Event Grid drift webhook")
+}
+
+// ServeHTTP handles an Event Grid delivery.
+func (w *Webhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if w.token != "" && subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(w.token)) != 1 {
+		http.Error(rw, "invalid token", http.StatusUnauthorized)
+		return
+	}
+	switch r.Method {
+	case http.MethodOptions:
+		// CloudEvents abuse protection handshake
+		if origin := r.Header.Get("WebHook-Request-Origin"); origin != "" {
+			rw.Header().Set("WebHook-Allowed-Origin", origin)
+			rw.Header().Set("WebHook-Allowed-Rate", "*")
+		}
+		rw.WriteHeader(http.StatusOK)
+		return
+	case http.MethodPost:
+	default:
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	b, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(rw, "cannot read the request body", http.StatusBadRequest)
+		return
+	}
+	p, err := parsePayload(b)
+	if err != nil {
+		w.log.Debug("Cannot parse the Event Grid delivery", "error", err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if p.ValidationCode != "" {
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(map[string]string{"validationResponse": p.ValidationCode})
+		return
+	}
+	if err := w.enqueuer.Enqueue(r.Context(), p.ResourceIDs); err != nil {
+		// Event Grid retries the delivery on server errors.
+		w.log.Info("Cannot enqueue the managed resources of the Event Grid delivery", "error", err)
+		http.Error(rw, "cannot enqueue the managed resources", http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhook(t *testing.T) {
+	validation := `[{"eventType": "Microsoft.EventGrid.SubscriptionValidationEvent", "data": {"validationCode": "code"}}]`
+	write := `[{"eventType": "Microsoft.Resources.ResourceWriteSuccess", "data": {"resourceUri": "` + testIDA + `"}}]`
+	cases := map[string]struct {
+		token    string
+		method   string
+		target   string
+		body     string
+		fail     bool
+		want     int
+		wantBody string
+	}{
+		"MissingToken": {
+			token: "secret", method: http.MethodPost, target: "/", body: write,
+			want: http.StatusUnauthorized,
+		},
+		"WrongToken": {
+			token: "secret", method: http.MethodPost, target: "/?token=other", body: write,
+			want: http.StatusUnauthorized,
+		},
+		"Validation": {
+			token: "secret", method: http.MethodPost, target: "/?token=secret", body: validation,
+			want: http.StatusOK, wantBody: `"validationResponse":"code"`,
+		},
+		"Unauthenticated": {
+			method: http.MethodPost, target: "/", body: write,
+			want: http.StatusOK,
+		},
+		"BadPayload": {
+			method: http.MethodPost, target: "/", body: "[",
+			want: http.StatusBadRequest,
+		},
+		"EnqueueFailure": {
+			method: http.MethodPost, target: "/", body: write, fail: true,
+			want: http.StatusInternalServerError,
+		},
+		"MethodNotAllowed": {
+			method: http.MethodGet, target: "/",
+			want: http.StatusMethodNotAllowed,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fail := tc.fail
+			e, _ := newTestEnqueuer(t, &fail, testResource("a", testIDA))
+			w := NewWebhook(":0", e, WithWebhookToken(tc.token))
+			rec := httptest.NewRecorder()
+			w.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)))
+			if rec.Code != tc.want {
+				t.Errorf("want status %d, got %d", tc.want, rec.Code)
+			}
+			if !strings.Contains(rec.Body.String(), tc.wantBody) {
+				t.Errorf("want the body to contain %s, got %s", tc.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
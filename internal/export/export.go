@@ -0,0 +1,397 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package export generates Resource manifests from the existing Azure
+// resources of a resource group or subscription. Each resource is read at
+// the newest API version known to the Azure schema and its read-only
+// properties are removed, so that the manifests can be applied as they are.
+// The external names of the Resources are set to the IDs of the Azure
+// resources, so that the Resources adopt them.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/upbound/provider-azapi/v2/config/typed"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+	"github.com/upbound/provider-azapi/v2/internal/manifest"
+)
+
+const (
+	kind = "Resource"
+
+	// AnnotationKeyExportedFrom is set on the exported Resources to the
+	// type and API version the Azure resource was read with.
+	AnnotationKeyExportedFrom = "azapi.upbound.io/exported-from"
+
+	// resourcesAPIVersion is the API version of the resource group, the
+	// resource and the resource provider operations.
+	resourcesAPIVersion = "2021-04-01"
+
+	managementPolicyObserve = "Observe"
+
+	errInvalidScopeFmt   = "invalid scope %q: must be a subscription or a resource group ID"
+	errListResourceGroup = "cannot list the resource groups"
+	errListResourcesFmt  = "cannot list the resources of %s"
+	errGetProviderFmt    = "cannot get the resource provider %s"
+	errNoAPIVersionFmt   = "no API version of %s is known"
+	errGetResource       = "cannot get the resource"
+	errInvalidIDFmt      = "invalid resource ID %q"
+)
+
+// the properties of the Azure resources that are not part of the body of
+// the Resources, either because they are Resource arguments or because they
+// are managed by Azure
+var rootProperties = map[string]bool{
+	"id":         true,
+	"name":       true,
+	"type":       true,
+	"apiVersion": true,
+	"location":   true,
+	"tags":       true,
+	"identity":   true,
+	"etag":       true,
+	"systemData": true,
+}
+
+// Options configures an Exporter.
+type Options struct {
+	// Manifest configures the scope and the provider config of the
+	// Resources.
+	Manifest manifest.Options
+	// ObserveOnly exports the Resources with the Observe management policy
+	// instead of the full management.
+	ObserveOnly bool
+	// Schema is the Azure schema the read-only properties are determined
+	// with, in addition to the typed kinds embedded in the provider. Only
+	// the common read-only properties are removed from the resources of the
+	// types it does not have.
+	Schema *typed.Schema
+	// Log receives the progress of the export.
+	Log func(format string, args ...any)
+}
+
+// A Result is the export of a scope.
+type Result struct {
+	// Resources are the Resource manifests, sorted by the IDs of the Azure
+	// resources.
+	Resources []*unstructured.Unstructured
+	// Warnings about the resources that are not exported or whose read-only
+	// properties could not be determined.
+	Warnings []string
+}
+
+// An Exporter exports Azure resources as Resource manifests.
+type Exporter struct {
+	client *arm.Client
+	o      Options
+	kinds  []typed.Kind
+	b      *manifest.Builder
+	// apiVersions are the API versions of the resource types of the
+	// resource providers, by lower case namespace and type
+	apiVersions map[string]map[string][]string
+	names       map[string]bool
+}
+
+// NewExporter returns an Exporter reading the Azure resources with the
+// supplied client.
+func NewExporter(c *arm.Client, o Options) (*Exporter, error) {
+	if o.Log == nil {
+		o.Log = func(string, ...any) {}
+	}
+	kinds, err := typed.Kinds()
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{client: c, o: o, kinds: kinds, b: manifest.NewBuilder(o.Manifest), apiVersions: map[string]map[string][]string{}}, nil
+}
+
+// A listedResource is an item of a resource list.
+type listedResource struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// Export exports the resources of the supplied scope, which is either a
+// subscription ID, e.g. /subscriptions/<id>, whose resource groups are
+// exported too, or a resource group ID.
+func (e *Exporter) Export(ctx context.Context, scope string) (*Result, error) {
+	scope = "/" + strings.Trim(scope, "/")
+	segments := strings.Split(strings.TrimPrefix(scope, "/"), "/")
+	if !strings.EqualFold(segments[0], "subscriptions") || (len(segments) != 2 && (len(segments) != 4 || !strings.EqualFold(segments[2], "resourceGroups"))) {
+		return nil, errors.Errorf(errInvalidScopeFmt, scope)
+	}
+	subscription := "/subscriptions/" + segments[1]
+
+	var listed []listedResource
+	if len(segments) == 2 {
+		items, _, err := e.client.List(ctx, subscription+"/resourcegroups", resourcesAPIVersion, arm.ListOptions{})
+		if err != nil {
+			return nil, errors.Wrap(err, errListResourceGroup)
+		}
+		l, err := decode(items)
+		if err != nil {
+			return nil, errors.Wrap(err, errListResourceGroup)
+		}
+		listed = append(listed, l...)
+	}
+	items, _, err := e.client.List(ctx, scope+"/resources", resourcesAPIVersion, arm.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, errListResourcesFmt, scope)
+	}
+	l, err := decode(items)
+	if err != nil {
+		return nil, errors.Wrapf(err, errListResourcesFmt, scope)
+	}
+	listed = append(listed, l...)
+	sort.Slice(listed, func(i, j int) bool { return strings.ToLower(listed[i].ID) < strings.ToLower(listed[j].ID) })
+
+	result := &Result{}
+	for _, r := range listed {
+		e.o.Log("Exporting %s", r.ID)
+		u, warnings, err := e.export(ctx, subscription, r)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s is not exported: %s", r.ID, err))
+			continue
+		}
+		result.Resources = append(result.Resources, u)
+		for _, w := range warnings {
+			result.Warnings = append(result.Warnings, r.ID+": "+w)
+		}
+	}
+	return result, nil
+}
+
+func decode(items []any) ([]listedResource, error) {
+	b, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	var l []listedResource
+	return l, json.Unmarshal(b, &l)
+}
+
+// export returns the Resource of the supplied Azure resource.
+func (e *Exporter) export(ctx context.Context, subscription string, r listedResource) (*unstructured.Unstructured, []string, error) {
+	apiVersion, props, err := e.schema(ctx, subscription, r.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+	var warnings []string
+	if props == nil {
+		warnings = append(warnings, fmt.Sprintf("the schema of %s@%s is unknown, only the common read-only properties are removed", r.Type, apiVersion))
+	}
+	resp, err := e.client.Do(ctx, http.MethodGet, r.ID, apiVersion, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGetResource)
+	}
+	obj := map[string]any{}
+	if err := resp.Unmarshal(&obj); err != nil {
+		return nil, nil, errors.Wrap(err, errGetResource)
+	}
+	parentID, name, err := parentOf(r.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	forProvider := map[string]any{
+		"type":     r.Type + "@" + apiVersion,
+		"name":     name,
+		"parentId": parentID,
+		"body":     body(obj, props),
+	}
+	if v, ok := obj["location"].(string); ok && v != "" {
+		forProvider["location"] = v
+	}
+	if v, ok := obj["tags"].(map[string]any); ok && len(v) > 0 {
+		forProvider["tags"] = v
+	}
+	if v := identity(obj["identity"]); v != nil {
+		forProvider["identity"] = []any{v}
+	}
+	u, err := e.resource(r.ID, r.Type+"@"+apiVersion, forProvider)
+	return u, warnings, err
+}
+
+// schema returns the API version the supplied resource type is exported
+// at and the writable properties of its body, nil if they are unknown. The
+// API version of a typed kind is preferred, then the newest one the Azure
+// schema knows of, and the newest one of the resource provider otherwise.
+func (e *Exporter) schema(ctx context.Context, subscription, resourceType string) (string, []*typed.Property, error) {
+	var kind *typed.Kind
+	for i, k := range e.kinds {
+		if strings.EqualFold(k.Type, resourceType) && (kind == nil || k.APIVersion > kind.APIVersion) {
+			kind = &e.kinds[i]
+		}
+	}
+	if kind != nil {
+		return kind.APIVersion, kind.Body, nil
+	}
+	versions, err := e.versions(ctx, subscription, resourceType)
+	if err != nil {
+		return "", nil, err
+	}
+	if e.o.Schema != nil {
+		for _, v := range versions {
+			if !e.o.Schema.Has(resourceType + "@" + v) {
+				continue
+			}
+			props, err := e.o.Schema.Body(resourceType + "@" + v)
+			if err != nil {
+				return "", nil, err
+			}
+			return v, props, nil
+		}
+	}
+	return versions[0], nil, nil
+}
+
+// versions returns the API versions of the supplied resource type, the
+// stable ones first, newest first.
+func (e *Exporter) versions(ctx context.Context, subscription, resourceType string) ([]string, error) {
+	namespace, t, _ := strings.Cut(resourceType, "/")
+	ns := strings.ToLower(namespace)
+	types, ok := e.apiVersions[ns]
+	if !ok {
+		resp, err := e.client.Do(ctx, http.MethodGet, subscription+"/providers/"+namespace, resourcesAPIVersion, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, errGetProviderFmt, namespace)
+		}
+		var p struct {
+			ResourceTypes []struct {
+				ResourceType string   `json:"resourceType"`
+				APIVersions  []string `json:"apiVersions"`
+			} `json:"resourceTypes"`
+		}
+		if err := resp.Unmarshal(&p); err != nil {
+			return nil, errors.Wrapf(err, errGetProviderFmt, namespace)
+		}
+		types = make(map[string][]string, len(p.ResourceTypes))
+		for _, rt := range p.ResourceTypes {
+			types[strings.ToLower(rt.ResourceType)] = rt.APIVersions
+		}
+		e.apiVersions[ns] = types
+	}
+	versions := append([]string(nil), types[strings.ToLower(t)]...)
+	if len(versions) == 0 {
+		return nil, errors.Errorf(errNoAPIVersionFmt, resourceType)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		pi, pj := strings.Contains(versions[i], "-preview"), strings.Contains(versions[j], "-preview")
+		if pi != pj {
+			return !pi
+		}
+		return versions[i] > versions[j]
+	})
+	return versions, nil
+}
+
+// resource returns the Resource manifest with the supplied external name
+// and forProvider.
+func (e *Exporter) resource(id, typeAPIVersion string, forProvider map[string]any) (*unstructured.Unstructured, error) {
+	spec := map[string]any{"forProvider": forProvider}
+	if e.o.ObserveOnly {
+		spec["managementPolicies"] = []any{managementPolicyObserve}
+	}
+	return e.b.Build(kind, nameOf(id), map[string]string{
+		meta.AnnotationKeyExternalName: id,
+		AnnotationKeyExportedFrom:      typeAPIVersion,
+	}, spec)
+}
+
+// nameOf returns the resource group and the names of the resource with the
+// supplied ID, which the name of its Resource is derived from.
+func nameOf(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	var parts []string
+	for i := 0; i+1 < len(segments); i += 2 {
+		switch strings.ToLower(segments[i]) {
+		case "subscriptions", "providers":
+			// the namespace of a resource provider is followed by the
+			// type and name pairs of its resources
+		default:
+			parts = append(parts, segments[i+1])
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// parentOf returns the parent ID and the name of the resource with the
+// supplied ID.
+func parentOf(id string) (string, string, error) {
+	id = strings.TrimSuffix(id, "/")
+	i := strings.LastIndex(id, "/")
+	if i <= 0 {
+		return "", "", errors.Errorf(errInvalidIDFmt, id)
+	}
+	name := id[i+1:]
+	parent := id[:strings.LastIndex(id[:i], "/")]
+	// the top level resources of a resource provider are children of its
+	// scope
+	if j := strings.LastIndex(strings.ToLower(parent), "/providers/"); j >= 0 && !strings.Contains(parent[j+len("/providers/"):], "/") {
+		parent = parent[:j]
+	}
+	if parent == "" {
+		parent = "/"
+	}
+	return parent, name, nil
+}
+
+// body returns the body of the Resource of the supplied Azure resource,
+// with the supplied writable properties if they are known. The common
+// read-only properties are always removed, as the objects whose properties
+// are all read-only are dynamic in the schema.
+func body(obj map[string]any, props []*typed.Property) map[string]any {
+	var result map[string]any
+	if props != nil {
+		result = typed.Writable(obj, props)
+	} else {
+		result = make(map[string]any, len(obj))
+		for k, v := range obj {
+			if !rootProperties[k] {
+				result[k] = v
+			}
+		}
+	}
+	if p, ok := result["properties"].(map[string]any); ok {
+		delete(p, "provisioningState")
+		if len(p) == 0 {
+			delete(result, "properties")
+		}
+	}
+	return result
+}
+
+// identity returns the identity block of the Resource of the supplied Azure
+// identity, nil if there's none.
+func identity(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	t, _ := m["type"].(string)
+	if t == "" || strings.EqualFold(t, "None") {
+		return nil
+	}
+	result := map[string]any{"type": t}
+	ids, _ := m["userAssignedIdentities"].(map[string]any)
+	if len(ids) > 0 {
+		keys := make([]string, 0, len(ids))
+		for k := range ids {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		l := make([]any, len(keys))
+		for i, k := range keys {
+			l[i] = k
+		}
+		result["identityIds"] = l
+	}
+	return result
+}
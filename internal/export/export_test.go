@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+
+	"github.com/upbound/provider-azapi/v2/config/typed"
+	"github.com/upbound/provider-azapi/v2/internal/clients/arm"
+)
+
+const (
+	testRG   = "/subscriptions/sub/resourceGroups/rg"
+	testVNet = testRG + "/providers/Microsoft.Network/virtualNetworks/vnet"
+)
+
+// testVNetObject returns a virtual network as returned by Azure.
+func testVNetObject() map[string]any {
+	return map[string]any{
+		"id":       testVNet,
+		"name":     "vnet",
+		"type":     "Microsoft.Network/virtualNetworks",
+		"location": "westeurope",
+		"tags":     map[string]any{"env": "test"},
+		"etag":     "W/\"etag\"",
+		"identity": map[string]any{
+			"type":        "UserAssigned",
+			"principalId": "principal",
+			"userAssignedIdentities": map[string]any{
+				"/id/b": map[string]any{"principalId": "b"},
+				"/id/a": map[string]any{"principalId": "a"},
+			},
+		},
+		"systemData": map[string]any{"createdBy": "someone"},
+		"properties": map[string]any{
+			"provisioningState": "Succeeded",
+			"resourceGuid":      "guid",
+			"addressSpace":      map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}},
+		},
+	}
+}
+
+func TestBody(t *testing.T) {
+	props := []*typed.Property{{Name: "properties", Type: typed.TypeObject, Properties: []*typed.Property{
+		{Name: "addressSpace", Type: typed.TypeObject, Properties: []*typed.Property{
+			{Name: "addressPrefixes", Type: typed.TypeList, ElementType: typed.TypeString},
+		}},
+		{Name: "provisioningState", Type: typed.TypeString},
+	}}}
+	cases := map[string]struct {
+		obj   map[string]any
+		props []*typed.Property
+		want  map[string]any
+	}{
+		"UnknownSchema": {
+			obj: testVNetObject(),
+			want: map[string]any{"properties": map[string]any{
+				"resourceGuid": "guid",
+				"addressSpace": map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}},
+			}},
+		},
+		"KnownSchema": {
+			obj:   testVNetObject(),
+			props: props,
+			want: map[string]any{"properties": map[string]any{
+				"addressSpace": map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}},
+			}},
+		},
+		"OnlyReadOnlyProperties": {
+			obj: map[string]any{
+				"id":         testVNet,
+				"kind":       "StorageV2",
+				"properties": map[string]any{"provisioningState": "Succeeded"},
+			},
+			want: map[string]any{"kind": "StorageV2"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := body(tc.obj, tc.props); !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	cases := map[string]struct {
+		v    any
+		want map[string]any
+	}{
+		"Missing": {},
+		"None": {
+			v: map[string]any{"type": "None"},
+		},
+		"SystemAssigned": {
+			v:    map[string]any{"type": "SystemAssigned", "principalId": "principal", "tenantId": "tenant"},
+			want: map[string]any{"type": "SystemAssigned"},
+		},
+		"UserAssigned": {
+			v:    testVNetObject()["identity"],
+			want: map[string]any{"type": "UserAssigned", "identityIds": []any{"/id/a", "/id/b"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := identity(tc.v); !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParentOf(t *testing.T) {
+	cases := map[string]struct {
+		id         string
+		wantParent string
+		wantName   string
+		wantErr    bool
+	}{
+		"ResourceGroup": {
+			id:         testRG,
+			wantParent: "/subscriptions/sub",
+			wantName:   "rg",
+		},
+		"Subscription": {
+			id:         "/subscriptions/sub",
+			wantParent: "/",
+			wantName:   "sub",
+		},
+		"TopLevelResource": {
+			id:         testVNet,
+			wantParent: testRG,
+			wantName:   "vnet",
+		},
+		"ChildResource": {
+			id:         testVNet + "/subnets/a/",
+			wantParent: testVNet,
+			wantName:   "a",
+		},
+		"Extension": {
+			id:         testVNet + "/providers/Microsoft.Authorization/locks/lock",
+			wantParent: testVNet,
+			wantName:   "lock",
+		},
+		"Invalid": {
+			id:      "vnet",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			parent, name, err := parentOf(tc.id)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("want error %t, got %v", tc.wantErr, err)
+			}
+			if parent != tc.wantParent || name != tc.wantName {
+				t.Errorf("want %q and %q, got %q and %q", tc.wantParent, tc.wantName, parent, name)
+			}
+		})
+	}
+}
+
+func TestNameOf(t *testing.T) {
+	cases := map[string]struct {
+		id   string
+		want string
+	}{
+		"ResourceGroup":    {id: testRG, want: "rg"},
+		"TopLevelResource": {id: testVNet, want: "rg-vnet"},
+		"ChildResource":    {id: testVNet + "/subnets/a", want: "rg-vnet-a"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := nameOf(tc.id); got != tc.want {
+				t.Errorf("want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+// testARMServer serves the resources of a resource group with a virtual
+// network and a resource of a type without API versions.
+func testARMServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	responses := map[string]any{
+		testRG + "/resources?api-version=" + resourcesAPIVersion: map[string]any{"value": []any{
+			map[string]any{"id": testVNet, "type": "Microsoft.Network/virtualNetworks"},
+			map[string]any{"id": testRG + "/providers/Microsoft.Foo/bars/bar", "type": "Microsoft.Foo/bars"},
+		}},
+		"/subscriptions/sub/providers/Microsoft.Network?api-version=" + resourcesAPIVersion: map[string]any{"resourceTypes": []any{
+			map[string]any{"resourceType": "virtualNetworks", "apiVersions": []any{"2022-01-01", "2024-01-01-preview", "2023-04-01"}},
+		}},
+		"/subscriptions/sub/providers/Microsoft.Foo?api-version=" + resourcesAPIVersion: map[string]any{"resourceTypes": []any{}},
+		testVNet + "?api-version=2023-04-01": testVNetObject(),
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.RequestURI()]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.RequestURI())
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+}
+
+func TestExport(t *testing.T) {
+	srv := testARMServer(t)
+	defer srv.Close()
+
+	e, err := NewExporter(arm.NewClient(nil, arm.WithEndpoint(srv.URL)), Options{ObserveOnly: true})
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	result, err := e.Export(context.Background(), strings.TrimPrefix(testRG, "/"))
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	wantWarnings := []string{
+		testRG + "/providers/Microsoft.Foo/bars/bar is not exported: no API version of Microsoft.Foo/bars is known",
+		testVNet + ": the schema of Microsoft.Network/virtualNetworks@2023-04-01 is unknown, only the common read-only properties are removed",
+	}
+	if !reflect.DeepEqual(wantWarnings, result.Warnings) {
+		t.Errorf("want the warnings %q, got %q", wantWarnings, result.Warnings)
+	}
+	if len(result.Resources) != 1 {
+		t.Fatalf("want 1 Resource, got %d", len(result.Resources))
+	}
+	u := result.Resources[0]
+	if got, want := u.GetName(), "rg-vnet"; got != want {
+		t.Errorf("want the name %q, got %q", want, got)
+	}
+	wantAnnotations := map[string]string{
+		meta.AnnotationKeyExternalName: testVNet,
+		AnnotationKeyExportedFrom:      "Microsoft.Network/virtualNetworks@2023-04-01",
+	}
+	if got := u.GetAnnotations(); !reflect.DeepEqual(wantAnnotations, got) {
+		t.Errorf("want the annotations %v, got %v", wantAnnotations, got)
+	}
+	wantSpec := map[string]any{
+		"managementPolicies": []any{managementPolicyObserve},
+		"forProvider": map[string]any{
+			"type":     "Microsoft.Network/virtualNetworks@2023-04-01",
+			"name":     "vnet",
+			"parentId": testRG,
+			"location": "westeurope",
+			"tags":     map[string]any{"env": "test"},
+			"identity": []any{map[string]any{"type": "UserAssigned", "identityIds": []any{"/id/a", "/id/b"}}},
+			"body": map[string]any{"properties": map[string]any{
+				"resourceGuid": "guid",
+				"addressSpace": map[string]any{"addressPrefixes": []any{"10.0.0.0/16"}},
+			}},
+		},
+	}
+	if got := u.Object["spec"]; !reflect.DeepEqual(wantSpec, got) {
+		t.Errorf("want the spec %v, got %v", wantSpec, got)
+	}
+}
+
+func TestExportInvalidScope(t *testing.T) {
+	e, err := NewExporter(arm.NewClient(nil), Options{})
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	want := `invalid scope "/subscriptions/sub/providers/Microsoft.Network": must be a subscription or a resource group ID`
+	if _, err := e.Export(context.Background(), "/subscriptions/sub/providers/Microsoft.Network"); err == nil || err.Error() != want {
+		t.Errorf("want error %q, got %v", want, err)
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manifest builds the managed resource manifests generated by the
+// commands of the provider, e.g. from ARM templates, existing Azure
+// resources or Terraform states.
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	clusterGroup    = "resources.azapi.upbound.io"
+	namespacedGroup = "resources.azapi.m.upbound.io"
+	version         = "v1beta2"
+
+	defaultProviderConfigKind = "ClusterProviderConfig"
+	defaultName               = "resource"
+	maxNameLength             = 63
+	// room for the suffix making the names unique
+	maxBaseNameLength = maxNameLength - 4
+
+	errMarshal = "cannot marshal the managed resource"
+)
+
+// Options configures a Builder.
+type Options struct {
+	// Namespaced selects the namespaced managed resources.
+	Namespaced bool
+	// Namespace of the namespaced managed resources.
+	Namespace string
+	// ProviderConfigKind is the kind of the provider config referenced by
+	// the namespaced managed resources. Defaults to ClusterProviderConfig.
+	ProviderConfigKind string
+	// ProviderConfigName is the name of the provider config referenced by
+	// the managed resources. The default provider config is used if empty.
+	ProviderConfigName string
+}
+
+// A Builder builds managed resource manifests of the resources group with
+// unique names.
+type Builder struct {
+	o     Options
+	names map[string]bool
+}
+
+// NewBuilder returns a Builder building the managed resources of the scope
+// selected by the supplied options.
+func NewBuilder(o Options) *Builder {
+	if o.ProviderConfigKind == "" {
+		o.ProviderConfigKind = defaultProviderConfigKind
+	}
+	return &Builder{o: o, names: map[string]bool{}}
+}
+
+// Build returns the managed resource of the supplied kind, e.g. Resource,
+// with the supplied annotations and spec. Its name is derived from the
+// supplied one, which may be any string, and is unique among the managed
+// resources built by the Builder. The provider config reference of the
+// options is added to the spec.
+func (b *Builder) Build(kind, name string, annotations map[string]string, spec map[string]any) (*unstructured.Unstructured, error) {
+	group := clusterGroup
+	metadata := map[string]any{"name": b.uniqueName(name)}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	pcRef := map[string]any{"name": b.o.ProviderConfigName}
+	if b.o.Namespaced {
+		group = namespacedGroup
+		metadata["namespace"] = b.o.Namespace
+		pcRef["kind"] = b.o.ProviderConfigKind
+	}
+	if b.o.ProviderConfigName != "" {
+		spec["providerConfigRef"] = pcRef
+	}
+	// the JSON round trip converts the numbers to the types of the
+	// unstructured objects
+	j, err := json.Marshal(map[string]any{
+		"apiVersion": group + "/" + version,
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       spec,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshal)
+	}
+	u := &unstructured.Unstructured{}
+	return u, errors.Wrap(u.UnmarshalJSON(j), errMarshal)
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// uniqueName returns a Kubernetes object name derived from the supplied
+// name that no other managed resource built by the Builder has.
+func (b *Builder) uniqueName(name string) string {
+	n := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(n) > maxBaseNameLength {
+		n = strings.TrimRight(n[:maxBaseNameLength], "-")
+	}
+	if n == "" {
+		n = defaultName
+	}
+	result := n
+	for i := 2; b.names[result]; i++ {
+		result = fmt.Sprintf("%s-%d", n, i)
+	}
+	b.names[result] = true
+	return result
+}
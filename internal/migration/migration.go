@@ -0,0 +1,428 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migration moves cluster-scoped managed resources to their
+// namespaced counterparts without deleting the external resources. The
+// namespaced managed resource is first created with the same external name
+// and an observe-only management policy. Once it is ready, the legacy managed
+// resource is made observe-only with an orphan deletion policy, the
+// management policies of the legacy managed resource are handed over to the
+// namespaced one, and the legacy managed resource is deleted, leaving the
+// external resource in place.
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// AnnotationKeyMigratedFrom is set on a namespaced managed resource to the
+	// kind and name of the cluster-scoped managed resource it was migrated
+	// from.
+	AnnotationKeyMigratedFrom = "azapi.upbound.io/migrated-from"
+	// AnnotationKeyMigratedFromUID is set on a namespaced managed resource
+	// to the UID of the cluster-scoped managed resource it was migrated
+	// from, which allows it to take over the external resource owned by the
+	// latter.
+	AnnotationKeyMigratedFromUID = "azapi.upbound.io/migrated-from-uid"
+
+	clusterGroup    = "resources.azapi.upbound.io"
+	namespacedGroup = "resources.azapi.m.upbound.io"
+	version         = "v1beta2"
+
+	defaultProviderConfigKind = "ClusterProviderConfig"
+	defaultProviderConfigName = "default"
+	defaultPollInterval       = 5 * time.Second
+	defaultReadyTimeout       = 10 * time.Minute
+
+	managementPolicyAll     = "*"
+	managementPolicyObserve = "Observe"
+
+	errUnsupportedKind = "unsupported kind %q: must be one of Resource, UpdateResource or DataPlaneResource"
+	errGetLegacy       = "cannot get the cluster-scoped managed resource"
+	errListLegacy      = "cannot list the cluster-scoped managed resources"
+	errNoExternalName  = "cluster-scoped managed resource has no external name"
+	errGetNamespaced   = "cannot get the namespaced managed resource"
+	errExists          = "a namespaced managed resource with the same name, not migrated from %s, already exists"
+	errCreate          = "cannot create the namespaced managed resource"
+	errNotReady        = "namespaced managed resource did not become ready"
+	errOrphanLegacy    = "cannot orphan the cluster-scoped managed resource"
+	errHandOver        = "cannot hand the management policies over to the namespaced managed resource"
+	errDeleteLegacy    = "cannot delete the cluster-scoped managed resource"
+	errMarshalPlan     = "cannot marshal the namespaced managed resource"
+)
+
+// Kinds are the managed resource kinds that can be migrated.
+var Kinds = []string{"Resource", "UpdateResource", "DataPlaneResource"}
+
+// Options configures a Migrator.
+type Options struct {
+	// Namespace of the namespaced managed resources.
+	Namespace string
+	// ProviderConfigKind is the kind of the provider config referenced by
+	// the namespaced managed resources. Defaults to ClusterProviderConfig.
+	ProviderConfigKind string
+	// ProviderConfigName is the name of the provider config referenced by
+	// the namespaced managed resources. Defaults to the name of the provider
+	// config of the cluster-scoped managed resource.
+	ProviderConfigName string
+	// KeepLegacy keeps the orphaned cluster-scoped managed resources instead
+	// of deleting them.
+	KeepLegacy bool
+	// ReadyTimeout is how long to wait for a namespaced managed resource to
+	// become ready before handing it over the management policies.
+	ReadyTimeout time.Duration
+	// PollInterval is the interval at which the readiness of a namespaced
+	// managed resource is checked.
+	PollInterval time.Duration
+	// Log receives the progress of the migration.
+	Log func(format string, args ...any)
+}
+
+// A Plan is the migration of a cluster-scoped managed resource.
+type Plan struct {
+	// Legacy is the cluster-scoped managed resource.
+	Legacy *unstructured.Unstructured
+	// Namespaced is the namespaced managed resource to create, with an
+	// observe-only management policy.
+	Namespaced *unstructured.Unstructured
+	// ManagementPolicies are handed over to the namespaced managed resource
+	// once it is ready.
+	ManagementPolicies []string
+	// Warnings about the parts of the legacy managed resource that cannot be
+	// migrated as is.
+	Warnings []string
+}
+
+// A Migrator migrates cluster-scoped managed resources to namespaced ones.
+type Migrator struct {
+	client client.Client
+	o      Options
+}
+
+// NewMigrator returns a Migrator using the supplied client.
+func NewMigrator(c client.Client, o Options) *Migrator {
+	if o.ProviderConfigKind == "" {
+		o.ProviderConfigKind = defaultProviderConfigKind
+	}
+	if o.ReadyTimeout == 0 {
+		o.ReadyTimeout = defaultReadyTimeout
+	}
+	if o.PollInterval == 0 {
+		o.PollInterval = defaultPollInterval
+	}
+	if o.Log == nil {
+		o.Log = func(string, ...any) {}
+	}
+	return &Migrator{client: c, o: o}
+}
+
+func checkKind(kind string) error {
+	for _, k := range Kinds {
+		if k == kind {
+			return nil
+		}
+	}
+	return errors.Errorf(errUnsupportedKind, kind)
+}
+
+// Plans returns the migration plans of the cluster-scoped managed resources
+// of the supplied kind with the supplied names, or matching the supplied
+// label selector if no name is supplied.
+func (m *Migrator) Plans(ctx context.Context, kind string, names []string, selector labels.Selector) ([]*Plan, error) {
+	if err := checkKind(kind); err != nil {
+		return nil, err
+	}
+	var legacy []unstructured.Unstructured
+	if len(names) > 0 {
+		for _, n := range names {
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(schema.GroupVersionKind{Group: clusterGroup, Version: version, Kind: kind})
+			if err := m.client.Get(ctx, types.NamespacedName{Name: n}, u); err != nil {
+				return nil, errors.Wrapf(err, "%s: %s", errGetLegacy, n)
+			}
+			legacy = append(legacy, *u)
+		}
+	} else {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(schema.GroupVersionKind{Group: clusterGroup, Version: version, Kind: kind + "List"})
+		if err := m.client.List(ctx, l, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, errors.Wrap(err, errListLegacy)
+		}
+		legacy = l.Items
+	}
+	plans := make([]*Plan, 0, len(legacy))
+	for i := range legacy {
+		p, err := m.plan(&legacy[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %s", kind, legacy[i].GetName())
+		}
+		plans = append(plans, p)
+	}
+	return plans, nil
+}
+
+func (m *Migrator) plan(legacy *unstructured.Unstructured) (*Plan, error) {
+	en := meta.GetExternalName(legacy)
+	if en == "" {
+		return nil, errors.New(errNoExternalName)
+	}
+	p := &Plan{Legacy: legacy, ManagementPolicies: []string{managementPolicyAll}}
+	if mp, ok, _ := unstructured.NestedStringSlice(legacy.Object, "spec", "managementPolicies"); ok && len(mp) > 0 {
+		p.ManagementPolicies = mp
+	}
+
+	n := &unstructured.Unstructured{}
+	n.SetGroupVersionKind(schema.GroupVersionKind{Group: namespacedGroup, Version: version, Kind: legacy.GetKind()})
+	n.SetNamespace(m.o.Namespace)
+	n.SetName(legacy.GetName())
+	n.SetAnnotations(map[string]string{
+		meta.AnnotationKeyExternalName: en,
+		AnnotationKeyMigratedFrom:      migratedFrom(legacy),
+		AnnotationKeyMigratedFromUID:   string(legacy.GetUID()),
+	})
+	lbls := map[string]string{}
+	for k, v := range legacy.GetLabels() {
+		// the labels set by Crossplane relate the legacy managed resource
+		// to its composite and claim, which are not migrated
+		if strings.HasPrefix(k, "crossplane.io/") {
+			continue
+		}
+		lbls[k] = v
+	}
+	if len(lbls) > 0 {
+		n.SetLabels(lbls)
+	}
+
+	spec := map[string]any{
+		"managementPolicies": []any{managementPolicyObserve},
+	}
+	for _, f := range []string{"forProvider", "initProvider"} {
+		if v, ok, _ := unstructured.NestedFieldCopy(legacy.Object, "spec", f); ok {
+			p.Warnings = append(p.Warnings, namespaceReferences(v, "spec."+f, m.o.Namespace)...)
+			spec[f] = v
+		}
+	}
+	pcName := m.o.ProviderConfigName
+	if pcName == "" {
+		pcName, _, _ = unstructured.NestedString(legacy.Object, "spec", "providerConfigRef", "name")
+	}
+	if pcName == "" {
+		pcName = defaultProviderConfigName
+	}
+	spec["providerConfigRef"] = map[string]any{"kind": m.o.ProviderConfigKind, "name": pcName}
+	if name, ok, _ := unstructured.NestedString(legacy.Object, "spec", "writeConnectionSecretToRef", "name"); ok {
+		spec["writeConnectionSecretToRef"] = map[string]any{"name": name}
+		if ns, _, _ := unstructured.NestedString(legacy.Object, "spec", "writeConnectionSecretToRef", "namespace"); ns != m.o.Namespace {
+			p.Warnings = append(p.Warnings, fmt.Sprintf("the connection secret %s/%s is written to the namespace %s instead", ns, name, m.o.Namespace))
+		}
+	}
+	if dp, _, _ := unstructured.NestedString(legacy.Object, "spec", "deletionPolicy"); dp == string(xpv1.DeletionOrphan) {
+		// namespaced managed resources have no deletion policy, so the
+		// orphan policy is expressed with the management policies
+		p.ManagementPolicies = withoutDelete(p.ManagementPolicies)
+	}
+	n.Object["spec"] = spec
+	p.Namespaced = n
+	return p, nil
+}
+
+// namespaceReferences points the references and selectors of managed
+// resources found in the supplied parameters, e.g. parentIdRef, to the
+// supplied namespace, as the cluster-scoped managed resources they resolve
+// are expected to be migrated to the same namespace. It returns a warning
+// per reference and selector.
+func namespaceReferences(params any, path, namespace string) []string {
+	var warnings []string
+	switch v := params.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := path + "." + k
+			switch e := v[k].(type) {
+			case map[string]any:
+				switch {
+				case strings.HasSuffix(k, "Ref"):
+					name, _ := e["name"].(string)
+					e["namespace"] = namespace
+					warnings = append(warnings, fmt.Sprintf("%s references %s, which must be migrated to the namespace %s too", p, name, namespace))
+					continue
+				case strings.HasSuffix(k, "Selector"):
+					e["namespace"] = namespace
+					w := fmt.Sprintf("%s selects the managed resources in the namespace %s, which must be migrated there too", p, namespace)
+					if mcr, _ := e["matchControllerRef"].(bool); mcr {
+						w += ", and only matches those of the same composite resource, which is not migrated"
+					}
+					warnings = append(warnings, w)
+					continue
+				}
+			case []any:
+				if strings.HasSuffix(k, "Refs") {
+					for i, r := range e {
+						if rm, ok := r.(map[string]any); ok {
+							name, _ := rm["name"].(string)
+							rm["namespace"] = namespace
+							warnings = append(warnings, fmt.Sprintf("%s[%d] references %s, which must be migrated to the namespace %s too", p, i, name, namespace))
+						}
+					}
+					continue
+				}
+			}
+			warnings = append(warnings, namespaceReferences(v[k], p, namespace)...)
+		}
+	case []any:
+		for i, e := range v {
+			warnings = append(warnings, namespaceReferences(e, fmt.Sprintf("%s[%d]", path, i), namespace)...)
+		}
+	}
+	return warnings
+}
+
+// withoutDelete returns the supplied management policies without the Delete
+// policy.
+func withoutDelete(policies []string) []string {
+	if len(policies) == 1 && policies[0] == managementPolicyAll {
+		return []string{string(xpv1.ManagementActionObserve), string(xpv1.ManagementActionCreate), string(xpv1.ManagementActionUpdate), string(xpv1.ManagementActionLateInitialize)}
+	}
+	result := make([]string, 0, len(policies))
+	for _, p := range policies {
+		if p != string(xpv1.ManagementActionDelete) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func migratedFrom(legacy *unstructured.Unstructured) string {
+	return legacy.GetKind() + "." + clusterGroup + "/" + legacy.GetName()
+}
+
+// DryRun returns the YAML of the namespaced managed resource of the supplied
+// plan, preceded by comments describing the steps of the migration.
+func DryRun(p *Plan) ([]byte, error) {
+	b, err := yaml.Marshal(p.Namespaced.Object)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalPlan)
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Migration of %s\n", migratedFrom(p.Legacy))
+	fmt.Fprintf(&sb, "# 1. create %s/%s with the Observe management policy\n", p.Namespaced.GetNamespace(), p.Namespaced.GetName())
+	sb.WriteString("# 2. set the deletion policy of the legacy managed resource to Orphan and its management policies to Observe\n")
+	fmt.Fprintf(&sb, "# 3. set the management policies of the namespaced managed resource to %s\n", strings.Join(p.ManagementPolicies, ", "))
+	sb.WriteString("# 4. delete the orphaned legacy managed resource\n")
+	for _, w := range p.Warnings {
+		fmt.Fprintf(&sb, "# warning: %s\n", w)
+	}
+	sb.WriteString("---\n")
+	sb.Write(b)
+	return []byte(sb.String()), nil
+}
+
+// Migrate executes the supplied plan. The steps already taken by a previous
+// execution of the plan are skipped.
+func (m *Migrator) Migrate(ctx context.Context, p *Plan) error {
+	ref := migratedFrom(p.Legacy)
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(p.Namespaced.GroupVersionKind())
+	err := m.client.Get(ctx, client.ObjectKeyFromObject(p.Namespaced), current)
+	switch {
+	case kerrors.IsNotFound(err):
+		if err := m.client.Create(ctx, p.Namespaced.DeepCopy()); err != nil {
+			return errors.Wrap(err, errCreate)
+		}
+		m.o.Log("created %s %s/%s with the Observe management policy", p.Namespaced.GetKind(), p.Namespaced.GetNamespace(), p.Namespaced.GetName())
+	case err != nil:
+		return errors.Wrap(err, errGetNamespaced)
+	case current.GetAnnotations()[AnnotationKeyMigratedFrom] != ref:
+		return errors.Errorf(errExists, ref)
+	}
+
+	if err := m.waitReady(ctx, p.Namespaced); err != nil {
+		return err
+	}
+
+	orphan := map[string]any{"spec": map[string]any{
+		"deletionPolicy":     string(xpv1.DeletionOrphan),
+		"managementPolicies": []string{managementPolicyObserve},
+	}}
+	if err := m.client.Patch(ctx, p.Legacy, mergePatch(orphan)); client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errOrphanLegacy)
+	}
+	m.o.Log("orphaned %s", ref)
+
+	handOver := map[string]any{"spec": map[string]any{"managementPolicies": p.ManagementPolicies}}
+	if err := m.client.Patch(ctx, p.Namespaced, mergePatch(handOver)); err != nil {
+		return errors.Wrap(err, errHandOver)
+	}
+	m.o.Log("set the management policies of %s/%s to %s", p.Namespaced.GetNamespace(), p.Namespaced.GetName(), strings.Join(p.ManagementPolicies, ", "))
+
+	if m.o.KeepLegacy {
+		return nil
+	}
+	if err := m.client.Delete(ctx, p.Legacy); client.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errDeleteLegacy)
+	}
+	m.o.Log("deleted %s", ref)
+	return nil
+}
+
+// waitReady waits until the supplied managed resource is ready.
+func (m *Migrator) waitReady(ctx context.Context, mg *unstructured.Unstructured) error {
+	ctx, cancel := context.WithTimeout(ctx, m.o.ReadyTimeout)
+	defer cancel()
+	for {
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(mg.GroupVersionKind())
+		if err := m.client.Get(ctx, client.ObjectKeyFromObject(mg), current); err != nil {
+			return errors.Wrap(err, errGetNamespaced)
+		}
+		if conditionStatus(current, xpv1.TypeReady) == corev1.ConditionTrue {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), errNotReady)
+		case <-time.After(m.o.PollInterval):
+		}
+	}
+}
+
+func mergePatch(v map[string]any) client.Patch {
+	b, _ := json.Marshal(v) //nolint:errchkjson // marshals strings only
+	return client.RawPatch(types.MergePatchType, b)
+}
+
+func conditionStatus(u *unstructured.Unstructured, ct xpv1.ConditionType) corev1.ConditionStatus {
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conditions {
+		cm, ok := c.(map[string]any)
+		if !ok || cm["type"] != string(ct) {
+			continue
+		}
+		s, _ := cm["status"].(string)
+		return corev1.ConditionStatus(s)
+	}
+	return corev1.ConditionUnknown
+}
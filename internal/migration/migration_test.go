@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNamespaceReferences(t *testing.T) {
+	cases := map[string]struct {
+		params       any
+		want         any
+		wantWarnings []string
+	}{
+		"NoReferences": {
+			params: map[string]any{"type": "Microsoft.Storage/storageAccounts@2023-05-01", "outputRef": "a"},
+			want:   map[string]any{"type": "Microsoft.Storage/storageAccounts@2023-05-01", "outputRef": "a"},
+		},
+		"Reference": {
+			params: map[string]any{"parentIdRef": map[string]any{"name": "rg"}},
+			want:   map[string]any{"parentIdRef": map[string]any{"name": "rg", "namespace": "ns"}},
+			wantWarnings: []string{
+				"spec.forProvider.parentIdRef references rg, which must be migrated to the namespace ns too",
+			},
+		},
+		"References": {
+			params: map[string]any{"identity": []any{map[string]any{"identityIdsRefs": []any{map[string]any{"name": "a"}, map[string]any{"name": "b"}}}}},
+			want:   map[string]any{"identity": []any{map[string]any{"identityIdsRefs": []any{map[string]any{"name": "a", "namespace": "ns"}, map[string]any{"name": "b", "namespace": "ns"}}}}},
+			wantWarnings: []string{
+				"spec.forProvider.identity[0].identityIdsRefs[0] references a, which must be migrated to the namespace ns too",
+				"spec.forProvider.identity[0].identityIdsRefs[1] references b, which must be migrated to the namespace ns too",
+			},
+		},
+		"Selectors": {
+			params: map[string]any{
+				"parentIdSelector": map[string]any{"matchLabels": map[string]any{"app": "a"}},
+				"subnetSelector":   map[string]any{"matchControllerRef": true},
+			},
+			want: map[string]any{
+				"parentIdSelector": map[string]any{"matchLabels": map[string]any{"app": "a"}, "namespace": "ns"},
+				"subnetSelector":   map[string]any{"matchControllerRef": true, "namespace": "ns"},
+			},
+			wantWarnings: []string{
+				"spec.forProvider.parentIdSelector selects the managed resources in the namespace ns, which must be migrated there too",
+				"spec.forProvider.subnetSelector selects the managed resources in the namespace ns, which must be migrated there too, and only matches those of the same composite resource, which is not migrated",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := namespaceReferences(tc.params, "spec.forProvider", "ns")
+			if !reflect.DeepEqual(tc.wantWarnings, got) {
+				t.Errorf("want the warnings %q, got %q", tc.wantWarnings, got)
+			}
+			if !reflect.DeepEqual(tc.want, tc.params) {
+				t.Errorf("want the parameters %v, got %v", tc.want, tc.params)
+			}
+		})
+	}
+}
+
+func TestPlanAnnotations(t *testing.T) {
+	legacy := &unstructured.Unstructured{}
+	legacy.SetGroupVersionKind(schema.GroupVersionKind{Group: clusterGroup, Version: version, Kind: "Resource"})
+	legacy.SetName("sa")
+	legacy.SetUID("legacy")
+	legacy.SetAnnotations(map[string]string{meta.AnnotationKeyExternalName: "id"})
+
+	p, err := NewMigrator(nil, Options{Namespace: "ns"}).plan(legacy)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	want := map[string]string{
+		meta.AnnotationKeyExternalName: "id",
+		AnnotationKeyMigratedFrom:      "Resource.resources.azapi.upbound.io/sa",
+		AnnotationKeyMigratedFromUID:   "legacy",
+	}
+	if got := p.Namespaced.GetAnnotations(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want the annotations %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storageversion
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	resultMigrated = "migrated"
+	resultFailed   = "failed"
+
+	stateRunning   = 1
+	stateSucceeded = 2
+	stateFailed    = 3
+)
+
+var (
+	migratedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "azapi_storage_version_migration",
+		Name:      "objects_total",
+		Help:      "Number of objects rewritten in the storage version of their CRD, by result.",
+	}, []string{"crd", "result"})
+
+	migrationState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "azapi_storage_version_migration",
+		Name:      "state",
+		Help:      "State of the storage version migration of a CRD: 1 running, 2 succeeded, 3 failed.",
+	}, []string{"crd"})
+)
+
+func registerMetrics() error {
+	for _, c := range []prometheus.Collector{migratedObjects, migrationState} {
+		if err := metrics.Registry.Register(c); err != nil {
+			return errors.Wrap(err, "cannot register the storage version migration metrics")
+		}
+	}
+	return nil
+}
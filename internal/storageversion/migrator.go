@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storageversion migrates the objects of the provider's CRDs to
+// their storage version. Each object still stored in a previous version is
+// rewritten in the storage version, after which the stored versions of the
+// CRD are reduced to the storage version, so that the previous versions can
+// eventually be dropped from the CRD.
+package storageversion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	groupSuffix           = ".azapi.upbound.io"
+	namespacedGroupSuffix = ".azapi.m.upbound.io"
+
+	defaultPageSize      = 500
+	defaultRetryInterval = time.Minute
+
+	reasonMigrationStarted   event.Reason = "StorageVersionMigrationStarted"
+	reasonMigrationSucceeded event.Reason = "StorageVersionMigrationSucceeded"
+	reasonMigrationFailed    event.Reason = "StorageVersionMigrationFailed"
+
+	errListCRDs        = "cannot list the custom resource definitions"
+	errNoStorage       = "custom resource definition has no storage version"
+	errStorageChanged  = "storage version of the custom resource definition changed during the migration"
+	errListObjects     = "cannot list the objects to migrate"
+	errMigrateObject   = "cannot rewrite the object in the storage version"
+	errUpdateStoredVer = "cannot update the stored versions of the custom resource definition"
+)
+
+// An Option configures a Migrator.
+type Option func(*Migrator)
+
+// WithLogger sets the logger of a Migrator.
+func WithLogger(l logging.Logger) Option {
+	return func(m *Migrator) {
+		m.log = l
+	}
+}
+
+// WithRecorder sets the event recorder of a Migrator. The events are
+// recorded on the custom resource definitions.
+func WithRecorder(r event.Recorder) Option {
+	return func(m *Migrator) {
+		m.record = r
+	}
+}
+
+// WithPageSize sets the number of objects listed at once.
+func WithPageSize(n int64) Option {
+	return func(m *Migrator) {
+		m.pageSize = n
+	}
+}
+
+// WithRetryInterval sets the interval after which the CRDs whose migration
+// failed are migrated again.
+func WithRetryInterval(d time.Duration) Option {
+	return func(m *Migrator) {
+		m.retryInterval = d
+	}
+}
+
+// A Migrator migrates the objects of the CRDs of a set of API groups to the
+// storage versions of the CRDs. It runs until all the CRDs are migrated.
+type Migrator struct {
+	reader        client.Reader
+	client        client.Client
+	groups        map[string]bool
+	log           logging.Logger
+	record        event.Recorder
+	pageSize      int64
+	retryInterval time.Duration
+}
+
+// NewMigrator returns a Migrator of the CRDs of the supplied API groups. The
+// reader should not be backed by a cache, as the objects are only listed
+// once.
+func NewMigrator(r client.Reader, c client.Client, groups []string, opts ...Option) *Migrator {
+	m := &Migrator{
+		reader:        r,
+		client:        c,
+		groups:        make(map[string]bool, len(groups)),
+		log:           logging.NewNopLogger(),
+		record:        event.NewNopRecorder(),
+		pageSize:      defaultPageSize,
+		retryInterval: defaultRetryInterval,
+	}
+	for _, g := range groups {
+		m.groups[g] = true
+	}
+	for _, o := range opts {
+		o(m)
+	}
+	return m
+}
+
+// NeedLeaderElection returns true so that the objects are migrated by the
+// leader only.
+func (m *Migrator) NeedLeaderElection() bool {
+	return true
+}
+
+// Start migrates the CRDs, retrying the failed ones, until all of them are
+// migrated or the supplied context is done.
+func (m *Migrator) Start(ctx context.Context) error {
+	for {
+		pending, err := m.MigrateAll(ctx)
+		if err != nil {
+			m.log.Info("Cannot migrate the storage versions", "error", err)
+		}
+		if err == nil && pending == 0 {
+			m.log.Info("Storage versions migrated")
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(m.retryInterval):
+		}
+	}
+}
+
+// MigrateAll migrates the CRDs of the API groups and returns the number of
+// CRDs whose migration failed.
+func (m *Migrator) MigrateAll(ctx context.Context) (int, error) {
+	l := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := m.reader.List(ctx, l); err != nil {
+		return 0, errors.Wrap(err, errListCRDs)
+	}
+	pending := 0
+	for i := range l.Items {
+		crd := &l.Items[i]
+		if !m.groups[crd.Spec.Group] {
+			continue
+		}
+		if err := m.Migrate(ctx, crd); err != nil {
+			pending++
+			migrationState.WithLabelValues(crd.Name).Set(stateFailed)
+			m.record.Event(crd, event.Warning(reasonMigrationFailed, err))
+			m.log.Info("Cannot migrate the storage version", "crd", crd.Name, "error", err)
+		}
+	}
+	return pending, nil
+}
+
+// Migrate rewrites the objects of the supplied CRD in its storage version,
+// unless the storage version is the only stored version, and then reduces
+// the stored versions of the CRD to the storage version.
+func (m *Migrator) Migrate(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) error {
+	storage := storageVersion(crd)
+	if storage == "" {
+		return errors.New(errNoStorage)
+	}
+	if slices.Equal(crd.Status.StoredVersions, []string{storage}) {
+		migrationState.WithLabelValues(crd.Name).Set(stateSucceeded)
+		return nil
+	}
+	log := m.log.WithValues("crd", crd.Name, "storage-version", storage, "stored-versions", strings.Join(crd.Status.StoredVersions, ","))
+	log.Info("Migrating the storage version")
+	migrationState.WithLabelValues(crd.Name).Set(stateRunning)
+	m.record.Event(crd, event.Normal(reasonMigrationStarted, fmt.Sprintf("Rewriting the objects stored in versions %s in the storage version %s", strings.Join(crd.Status.StoredVersions, ", "), storage)))
+
+	gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: storage, Kind: crd.Spec.Names.ListKind}
+	n, err := m.migrateObjects(ctx, gvk, crd.Name)
+	if err != nil {
+		return err
+	}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &apiextensionsv1.CustomResourceDefinition{}
+		if err := m.reader.Get(ctx, client.ObjectKeyFromObject(crd), current); err != nil {
+			return err
+		}
+		// the storage version may have changed in the meantime, in which
+		// case the objects are migrated at the next attempt
+		if storageVersion(current) != storage {
+			return errors.New(errStorageChanged)
+		}
+		current.Status.StoredVersions = []string{storage}
+		return m.client.Status().Update(ctx, current)
+	})
+	if err != nil {
+		return errors.Wrap(err, errUpdateStoredVer)
+	}
+	migrationState.WithLabelValues(crd.Name).Set(stateSucceeded)
+	m.record.Event(crd, event.Normal(reasonMigrationSucceeded, fmt.Sprintf("Rewrote %d objects in the storage version %s", n, storage)))
+	log.Info("Migrated the storage version", "objects", n)
+	return nil
+}
+
+// migrateObjects rewrites all the objects of the supplied list kind and
+// returns the number of objects rewritten. An unchanged update is enough
+// for the API server to store an object in the storage version.
+func (m *Migrator) migrateObjects(ctx context.Context, listGVK schema.GroupVersionKind, crd string) (int, error) {
+	n := 0
+	cont := ""
+	for {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(listGVK)
+		if err := m.reader.List(ctx, l, client.Limit(m.pageSize), client.Continue(cont)); err != nil {
+			return n, errors.Wrap(err, errListObjects)
+		}
+		for i := range l.Items {
+			err := m.client.Update(ctx, &l.Items[i])
+			// a conflicting or deleted object has been written since it was
+			// listed, and thus is already stored in the storage version
+			if err != nil && !kerrors.IsConflict(err) && !kerrors.IsNotFound(err) {
+				migratedObjects.WithLabelValues(crd, resultFailed).Inc()
+				return n, errors.Wrapf(err, "%s: %s", errMigrateObject, objectName(&l.Items[i]))
+			}
+			migratedObjects.WithLabelValues(crd, resultMigrated).Inc()
+			n++
+		}
+		cont = l.GetContinue()
+		if cont == "" {
+			return n, nil
+		}
+	}
+}
+
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+func objectName(o client.Object) string {
+	if o.GetNamespace() == "" {
+		return o.GetName()
+	}
+	return o.GetNamespace() + "/" + o.GetName()
+}
+
+// Groups returns the API groups of the provider's kinds registered with the
+// supplied scheme.
+func Groups(s *runtime.Scheme) []string {
+	var groups []string
+	for gvk := range s.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, groupSuffix) && !strings.HasSuffix(gvk.Group, namespacedGroupSuffix) {
+			continue
+		}
+		if !slices.Contains(groups, gvk.Group) {
+			groups = append(groups, gvk.Group)
+		}
+	}
+	slices.Sort(groups)
+	return groups
+}
+
+// Options configures the storage version migration.
+type Options struct {
+	Logger logging.Logger
+}
+
+// Setup adds a Migrator of the CRDs of the provider's API groups registered
+// with the manager's scheme to the supplied manager.
+func Setup(mgr manager.Manager, o Options) error {
+	if o.Logger == nil {
+		o.Logger = logging.NewNopLogger()
+	}
+	if err := registerMetrics(); err != nil {
+		return err
+	}
+	m := NewMigrator(mgr.GetAPIReader(), mgr.GetClient(), Groups(mgr.GetScheme()),
+		WithLogger(o.Logger),
+		WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor("storage-version-migration"))))
+	return errors.Wrap(mgr.Add(m), "cannot add the storage version migrator to the manager")
+}
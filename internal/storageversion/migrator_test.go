@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storageversion
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+)
+
+const testCRDName = "resources.resources.azapi.upbound.io"
+
+func testCRD(storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRDName},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: v1beta2.CRDGroup,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Resource", ListKind: "ResourceList"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1beta1", Served: true},
+				{Name: v1beta2.CRDVersion, Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	conflict := kerrors.NewConflict(schema.GroupResource{}, "", nil)
+	cases := map[string]struct {
+		storedVersions     []string
+		objectConflict     bool
+		statusConflicts    int
+		wantObjectUpdates  int
+		wantStatusUpdates  int
+		wantStoredVersions []string
+	}{
+		"AlreadyMigrated": {
+			storedVersions:     []string{"v1beta2"},
+			wantStoredVersions: []string{"v1beta2"},
+		},
+		"Migrated": {
+			storedVersions:     []string{"v1beta1", "v1beta2"},
+			wantObjectUpdates:  2,
+			wantStatusUpdates:  1,
+			wantStoredVersions: []string{"v1beta2"},
+		},
+		"ObjectConflict": {
+			storedVersions:     []string{"v1beta1", "v1beta2"},
+			objectConflict:     true,
+			wantObjectUpdates:  2,
+			wantStatusUpdates:  1,
+			wantStoredVersions: []string{"v1beta2"},
+		},
+		"StoredVersionsConflict": {
+			storedVersions:     []string{"v1beta1", "v1beta2"},
+			statusConflicts:    1,
+			wantObjectUpdates:  2,
+			wantStatusUpdates:  2,
+			wantStoredVersions: []string{"v1beta2"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := apiextensionsv1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := v1beta2.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			objectUpdates, statusUpdates := 0, 0
+			kube := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(testCRD(tc.storedVersions...),
+					&v1beta2.Resource{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+					&v1beta2.Resource{ObjectMeta: metav1.ObjectMeta{Name: "b"}}).
+				WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+						objectUpdates++
+						if tc.objectConflict {
+							return conflict
+						}
+						return c.Update(ctx, obj, opts...)
+					},
+					SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						statusUpdates++
+						if statusUpdates <= tc.statusConflicts {
+							return conflict
+						}
+						return c.SubResource(subResource).Update(ctx, obj, opts...)
+					},
+				}).
+				Build()
+
+			m := NewMigrator(kube, kube, []string{v1beta2.CRDGroup}, WithPageSize(1))
+			if err := m.Migrate(context.Background(), testCRD(tc.storedVersions...)); err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			if objectUpdates != tc.wantObjectUpdates {
+				t.Errorf("want %d object updates, got %d", tc.wantObjectUpdates, objectUpdates)
+			}
+			if statusUpdates != tc.wantStatusUpdates {
+				t.Errorf("want %d status updates, got %d", tc.wantStatusUpdates, statusUpdates)
+			}
+			got := &apiextensionsv1.CustomResourceDefinition{}
+			if err := kube.Get(context.Background(), client.ObjectKey{Name: testCRDName}, got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.wantStoredVersions, got.Status.StoredVersions) {
+				t.Errorf("want the stored versions %v, got %v", tc.wantStoredVersions, got.Status.StoredVersions)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tfstate translates the azapi resources of a Terraform state into
+// managed resource manifests, so that their ownership can move from
+// Terraform to Crossplane without recreating them. The external names of the
+// managed resources are set to the IDs of the state, so that they adopt the
+// Azure resources, and their parameters are converted from the attributes
+// of the state. The resources are expected to be removed from the Terraform
+// state, e.g. with terraform state rm, once the managed resources are ready.
+package tfstate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/upbound/provider-azapi/v2/apis/cluster/resources/v1beta2"
+	"github.com/upbound/provider-azapi/v2/internal/manifest"
+)
+
+const (
+	// AnnotationKeyTerraformAddress is set on the managed resources to the
+	// address of the Terraform resource instance they are translated from,
+	// e.g. module.network.azapi_resource.subnet["default"].
+	AnnotationKeyTerraformAddress = "azapi.upbound.io/terraform-address"
+
+	stateVersion = 4
+	modeManaged  = "managed"
+
+	managementPolicyObserve = "Observe"
+
+	attrID            = "id"
+	attrSensitiveBody = "sensitive_body"
+
+	errParseState      = "cannot parse the Terraform state"
+	errStateVersionFmt = "unsupported Terraform state version %d, only the version %d is supported"
+	errNoIDFmt         = "the instance %s has no ID"
+	errConvertFmt      = "cannot convert the attribute %s"
+	errDecodeDynamic   = "cannot decode the dynamic value"
+)
+
+// the kinds of the managed resources by the type of the Terraform resources
+var kinds = map[string]string{
+	"azapi_resource":            "Resource",
+	"azapi_update_resource":     "UpdateResource",
+	"azapi_data_plane_resource": "DataPlaneResource",
+}
+
+// the parameters of the managed resources by the type of the Terraform
+// resources, whose tf struct tags name the Terraform attributes. The
+// parameters of the cluster-scoped and the namespaced managed resources
+// have the same Terraform attributes.
+var parameters = map[string]reflect.Type{
+	"azapi_resource":            reflect.TypeOf(v1beta2.ResourceParameters{}),
+	"azapi_update_resource":     reflect.TypeOf(v1beta2.UpdateResourceParameters{}),
+	"azapi_data_plane_resource": reflect.TypeOf(v1beta2.DataPlaneResourceParameters{}),
+}
+
+var jsonType = reflect.TypeOf(apiextensionsv1.JSON{})
+
+// Options configures the translation of a Terraform state.
+type Options struct {
+	// Manifest configures the scope and the provider config of the managed
+	// resources.
+	Manifest manifest.Options
+	// ObserveOnly translates the resources with the Observe management
+	// policy instead of the full management.
+	ObserveOnly bool
+}
+
+// A Result is the translation of a Terraform state.
+type Result struct {
+	// Resources are the managed resource manifests, in the order of the
+	// state.
+	Resources []*unstructured.Unstructured
+	// Warnings about the resources and attributes of the state that are not
+	// translated.
+	Warnings []string
+}
+
+// state is a Terraform state of version 4.
+type state struct {
+	Version   int             `json:"version"`
+	Resources []stateResource `json:"resources"`
+}
+
+type stateResource struct {
+	Module    string          `json:"module"`
+	Mode      string          `json:"mode"`
+	Type      string          `json:"type"`
+	Name      string          `json:"name"`
+	Instances []stateInstance `json:"instances"`
+}
+
+type stateInstance struct {
+	IndexKey   any            `json:"index_key"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// Convert translates the azapi resources of the supplied Terraform state
+// into managed resource manifests.
+func Convert(stateJSON []byte, o Options) (*Result, error) {
+	s := &state{}
+	if err := json.Unmarshal(stateJSON, s); err != nil {
+		return nil, errors.Wrap(err, errParseState)
+	}
+	if s.Version != stateVersion {
+		return nil, errors.Errorf(errStateVersionFmt, s.Version, stateVersion)
+	}
+	c := &converter{o: o, b: manifest.NewBuilder(o.Manifest), result: &Result{}}
+	for _, r := range s.Resources {
+		if r.Mode != modeManaged {
+			continue
+		}
+		if _, ok := kinds[r.Type]; !ok {
+			if strings.HasPrefix(r.Type, "azapi_") {
+				c.warn(fmt.Sprintf("resource %s is skipped: the type %s is not supported", address(r, nil), r.Type))
+			}
+			continue
+		}
+		for _, in := range r.Instances {
+			if err := c.instance(r, in); err != nil {
+				c.warn(fmt.Sprintf("resource %s is skipped: %s", address(r, in.IndexKey), err))
+			}
+		}
+	}
+	return c.result, nil
+}
+
+type converter struct {
+	o      Options
+	b      *manifest.Builder
+	result *Result
+}
+
+func (c *converter) warn(msg string) {
+	c.result.Warnings = append(c.result.Warnings, msg)
+}
+
+// instance appends the managed resource of the supplied instance of the
+// supplied Terraform resource to the result.
+func (c *converter) instance(r stateResource, in stateInstance) error {
+	addr := address(r, in.IndexKey)
+	id, _ := in.Attributes[attrID].(string)
+	if id == "" {
+		return errors.Errorf(errNoIDFmt, addr)
+	}
+	if v := in.Attributes[attrSensitiveBody]; v != nil {
+		c.warn(fmt.Sprintf("the sensitive body of resource %s is not translated, set it in the sensitiveBody of the managed resource", addr))
+	}
+	attrs := make(map[string]any, len(in.Attributes))
+	for k, v := range in.Attributes {
+		if k != attrSensitiveBody {
+			attrs[k] = v
+		}
+	}
+	if r.Type == "azapi_update_resource" {
+		// the resource ID and the name and parent ID are alternatives, the
+		// state has both of them
+		if rid, _ := attrs["resource_id"].(string); rid != "" {
+			delete(attrs, "name")
+			delete(attrs, "parent_id")
+		}
+	}
+	forProvider, err := convert(attrs, parameters[r.Type], "")
+	if err != nil {
+		return err
+	}
+	spec := map[string]any{"forProvider": forProvider}
+	if c.o.ObserveOnly {
+		spec["managementPolicies"] = []any{managementPolicyObserve}
+	}
+	u, err := c.b.Build(kinds[r.Type], nameOf(r, in.IndexKey), map[string]string{
+		meta.AnnotationKeyExternalName: id,
+		AnnotationKeyTerraformAddress:  addr,
+	}, spec)
+	if err != nil {
+		return err
+	}
+	c.result.Resources = append(c.result.Resources, u)
+	return nil
+}
+
+// convert returns the supplied Terraform attribute value converted to the
+// supplied type of the parameters, with the JSON names of the parameters.
+// The attributes that are not parameters, e.g. the computed ones, and the
+// null and empty values are dropped, in which case nil is returned.
+func convert(v any, t reflect.Type, path string) (any, error) { //nolint:gocyclo // a single type switch
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if v == nil {
+		return nil, nil
+	}
+	if t == jsonType {
+		d, err := dynamic(v)
+		return d, errors.Wrapf(err, errConvertFmt, path)
+	}
+	switch t.Kind() { //nolint:exhaustive // the scalars are kept as they are
+	case reflect.Struct:
+		// the blocks are lists of a single object in the state
+		if l, ok := v.([]any); ok {
+			if len(l) == 0 {
+				return nil, nil
+			}
+			v = l[0]
+		}
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, errors.Errorf(errConvertFmt, path)
+		}
+		result := map[string]any{}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tf, jsn := tagName(f.Tag.Get("tf")), tagName(f.Tag.Get("json"))
+			if tf == "" || tf == "-" || jsn == "" || jsn == "-" {
+				continue
+			}
+			cv, err := convert(m[tf], f.Type, join(path, tf))
+			if err != nil {
+				return nil, err
+			}
+			if cv != nil {
+				result[jsn] = cv
+			}
+		}
+		if len(result) == 0 {
+			return nil, nil
+		}
+		return result, nil
+	case reflect.Slice:
+		l, ok := v.([]any)
+		if !ok {
+			// a single object of a block
+			l = []any{v}
+		}
+		result := make([]any, 0, len(l))
+		for i, e := range l {
+			cv, err := convert(e, t.Elem(), fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			if cv != nil {
+				result = append(result, cv)
+			}
+		}
+		if len(result) == 0 {
+			return nil, nil
+		}
+		return result, nil
+	case reflect.Map:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, errors.Errorf(errConvertFmt, path)
+		}
+		result := make(map[string]any, len(m))
+		for k, e := range m {
+			cv, err := convert(e, t.Elem(), join(path, k))
+			if err != nil {
+				return nil, err
+			}
+			if cv != nil {
+				result[k] = cv
+			}
+		}
+		if len(result) == 0 {
+			return nil, nil
+		}
+		return result, nil
+	default:
+		return v, nil
+	}
+}
+
+// dynamic returns the value of a dynamic attribute of the state, which is a
+// JSON string with the azapi provider 1.x, and an object with the value and
+// its type with the 2.x.
+func dynamic(v any) (any, error) {
+	if m, ok := v.(map[string]any); ok && len(m) == 2 {
+		if _, ok := m["type"]; ok {
+			if value, ok := m["value"]; ok {
+				v = value
+			}
+		}
+	}
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	if s == "" {
+		return nil, nil
+	}
+	var result any
+	return result, errors.Wrap(json.Unmarshal([]byte(s), &result), errDecodeDynamic)
+}
+
+// tagName returns the name of the supplied struct tag value.
+func tagName(tag string) string {
+	return strings.Split(tag, ",")[0]
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// address returns the address of the Terraform resource instance with the
+// supplied index key, or of the Terraform resource if it's nil.
+func address(r stateResource, key any) string {
+	a := r.Type + "." + r.Name
+	if r.Module != "" {
+		a = r.Module + "." + a
+	}
+	switch k := key.(type) {
+	case string:
+		a += fmt.Sprintf("[%q]", k)
+	case float64:
+		a += fmt.Sprintf("[%d]", int64(k))
+	}
+	return a
+}
+
+// nameOf returns the module path, the name and the index key of the
+// Terraform resource instance, which the name of its managed resource is
+// derived from.
+func nameOf(r stateResource, key any) string {
+	parts := make([]string, 0, 3)
+	if r.Module != "" {
+		parts = append(parts, strings.ReplaceAll(r.Module, "module.", ""))
+	}
+	parts = append(parts, r.Name)
+	if key != nil {
+		parts = append(parts, fmt.Sprint(key))
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2025 Upbound Inc. <https://upbound.io>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tfstate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/upbound/provider-azapi/v2/internal/manifest"
+)
+
+const (
+	testRGID     = "/subscriptions/sub/resourceGroups/rg"
+	testSubnetID = testRGID + "/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default"
+	testAccount  = testRGID + "/providers/Microsoft.Storage/storageAccounts/sa"
+)
+
+func TestConvert(t *testing.T) {
+	cases := map[string]struct {
+		state        string
+		o            Options
+		want         []map[string]any
+		wantWarnings []string
+		wantErr      string
+	}{
+		"ResourceV2": {
+			state: `{
+				"version": 4,
+				"resources": [{
+					"module": "module.network",
+					"mode": "managed",
+					"type": "azapi_resource",
+					"name": "subnet",
+					"instances": [{
+						"index_key": "default",
+						"attributes": {
+							"id": "` + testSubnetID + `",
+							"type": "Microsoft.Network/virtualNetworks/subnets@2023-04-01",
+							"name": "default",
+							"parent_id": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+							"body": {"type": ["object", {}], "value": {"properties": {"addressPrefix": "10.0.0.0/24"}}},
+							"output": {"type": ["object", {}], "value": {"id": "x"}},
+							"identity": [{"type": "UserAssigned", "identity_ids": ["id1"], "principal_id": "p"}],
+							"retry": null,
+							"locks": [],
+							"tags": {"env": "dev"},
+							"schema_validation_enabled": true
+						}
+					}]
+				}]
+			}`,
+			want: []map[string]any{{
+				"apiVersion": "resources.azapi.upbound.io/v1beta2",
+				"kind":       "Resource",
+				"metadata": map[string]any{
+					"name": "network-subnet-default",
+					"annotations": map[string]any{
+						"crossplane.io/external-name":        testSubnetID,
+						"azapi.upbound.io/terraform-address": `module.network.azapi_resource.subnet["default"]`,
+					},
+				},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"type":                    "Microsoft.Network/virtualNetworks/subnets@2023-04-01",
+						"name":                    "default",
+						"parentId":                "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+						"body":                    map[string]any{"properties": map[string]any{"addressPrefix": "10.0.0.0/24"}},
+						"identity":                []any{map[string]any{"type": "UserAssigned", "identityIds": []any{"id1"}}},
+						"tags":                    map[string]any{"env": "dev"},
+						"schemaValidationEnabled": true,
+					},
+				},
+			}},
+		},
+		"ResourceV1ObserveOnlyNamespaced": {
+			state: `{
+				"version": 4,
+				"resources": [{
+					"mode": "managed",
+					"type": "azapi_resource",
+					"name": "sa",
+					"instances": [{
+						"index_key": 0,
+						"attributes": {
+							"id": "` + testAccount + `",
+							"type": "Microsoft.Storage/storageAccounts@2023-05-01",
+							"body": "{\"kind\":\"StorageV2\",\"sku\":{\"name\":\"Standard_LRS\"}}",
+							"retry": [{"error_message_regex": ["Conflict"], "interval_seconds": 10}]
+						}
+					}]
+				}]
+			}`,
+			o: Options{
+				Manifest:    manifest.Options{Namespaced: true, Namespace: "team", ProviderConfigName: "azure"},
+				ObserveOnly: true,
+			},
+			want: []map[string]any{{
+				"apiVersion": "resources.azapi.m.upbound.io/v1beta2",
+				"kind":       "Resource",
+				"metadata": map[string]any{
+					"name":      "sa-0",
+					"namespace": "team",
+					"annotations": map[string]any{
+						"crossplane.io/external-name":        testAccount,
+						"azapi.upbound.io/terraform-address": "azapi_resource.sa[0]",
+					},
+				},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"type":  "Microsoft.Storage/storageAccounts@2023-05-01",
+						"body":  map[string]any{"kind": "StorageV2", "sku": map[string]any{"name": "Standard_LRS"}},
+						"retry": map[string]any{"errorMessageRegex": []any{"Conflict"}, "intervalSeconds": int64(10)},
+					},
+					"managementPolicies": []any{"Observe"},
+					"providerConfigRef":  map[string]any{"name": "azure", "kind": "ClusterProviderConfig"},
+				},
+			}},
+		},
+		"UpdateResourceWithResourceID": {
+			state: `{
+				"version": 4,
+				"resources": [{
+					"mode": "managed",
+					"type": "azapi_update_resource",
+					"name": "tls",
+					"instances": [{
+						"attributes": {
+							"id": "` + testAccount + `",
+							"type": "Microsoft.Storage/storageAccounts@2023-05-01",
+							"resource_id": "` + testAccount + `",
+							"name": "sa",
+							"parent_id": "` + testRGID + `",
+							"body": {"type": ["object", {}], "value": {"properties": {"minimumTlsVersion": "TLS1_2"}}}
+						}
+					}]
+				}]
+			}`,
+			want: []map[string]any{{
+				"apiVersion": "resources.azapi.upbound.io/v1beta2",
+				"kind":       "UpdateResource",
+				"metadata": map[string]any{
+					"name": "tls",
+					"annotations": map[string]any{
+						"crossplane.io/external-name":        testAccount,
+						"azapi.upbound.io/terraform-address": "azapi_update_resource.tls",
+					},
+				},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"type":       "Microsoft.Storage/storageAccounts@2023-05-01",
+						"resourceId": testAccount,
+						"body":       map[string]any{"properties": map[string]any{"minimumTlsVersion": "TLS1_2"}},
+					},
+				},
+			}},
+		},
+		"DataPlaneResource": {
+			state: `{
+				"version": 4,
+				"resources": [{
+					"mode": "managed",
+					"type": "azapi_data_plane_resource",
+					"name": "kv",
+					"instances": [{
+						"attributes": {
+							"id": "kv.vault.azure.net/contacts",
+							"type": "Microsoft.KeyVault/vaults/contacts@7.4",
+							"name": "contacts",
+							"parent_id": "kv.vault.azure.net",
+							"body": "",
+							"sensitive_body": {"type": ["object", {}], "value": {"secret": "x"}}
+						}
+					}]
+				}]
+			}`,
+			want: []map[string]any{{
+				"apiVersion": "resources.azapi.upbound.io/v1beta2",
+				"kind":       "DataPlaneResource",
+				"metadata": map[string]any{
+					"name": "kv",
+					"annotations": map[string]any{
+						"crossplane.io/external-name":        "kv.vault.azure.net/contacts",
+						"azapi.upbound.io/terraform-address": "azapi_data_plane_resource.kv",
+					},
+				},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"type":     "Microsoft.KeyVault/vaults/contacts@7.4",
+						"name":     "contacts",
+						"parentId": "kv.vault.azure.net",
+					},
+				},
+			}},
+			wantWarnings: []string{
+				"the sensitive body of resource azapi_data_plane_resource.kv is not translated, set it in the sensitiveBody of the managed resource",
+			},
+		},
+		"Skipped": {
+			state: `{
+				"version": 4,
+				"resources": [
+					{"mode": "data", "type": "azapi_resource", "name": "rg", "instances": [{"attributes": {"id": "x"}}]},
+					{"mode": "managed", "type": "azurerm_resource_group", "name": "rg", "instances": [{"attributes": {"id": "x"}}]},
+					{"mode": "managed", "type": "azapi_resource_action", "name": "restart", "instances": [{"attributes": {"id": "x"}}]},
+					{"mode": "managed", "type": "azapi_resource", "name": "noid", "instances": [{"index_key": "a", "attributes": {}}]},
+					{"mode": "managed", "type": "azapi_resource", "name": "bad", "instances": [{"attributes": {"id": "x", "body": "{"}}]}
+				]
+			}`,
+			wantWarnings: []string{
+				"resource azapi_resource_action.restart is skipped: the type azapi_resource_action is not supported",
+				`resource azapi_resource.noid["a"] is skipped: the instance azapi_resource.noid["a"] has no ID`,
+				"resource azapi_resource.bad is skipped: cannot convert the attribute body: cannot decode the dynamic value: unexpected end of JSON input",
+			},
+		},
+		"UnsupportedVersion": {
+			state:   `{"version": 3}`,
+			wantErr: "unsupported Terraform state version 3, only the version 4 is supported",
+		},
+		"InvalidJSON": {
+			state:   `{`,
+			wantErr: "cannot parse the Terraform state: unexpected end of JSON input",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := Convert([]byte(tc.state), tc.o)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("want error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want no error, got %v", err)
+			}
+			var got []map[string]any
+			for _, u := range r.Resources {
+				got = append(got, u.Object)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want resources\n%v\ngot\n%v", tc.want, got)
+			}
+			if !reflect.DeepEqual(tc.wantWarnings, r.Warnings) {
+				t.Errorf("want warnings\n%q\ngot\n%q", tc.wantWarnings, r.Warnings)
+			}
+		})
+	}
+}